@@ -0,0 +1,358 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+)
+
+// Category is a function category and the source file defining its functions
+type Category struct {
+	Name      string
+	File      string
+	Functions []Function
+}
+
+// Function is a function of the registry
+type Function struct {
+	Definition *aaliflowkitgrpc.FunctionDefinition
+	Metadata   *internalstates.FunctionMetadata
+	Adapter    *Adapter
+}
+
+// Adapter describes the generated adapter calling a function without reflection
+type Adapter struct {
+	Params []AdapterParam
+	// Call is the statement calling the function
+	Call string
+	// Outputs are the comma-separated output variables
+	Outputs string
+	// Error is true if the function returns a trailing error
+	Error bool
+}
+
+// AdapterParam is a parameter of a function, decoded from the input with the same index
+type AdapterParam struct {
+	Index int
+	Type  string
+	// Decoder is the typed decoder of the input, see inputDecoder
+	Decoder string
+}
+
+// inputDecoders maps the Go types of the function inputs to the typed decoders of pkg/externalfunctions/adapters.go,
+// the inputs of all other types are decoded from JSON
+var inputDecoders = map[string]string{
+	"string":     "decodeText",
+	"int":        "decodeInt",
+	"int8":       "decodeInt",
+	"int16":      "decodeInt",
+	"int32":      "decodeInt",
+	"int64":      "decodeInt",
+	"rune":       "decodeInt",
+	"uint":       "decodeUint",
+	"uint8":      "decodeUint",
+	"uint16":     "decodeUint",
+	"uint32":     "decodeUint",
+	"uint64":     "decodeUint",
+	"byte":       "decodeUint",
+	"float32":    "decodeFloat",
+	"float64":    "decodeFloat",
+	"complex64":  "decodeComplex",
+	"complex128": "decodeComplex",
+	"bool":       "decodeBool",
+}
+
+// inputDecoder returns the typed decoder of a function input
+//
+// Parameters:
+//   - goType: the Go type of the input in the function definition
+//
+// Returns:
+//   - string: the name of the decoder
+func inputDecoder(goType string) string {
+	if decoder, ok := inputDecoders[goType]; ok {
+		return decoder
+	}
+	return "decodeJSON"
+}
+
+// categories lists the function categories and their source files in pkg/externalfunctions,
+// add a new category here and run go generate in pkg/externalfunctions
+var categories = []Category{
+	{Name: "llm_handler", File: "llmhandler.go"},
+	{Name: "knowledge_db", File: "knowledgedb.go"},
+	{Name: "ansys_gpt", File: "ansysgpt.go"},
+	{Name: "data_extraction", File: "dataextraction.go"},
+	{Name: "generic", File: "generic.go"},
+	{Name: "cast", File: "cast.go"},
+	{Name: "ansys_mesh_pilot", File: "ansysmeshpilot.go"},
+	{Name: "qdrant", File: "qdrant.go"},
+	{Name: "auth", File: "auth.go"},
+	{Name: "mcp", File: "mcp.go"},
+	{Name: "ansys_materials", File: "ansysmaterials.go"},
+	{Name: "rhsc", File: "rhsc.go"},
+}
+
+// exprString formats an ast.Expr as Go source
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	err := format.Node(&buf, token.NewFileSet(), expr)
+	if err != nil {
+		panic(fmt.Sprintf("unable to format expression: %v", err))
+	}
+	return buf.String()
+}
+
+// parseAdapters parses the exported functions of a source file and describes their adapters.
+// The import paths of the packages used in the parameter types are added to imports.
+func parseAdapters(content []byte, imports map[string]string) map[string]*Adapter {
+	file, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	if err != nil {
+		panic(fmt.Sprintf("unable to parse source file: %v", err))
+	}
+
+	// map the package names of the file to their import paths
+	filePackages := map[string]string{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		filePackages[name] = path
+	}
+
+	adapters := map[string]*Adapter{}
+	for _, decl := range file.Decls {
+		fn, isFn := decl.(*ast.FuncDecl)
+		if !isFn || fn.Recv != nil || !fn.Name.IsExported() {
+			continue
+		}
+
+		adapter := &Adapter{Params: []AdapterParam{}}
+		args := []string{}
+		for i, field := range fn.Type.Params.List {
+			if selector, ok := field.Type.(*ast.SelectorExpr); ok && i == 0 && exprString(selector) == "context.Context" {
+				args = append(args, "ctx")
+				continue
+			}
+
+			// record the packages used in the parameter type
+			ast.Inspect(field.Type, func(node ast.Node) bool {
+				if selector, ok := node.(*ast.SelectorExpr); ok {
+					if pkg, ok := selector.X.(*ast.Ident); ok {
+						imports[pkg.Name] = filePackages[pkg.Name]
+					}
+				}
+				return true
+			})
+
+			paramType := field.Type
+			variadic := false
+			if ellipsis, ok := paramType.(*ast.Ellipsis); ok {
+				paramType = &ast.ArrayType{Elt: ellipsis.Elt}
+				variadic = true
+			}
+			for n := 0; n < max(len(field.Names), 1); n++ {
+				param := AdapterParam{Index: len(adapter.Params), Type: exprString(paramType)}
+				adapter.Params = append(adapter.Params, param)
+				arg := fmt.Sprintf("in%d", param.Index)
+				if variadic {
+					arg += "..."
+				}
+				args = append(args, arg)
+			}
+		}
+
+		outputs := []string{}
+		results := []string{}
+		if fn.Type.Results != nil {
+			for i, field := range fn.Type.Results.List {
+				for n := 0; n < max(len(field.Names), 1); n++ {
+					if i == len(fn.Type.Results.List)-1 && exprString(field.Type) == "error" {
+						adapter.Error = true
+						results = append(results, "err")
+						continue
+					}
+					output := fmt.Sprintf("out%d", len(outputs))
+					outputs = append(outputs, output)
+					results = append(results, output)
+				}
+			}
+		}
+
+		call := fmt.Sprintf("%s(%s)", fn.Name.Name, strings.Join(args, ", "))
+		switch {
+		case len(results) == 0:
+			adapter.Call = call
+		case len(outputs) == 0 && len(adapter.Params) > 0:
+			// err is already declared by the decoded inputs
+			adapter.Call = fmt.Sprintf("err = %s", call)
+		default:
+			adapter.Call = fmt.Sprintf("%s := %s", strings.Join(results, ", "), call)
+		}
+		adapter.Outputs = strings.Join(outputs, ", ")
+		adapters[fn.Name.Name] = adapter
+	}
+	return adapters
+}
+
+// importSpecs formats the imports of the generated adapters
+func importSpecs(imports map[string]string) []string {
+	specs := []string{}
+	for name, path := range imports {
+		if path[strings.LastIndex(path, "/")+1:] == name {
+			specs = append(specs, strconv.Quote(path))
+		} else {
+			specs = append(specs, fmt.Sprintf("%s %s", name, strconv.Quote(path)))
+		}
+	}
+	// gofmt sorts the imports of the generated code
+	return specs
+}
+
+// stringSlice formats a string slice as Go literal, keeping nil and empty slices apart
+func stringSlice(values []string) string {
+	if values == nil {
+		return "nil"
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return fmt.Sprintf("[]string{%s}", strings.Join(quoted, ", "))
+}
+
+// stringPointer formats a string pointer as Go expression
+func stringPointer(value *string) string {
+	if value == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("registryString(%s)", strconv.Quote(*value))
+}
+
+// floatPointer formats a float pointer as Go expression
+func floatPointer(value *float64) string {
+	if value == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("registryFloat(%s)", strconv.FormatFloat(*value, 'g', -1, 64))
+}
+
+// durationValue formats a duration as Go expression
+func durationValue(value time.Duration) string {
+	return fmt.Sprintf("registryDuration(%s)", strconv.Quote(value.String()))
+}
+
+// writeTemplate executes a template and writes the formatted code to a file
+func writeTemplate(tmpl *template.Template, name string, data interface{}, outFile string) {
+	// execute template w/ data
+	var buf bytes.Buffer
+	err := tmpl.ExecuteTemplate(&buf, name, data)
+	if err != nil {
+		panic(fmt.Sprintf("unable to execute template: %v", err))
+	}
+
+	// format the generated code
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(fmt.Sprintf("unable to format generated code: %v", err))
+	}
+
+	// write to file
+	err = os.WriteFile(outFile, formatted, 0644)
+	if err != nil {
+		panic(fmt.Sprintf("unable to write generated code to file: %v", err))
+	}
+}
+
+func main() {
+	_, thisFile, _, _ := runtime.Caller(0)
+	genDir := filepath.Dir(thisFile)
+	sourceDir := filepath.Join(genDir, "../../../pkg/externalfunctions")
+
+	// extract the function definitions of each category from its source file
+	internalstates.InitializeInternalStates()
+	imports := map[string]string{}
+	for i, category := range categories {
+		content, err := os.ReadFile(filepath.Join(sourceDir, category.File))
+		if err != nil {
+			panic(fmt.Sprintf("unable to read source file of category %s: %v", category.Name, err))
+		}
+		err = functiondefinitions.ExtractFunctionDefinitionsFromPackage(string(content), category.Name)
+		if err != nil {
+			panic(fmt.Sprintf("unable to extract function definitions of category %s: %v", category.Name, err))
+		}
+		adapters := parseAdapters(content, imports)
+
+		for name, definition := range internalstates.AvailableFunctions {
+			if definition.Category == category.Name {
+				adapter := adapters[name]
+				if adapter != nil {
+					if len(adapter.Params) != len(definition.Input) {
+						panic(fmt.Sprintf("function %s takes %d inputs, but its definition has %d", name, len(adapter.Params), len(definition.Input)))
+					}
+					for j := range adapter.Params {
+						adapter.Params[j].Decoder = inputDecoder(definition.Input[j].GoType)
+					}
+				}
+				categories[i].Functions = append(categories[i].Functions, Function{definition, internalstates.AvailableFunctionsMetadata[name], adapter})
+			}
+		}
+		sort.Slice(categories[i].Functions, func(a, b int) bool {
+			return categories[i].Functions[a].Definition.Name < categories[i].Functions[b].Definition.Name
+		})
+	}
+
+	tmpl := template.Must(
+		template.New("").Funcs(template.FuncMap{
+			"quote":         strconv.Quote,
+			"stringSlice":   stringSlice,
+			"stringPointer": stringPointer,
+			"floatPointer":  floatPointer,
+			"durationValue": durationValue,
+		}).ParseFiles(filepath.Join(genDir, "registry.gotmpl"), filepath.Join(genDir, "adapters.gotmpl")))
+
+	writeTemplate(tmpl, "registry.gotmpl", categories, filepath.Join(sourceDir, "externalfunctions.go"))
+	writeTemplate(tmpl, "adapters.gotmpl", map[string]interface{}{
+		"Categories": categories,
+		"Imports":    importSpecs(imports),
+	}, filepath.Join(sourceDir, "functionadapters.go"))
+}
@@ -23,52 +23,23 @@
 package main
 
 import (
-	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
 
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
 	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
 	"github.com/ansys/aali-flowkit/pkg/grpcserver"
 	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/llmmock"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-flowkit/pkg/secrets"
 )
 
-//go:embed pkg/externalfunctions/dataextraction.go
-var dataExtractionFile string
-
-//go:embed pkg/externalfunctions/qdrant.go
-var qdrantFile string
-
-//go:embed pkg/externalfunctions/generic.go
-var genericFile string
-
-//go:embed pkg/externalfunctions/cast.go
-var castFile string
-
-//go:embed pkg/externalfunctions/knowledgedb.go
-var knowledgeDBFile string
-
-//go:embed pkg/externalfunctions/llmhandler.go
-var llmHandlerFile string
-
-//go:embed pkg/externalfunctions/ansysgpt.go
-var ansysGPTFile string
-
-//go:embed pkg/externalfunctions/ansysmeshpilot.go
-var ansysMeshPilotFile string
-
-//go:embed pkg/externalfunctions/ansysmaterials.go
-var ansysMaterialsFile string
-
-//go:embed pkg/externalfunctions/auth.go
-var authFile string
-
-//go:embed pkg/externalfunctions/mcp.go
-var mcpFile string
-
-//go:embed pkg/externalfunctions/rhsc.go
-var rhscFile string
-
 func init() {
 	// initialize config
 	config.InitConfig([]string{}, map[string]interface{}{
@@ -85,35 +56,133 @@ func init() {
 	logging.InitLogger(config.GlobalConfig)
 }
 
+// schemaFile is the file to dump the JSON Schema catalogue of all functions to
+var schemaFile = flag.String("dump-schema", "", "write the JSON Schema catalogue of all functions to the given file and exit")
+
+// secretsSource is the JSON file of plain secrets to encrypt into the FLOWKIT_SECRETS_FILE secret store
+var secretsSource = flag.String("encrypt-secrets", "", "encrypt the JSON object of secrets in the given file into FLOWKIT_SECRETS_FILE and exit")
+
+// mockLLMFixtures is the fixtures file of the local mock aali-llm serving the LLM requests
+var mockLLMFixtures = flag.String("mock-llm", "", "serve the LLM requests with a local mock aali-llm replying as scripted by the given fixtures file")
+
 func main() {
+	flag.Parse()
+
 	// Initialize internal states
 	internalstates.InitializeInternalStates()
 
-	// Create file list
-	files := map[string]string{
-		"data_extraction":  dataExtractionFile,
-		"generic":          genericFile,
-		"cast":             castFile,
-		"knowledge_db":     knowledgeDBFile,
-		"llm_handler":      llmHandlerFile,
-		"ansys_gpt":        ansysGPTFile,
-		"qdrant":           qdrantFile,
-		"ansys_mesh_pilot": ansysMeshPilotFile,
-		"ansys_materials":  ansysMaterialsFile,
-		"auth":             authFile,
-		"mcp":              mcpFile,
-		"rhsc":             rhscFile,
+	// Load function definitions generated from the externalfunctions package
+	err := functiondefinitions.LoadRegistry(externalfunctions.FunctionDefinitions, externalfunctions.FunctionsMetadata)
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "Error loading function definitions: %v", err)
+	}
+	err = functiondefinitions.CheckConsistency(externalfunctions.ExternalFunctionsMap)
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "Function definitions are out of date, run go generate: %v", err)
+	}
+
+	// Dump the JSON Schema catalogue instead of starting the server
+	if *schemaFile != "" {
+		err = dumpFunctionSchemas(*schemaFile)
+		if err != nil {
+			redact.Log.Fatalf(&logging.ContextMap{}, "Error dumping function schemas: %v", err)
+		}
+		return
 	}
 
-	// Load function definitions
-	for category, file := range files {
-		err := functiondefinitions.ExtractFunctionDefinitionsFromPackage(file, category)
+	// Encrypt the secret store instead of starting the server
+	if *secretsSource != "" {
+		err = encryptSecrets(*secretsSource)
 		if err != nil {
-			logging.Log.Fatalf(&logging.ContextMap{}, "Error extracting function definitions from package: %v", err)
+			redact.Log.Fatalf(&logging.ContextMap{}, "Error encrypting secrets: %v", err)
 		}
+		return
+	}
+
+	// Replace aali-llm by a local mock for offline development
+	if *mockLLMFixtures != "" {
+		mock, err := startMockLLM(*mockLLMFixtures)
+		if err != nil {
+			redact.Log.Fatalf(&logging.ContextMap{}, "Error starting mock LLM: %v", err)
+		}
+		defer mock.Close()
 	}
 
 	// Start the gRPC server
 	grpcserver.StartServer()
-	logging.Log.Fatalf(&logging.ContextMap{}, "Error in gRPC server. Exiting application.")
+	redact.Log.Infof(&logging.ContextMap{}, "gRPC server shut down. Exiting application.")
+}
+
+// dumpFunctionSchemas writes the JSON Schema catalogue of all available functions to a file
+//
+// Parameters:
+//   - path: the path of the file to write
+//
+// Returns:
+//   - error: an error if the catalogue cannot be marshalled or written
+func dumpFunctionSchemas(path string) error {
+	catalogue := functiondefinitions.GenerateCatalogue(internalstates.AvailableFunctions, externalfunctions.ExternalFunctionsMap)
+	data, err := json.MarshalIndent(catalogue, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// encryptSecrets encrypts a JSON object of secrets into the file read by the file secret provider
+// The file and its key are taken from FLOWKIT_SECRETS_FILE and FLOWKIT_SECRETS_KEY_FILE
+//
+// Parameters:
+//   - path: the path of the JSON object mapping secret names to values
+//
+// Returns:
+//   - error: an error if the secrets cannot be read, encrypted or written
+func encryptSecrets(path string) error {
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+	if variables["FLOWKIT_SECRETS_FILE"] == "" || variables["FLOWKIT_SECRETS_KEY_FILE"] == "" {
+		return fmt.Errorf("FLOWKIT_SECRETS_FILE and FLOWKIT_SECRETS_KEY_FILE must be set")
+	}
+	key, err := secrets.LoadKey(variables["FLOWKIT_SECRETS_KEY_FILE"])
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	values := map[string]string{}
+	err = json.Unmarshal(data, &values)
+	if err != nil {
+		return fmt.Errorf("invalid secrets file %s: %v", path, err)
+	}
+
+	content, err := secrets.Encrypt(values, key)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(variables["FLOWKIT_SECRETS_FILE"], content, 0o600)
+}
+
+// startMockLLM starts a local mock aali-llm and points LLM_HANDLER_ENDPOINT to it
+//
+// Parameters:
+//   - path: the path of the fixtures file scripting the replies
+//
+// Returns:
+//   - *llmmock.Server: the running mock
+//   - error: an error if the fixtures cannot be loaded or the mock cannot listen
+func startMockLLM(path string) (*llmmock.Server, error) {
+	fixtures, err := llmmock.LoadFixtures(path)
+	if err != nil {
+		return nil, err
+	}
+	mock := llmmock.NewServer(fixtures)
+	endpoint, err := mock.Start("localhost:0")
+	if err != nil {
+		return nil, err
+	}
+	config.GlobalConfig.LLM_HANDLER_ENDPOINT = endpoint
+	redact.Log.Infof(&logging.ContextMap{}, "Serving LLM requests with the mock aali-llm at %v, scripted by %v.", endpoint, path)
+	return mock, nil
 }
@@ -0,0 +1,132 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package audit records the invocations of external functions,
+// with the caller, the redacted inputs, the duration and the outcome of each call.
+package audit
+
+import (
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces the values of sensitive inputs
+const RedactedValue = "[REDACTED]"
+
+// DefaultSensitiveInputs are the names of the inputs whose values are always redacted
+var DefaultSensitiveInputs = []string{"githubAccessToken", "acsApiKey", "jwtToken"}
+
+// Record is the audit record of a function invocation
+type Record struct {
+	// Time is the start of the invocation
+	Time time.Time `json:"time"`
+	// APIKeyHash identifies the API key of the caller without revealing it, empty without API key
+	APIKeyHash string `json:"apiKeyHash,omitempty"`
+	// Peer is the network address of the caller
+	Peer string `json:"peer,omitempty"`
+	// Method is the gRPC method, e.g. "RunFunction"
+	Method string `json:"method"`
+	// Function is the name of the called function
+	Function string `json:"function"`
+	// Category is the category of the function, empty for unknown functions
+	Category string `json:"category,omitempty"`
+	// Inputs are the inputs of the request
+	Inputs []Input `json:"inputs"`
+	// DurationMs is the duration of the invocation in milliseconds
+	DurationMs float64 `json:"durationMs"`
+	// Outcome is the gRPC status code of the invocation, e.g. "OK" or "PermissionDenied"
+	Outcome string `json:"outcome"`
+	// Error is the error message if the invocation failed
+	Error string `json:"error,omitempty"`
+}
+
+// Input is an input of a function invocation
+type Input struct {
+	// Name is the name of the input
+	Name string `json:"name"`
+	// Value is the value of the request, redacted for sensitive inputs
+	Value string `json:"value,omitempty"`
+	// Reference is the pipeline node whose output is passed to the input
+	Reference string `json:"reference,omitempty"`
+}
+
+// Sink receives the audit records
+type Sink interface {
+	// Write records an invocation
+	Write(record Record) error
+	// Close flushes and closes the sink
+	Close() error
+}
+
+// Redactor hides the values of sensitive inputs and shortens long values
+type Redactor struct {
+	sensitive      map[string]bool
+	maxValueLength int
+}
+
+// NewRedactor creates a redactor for the default and the given sensitive input names
+// The names are compared case-insensitively
+//
+// Parameters:
+//   - sensitiveInputs: the names of further inputs whose values are redacted
+//   - maxValueLength: the maximum length of recorded values in bytes, values are not shortened if zero
+//
+// Returns:
+//   - *Redactor: the redactor
+func NewRedactor(sensitiveInputs []string, maxValueLength int) *Redactor {
+	redactor := &Redactor{sensitive: map[string]bool{}, maxValueLength: maxValueLength}
+	for _, name := range append(append([]string{}, DefaultSensitiveInputs...), sensitiveInputs...) {
+		if name = strings.TrimSpace(name); name != "" {
+			redactor.sensitive[strings.ToLower(name)] = true
+		}
+	}
+	return redactor
+}
+
+// Sensitive checks if the values of an input are redacted
+//
+// Parameters:
+//   - name: the name of the input
+//
+// Returns:
+//   - bool: true if the input is sensitive
+func (r *Redactor) Sensitive(name string) bool {
+	return r.sensitive[strings.ToLower(name)]
+}
+
+// Redact returns the value to record for an input
+//
+// Parameters:
+//   - name: the name of the input
+//   - value: the value of the request
+//
+// Returns:
+//   - string: RedactedValue for sensitive inputs, the value shortened to the maximum length otherwise
+func (r *Redactor) Redact(name string, value string) string {
+	if r.Sensitive(name) {
+		return RedactedValue
+	}
+	if r.maxValueLength > 0 && len(value) > r.maxValueLength {
+		return strings.ToValidUTF8(value[:r.maxValueLength], "") + "...[truncated]"
+	}
+	return value
+}
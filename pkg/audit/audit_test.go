@@ -0,0 +1,45 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactor(t *testing.T) {
+	redactor := NewRedactor([]string{"dbPassword", " "}, 8)
+
+	// the default and configured inputs are redacted regardless of case
+	assert.True(t, redactor.Sensitive("ACSAPIKEY"))
+	assert.False(t, redactor.Sensitive("query"))
+	assert.Equal(t, RedactedValue, redactor.Redact("githubAccessToken", "ghp_secret"))
+	assert.Equal(t, RedactedValue, redactor.Redact("JWTToken", "eyJ"))
+	assert.Equal(t, RedactedValue, redactor.Redact("dbpassword", "hunter2"))
+
+	// other values are kept and long values are shortened
+	assert.Equal(t, "short", redactor.Redact("query", "short"))
+	assert.Equal(t, "MATCH (n...[truncated]", redactor.Redact("query", "MATCH (n) RETURN n"))
+	assert.Equal(t, "1234567...[truncated]", redactor.Redact("query", "1234567é"))
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// FileSink appends the records as JSON lines to a file
+// The file is rotated once it would exceed its maximum size, keeping a number of backups
+// named like the file with the suffixes ".1" (newest) to ".<maxBackups>" (oldest)
+type FileSink struct {
+	mutex      sync.Mutex
+	path       string
+	maxBytes   int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// NewFileSink opens a file sink, appending to an existing file
+//
+// Parameters:
+//   - path: the path of the file
+//   - maxBytes: the size at which the file is rotated, the file is not rotated if zero
+//   - maxBackups: the number of rotated files kept
+//
+// Returns:
+//   - *FileSink: the sink
+//   - error: an error if the file cannot be opened
+func NewFileSink(path string, maxBytes int64, maxBackups int) (*FileSink, error) {
+	sink := &FileSink{path: path, maxBytes: maxBytes, maxBackups: maxBackups}
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+// Write appends a record to the file, rotating the file first if it would exceed its maximum size
+//
+// Parameters:
+//   - record: the record
+//
+// Returns:
+//   - error: an error if the record cannot be written
+func (s *FileSink) Write(record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.file == nil {
+		return fmt.Errorf("audit file %s is closed", s.path)
+	}
+	if s.maxBytes > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	written, err := s.file.Write(line)
+	s.size += int64(written)
+	return err
+}
+
+// Close closes the file
+//
+// Returns:
+//   - error: an error if the file cannot be closed
+func (s *FileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// open opens the file for appending and reads its size
+//
+// Returns:
+//   - error: an error if the file cannot be opened
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to read audit file size: %v", err)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+// rotate renames the file to the newest backup, removes the oldest backup and opens a new file
+//
+// Returns:
+//   - error: an error if a file cannot be renamed or opened
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %v", err)
+	}
+	s.file = nil
+
+	if s.maxBackups < 1 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove audit file: %v", err)
+		}
+		return s.open()
+	}
+
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate audit file: %v", err)
+		}
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return fmt.Errorf("failed to rotate audit file: %v", err)
+	}
+	return s.open()
+}
+
+// WriterSink writes the records as JSON lines to a writer, e.g. the standard output
+type WriterSink struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewWriterSink creates a sink writing to a writer
+//
+// Parameters:
+//   - writer: the writer
+//
+// Returns:
+//   - *WriterSink: the sink
+func NewWriterSink(writer io.Writer) *WriterSink {
+	return &WriterSink{encoder: json.NewEncoder(writer)}
+}
+
+// Write writes a record as JSON line
+//
+// Parameters:
+//   - record: the record
+//
+// Returns:
+//   - error: an error if the record cannot be written
+func (s *WriterSink) Write(record Record) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.encoder.Encode(record)
+}
+
+// Close does nothing, the writer is owned by the caller
+//
+// Returns:
+//   - error: always nil
+func (s *WriterSink) Close() error {
+	return nil
+}
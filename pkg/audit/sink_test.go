@@ -0,0 +1,106 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readRecords reads the JSON lines of an audit file
+func readRecords(t *testing.T, path string) []Record {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	records := []Record{}
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		var record Record
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	record := Record{
+		Time:     time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC),
+		Method:   "RunFunction",
+		Function: "GeneralGraphDbQuery",
+		Inputs:   []Input{{Name: "query", Value: "MATCH (n) RETURN n"}},
+		Outcome:  "OK",
+	}
+	line, err := json.Marshal(record)
+	require.NoError(t, err)
+
+	// the file is rotated before it would hold more than two records
+	sink, err := NewFileSink(path, int64(2*(len(line)+1)), 2)
+	require.NoError(t, err)
+	for i := 0; i < 7; i++ {
+		record.DurationMs = float64(i)
+		require.NoError(t, sink.Write(record))
+	}
+	require.NoError(t, sink.Close())
+
+	// the newest records are kept in the file and the backups, older backups are removed
+	durations := func(records []Record) []float64 {
+		values := []float64{}
+		for _, record := range records {
+			values = append(values, record.DurationMs)
+		}
+		return values
+	}
+	assert.Equal(t, []float64{6}, durations(readRecords(t, path)))
+	assert.Equal(t, []float64{4, 5}, durations(readRecords(t, path+".1")))
+	assert.Equal(t, []float64{2, 3}, durations(readRecords(t, path+".2")))
+	assert.NoFileExists(t, path+".3")
+	assert.Equal(t, record.Inputs, readRecords(t, path)[0].Inputs)
+
+	// a reopened sink appends to the file
+	sink, err = NewFileSink(path, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(record))
+	require.NoError(t, sink.Close())
+	assert.Len(t, readRecords(t, path), 2)
+	assert.Error(t, sink.Write(record))
+}
+
+func TestWriterSink(t *testing.T) {
+	var buffer bytes.Buffer
+	sink := NewWriterSink(&buffer)
+	require.NoError(t, sink.Write(Record{Method: "StreamFunction", Function: "PerformGeneralRequest", Outcome: "Unavailable"}))
+	require.NoError(t, sink.Close())
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &record))
+	assert.Equal(t, "PerformGeneralRequest", record["function"])
+	assert.Equal(t, "Unavailable", record["outcome"])
+	assert.NotContains(t, record, "apiKeyHash")
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package cache stores the outputs of cacheable external functions
+// in memory or in Redis, keyed by the function and its inputs.
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Store is a key-value store with expiring entries
+type Store interface {
+	// Get returns the value of a key and false if the key is missing or expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value of a key for the given time
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// DeletePrefix removes all keys starting with the prefix and returns their number
+	DeletePrefix(ctx context.Context, prefix string) (int, error)
+}
+
+// Key creates the cache key of a function call
+// The inputs are serialized as JSON, so equal values give the same key regardless of their request format
+//
+// Parameters:
+//   - function: the name of the function
+//   - inputs: the converted inputs of the function
+//
+// Returns:
+//   - string: the key, starting with FunctionPrefix(function)
+//   - error: an error if an input cannot be serialized
+func Key(function string, inputs []interface{}) (string, error) {
+	serialized, err := json.Marshal(inputs)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(serialized)
+	return FunctionPrefix(function) + hex.EncodeToString(hash[:]), nil
+}
+
+// FunctionPrefix returns the prefix of the cache keys of a function
+//
+// Parameters:
+//   - function: the name of the function
+//
+// Returns:
+//   - string: the key prefix
+func FunctionPrefix(function string) string {
+	return function + ":"
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	key, err := Key("SimilaritySearch", []interface{}{"collection", []float32{0.5, 1}, map[string]int{"b": 2, "a": 1}})
+	require.NoError(t, err)
+	assert.Regexp(t, "^SimilaritySearch:[0-9a-f]{64}$", key)
+
+	// equal inputs give the same key, the map order does not matter
+	same, err := Key("SimilaritySearch", []interface{}{"collection", []float32{0.5, 1}, map[string]int{"a": 1, "b": 2}})
+	require.NoError(t, err)
+	assert.Equal(t, key, same)
+
+	// other inputs or functions give other keys
+	other, err := Key("SimilaritySearch", []interface{}{"collection", []float32{0.5, 2}, map[string]int{"a": 1, "b": 2}})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	other, err = Key("KeywordSearch", []interface{}{"collection", []float32{0.5, 1}, map[string]int{"a": 1, "b": 2}})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	// inputs that cannot be serialized have no key
+	_, err = Key("SimilaritySearch", []interface{}{make(chan string)})
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-memory LRU store
+// The least recently used entry is evicted once the store is full, expired entries are removed when read
+type MemoryStore struct {
+	mutex      sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	// order holds the entries, most recently used first
+	order *list.List
+	now   func() time.Time
+}
+
+// memoryEntry is an entry of the MemoryStore
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryStore creates an in-memory LRU store
+//
+// Parameters:
+//   - maxEntries: the maximum number of entries
+//
+// Returns:
+//   - *MemoryStore: the store
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Get returns the value of a key and false if the key is missing or expired
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//
+// Returns:
+//   - []byte: the value
+//   - bool: true if the key was found
+//   - error: always nil
+func (s *MemoryStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*memoryEntry)
+	if !s.now().Before(entry.expires) {
+		s.remove(element)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+// Set stores the value of a key for the given time
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//   - value: the value
+//   - ttl: the time the value is kept for
+//
+// Returns:
+//   - error: always nil
+func (s *MemoryStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	expires := s.now().Add(ttl)
+	if element, ok := s.entries[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		entry.value = value
+		entry.expires = expires
+		s.order.MoveToFront(element)
+		return nil
+	}
+
+	s.entries[key] = s.order.PushFront(&memoryEntry{key: key, value: value, expires: expires})
+	for s.order.Len() > s.maxEntries {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+// DeletePrefix removes all keys starting with the prefix
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - prefix: the key prefix
+//
+// Returns:
+//   - int: the number of removed keys
+//   - error: always nil
+func (s *MemoryStore) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	removed := 0
+	for key, element := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			s.remove(element)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// Len returns the number of entries, including expired entries not read since they expired
+//
+// Returns:
+//   - int: the number of entries
+func (s *MemoryStore) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.order.Len()
+}
+
+// remove removes an entry, the caller must hold the mutex
+func (s *MemoryStore) remove(element *list.Element) {
+	s.order.Remove(element)
+	delete(s.entries, element.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := NewMemoryStore(2)
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Set(ctx, "a:1", []byte("one"), time.Minute))
+	require.NoError(t, store.Set(ctx, "a:2", []byte("two"), time.Hour))
+	value, ok, err := store.Get(ctx, "a:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("one"), value)
+
+	// the least recently used entry is evicted
+	require.NoError(t, store.Set(ctx, "b:1", []byte("three"), time.Hour))
+	assert.Equal(t, 2, store.Len())
+	_, ok, _ = store.Get(ctx, "a:2")
+	assert.False(t, ok)
+
+	// expired entries are removed when read
+	now = now.Add(2 * time.Minute)
+	_, ok, _ = store.Get(ctx, "a:1")
+	assert.False(t, ok)
+	assert.Equal(t, 1, store.Len())
+
+	// entries are removed by prefix
+	require.NoError(t, store.Set(ctx, "a:3", []byte("four"), time.Hour))
+	removed, err := store.DeletePrefix(ctx, "a:")
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	_, ok, _ = store.Get(ctx, "b:1")
+	assert.True(t, ok)
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cache
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore stores the entries in Redis, so they are shared by several aali-flowkit instances
+// The connections are pooled by the go-redis client
+type RedisStore struct {
+	client    *redis.Client
+	namespace string
+}
+
+// RedisOptions configures a RedisStore
+type RedisOptions struct {
+	// Address is the host and port of the Redis server
+	Address string
+	// Username is the ACL user to authenticate as, the default user if empty
+	Username string
+	// Password is used to authenticate if not empty
+	Password string
+	// Database is the index of the Redis database
+	Database int
+	// Namespace is prepended to all keys, so several deployments can share a server
+	Namespace string
+	// Timeout limits each command if the context has no earlier deadline
+	Timeout time.Duration
+	// MaxIdleConnections is the number of connections kept open between commands
+	MaxIdleConnections int
+	// TLS enables TLS with the given configuration if not nil
+	TLS *tls.Config
+}
+
+// NewRedisStore creates a store on a Redis server
+// The connections are opened on first use
+//
+// Parameters:
+//   - opts: the options of the store
+//
+// Returns:
+//   - *RedisStore: the store
+func NewRedisStore(opts RedisOptions) *RedisStore {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+	if opts.MaxIdleConnections <= 0 {
+		opts.MaxIdleConnections = 4
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:                  opts.Address,
+		Username:              opts.Username,
+		Password:              opts.Password,
+		DB:                    opts.Database,
+		DialTimeout:           opts.Timeout,
+		ReadTimeout:           opts.Timeout,
+		WriteTimeout:          opts.Timeout,
+		ContextTimeoutEnabled: true,
+		MaxIdleConns:          opts.MaxIdleConnections,
+		TLSConfig:             opts.TLS,
+		DisableIndentity:      true,
+	})
+	return &RedisStore{client: client, namespace: opts.Namespace}
+}
+
+// Get returns the value of a key and false if the key is missing or expired
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//
+// Returns:
+//   - []byte: the value
+//   - bool: true if the key was found
+//   - error: an error if the command fails
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, s.namespace+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores the value of a key for the given time
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//   - value: the value
+//   - ttl: the time the value is kept for, rounded up to milliseconds
+//
+// Returns:
+//   - error: an error if the command fails
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ttl = (ttl + time.Millisecond - 1).Truncate(time.Millisecond)
+	return s.client.Set(ctx, s.namespace+key, value, ttl).Err()
+}
+
+// DeletePrefix removes all keys starting with the prefix
+// The keys are listed with SCAN, so the server is not blocked by large key sets
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - prefix: the key prefix
+//
+// Returns:
+//   - int: the number of removed keys
+//   - error: an error if a command fails
+func (s *RedisStore) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	pattern := escapeRedisPattern(s.namespace+prefix) + "*"
+	removed := 0
+	var cursor uint64
+	for {
+		keys, next, err := s.client.Scan(ctx, cursor, pattern, 500).Result()
+		if err != nil {
+			return removed, err
+		}
+		if len(keys) > 0 {
+			count, err := s.client.Del(ctx, keys...).Result()
+			if err != nil {
+				return removed, err
+			}
+			removed += int(count)
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return removed, nil
+		}
+	}
+}
+
+// Eval runs a Lua script on the server, so other stores can update keys atomically over the connections of the store
+// The script is sent by its SHA1 digest once the server knows it. The keys are prefixed with the namespace of the store
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - script: the Lua script
+//   - keys: the keys accessed by the script, available as KEYS
+//   - args: the arguments of the script, available as ARGV
+//
+// Returns:
+//   - interface{}: the reply of the script, string for strings, int64 for integers, []interface{} for arrays, nil for a nil reply
+//   - error: an error if the script or the command fails
+func (s *RedisStore) Eval(ctx context.Context, script string, keys []string, args ...string) (interface{}, error) {
+	namespaced := make([]string, len(keys))
+	for i, key := range keys {
+		namespaced[i] = s.namespace + key
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+
+	reply, err := redis.NewScript(script).Run(ctx, s.client, namespaced, values...).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return reply, err
+}
+
+// Close closes the connections of the store
+//
+// Returns:
+//   - error: an error if a connection cannot be closed
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+// escapeRedisPattern escapes the glob characters of a SCAN pattern
+func escapeRedisPattern(value string) string {
+	var escaped strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(`*?[]\`, r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
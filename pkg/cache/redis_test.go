@@ -0,0 +1,93 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	server.RequireUserAuth("flowkit", "secret")
+	store := NewRedisStore(RedisOptions{Address: server.Addr(), Username: "flowkit", Password: "secret", Namespace: "flowkit:"})
+	defer store.Close()
+
+	_, ok, err := store.Get(ctx, "a:1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// the keys are namespaced and expire in milliseconds
+	require.NoError(t, store.Set(ctx, "a:1", []byte("one\r\ntwo"), 1500*time.Microsecond))
+	assert.Equal(t, 2*time.Millisecond, server.TTL("flowkit:a:1"))
+	value, ok, err := store.Get(ctx, "a:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("one\r\ntwo"), value)
+
+	// entries are removed by prefix, the glob characters of the prefix match literally
+	require.NoError(t, store.Set(ctx, "a:2", []byte("two"), time.Minute))
+	require.NoError(t, store.Set(ctx, "b:1", []byte("three"), time.Minute))
+	require.NoError(t, store.Set(ctx, "b*:1", []byte("four"), time.Minute))
+	removed, err := store.DeletePrefix(ctx, "a:")
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+	removed, err = store.DeletePrefix(ctx, "b*")
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, []string{"flowkit:b:1"}, server.Keys())
+
+	// the keys of scripts are namespaced
+	reply, err := store.Eval(ctx, "return {KEYS[1], ARGV[1]}", []string{"c:1"}, "42")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"flowkit:c:1", "42"}, reply)
+	reply, err = store.Eval(ctx, "return redis.call('GET', KEYS[1])", []string{"c:1"})
+	require.NoError(t, err)
+	assert.Nil(t, reply)
+	_, err = store.Eval(ctx, "return redis.call('UNKNOWN')", nil)
+	assert.Error(t, err)
+
+	// the authentication error is returned
+	store = NewRedisStore(RedisOptions{Address: server.Addr(), Username: "flowkit", Password: "wrong"})
+	defer store.Close()
+	_, _, err = store.Get(ctx, "a:1")
+	assert.ErrorContains(t, err, "WRONGPASS")
+}
+
+func TestRedisStoreUnavailable(t *testing.T) {
+	server := miniredis.RunT(t)
+	address := server.Addr()
+	server.Close()
+
+	// commands fail once the server is gone
+	store := NewRedisStore(RedisOptions{Address: address, Timeout: time.Second})
+	defer store.Close()
+	_, _, err := store.Get(context.Background(), "a")
+	assert.Error(t, err)
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+)
+
+// InputDecoder provides the inputs of a function call to the generated adapters.
+type InputDecoder interface {
+	// Input returns the input with the given index, either as string value or, if it is decoded
+	// already (e.g. the output of another pipeline node), as value.
+	// Both are nil for an omitted optional input, which is passed as zero value.
+	Input(index int) (text *string, value interface{}, err error)
+	// Constraints returns the constraints of the input with the given index
+	Constraints(index int) *internalstates.InputConstraints
+	// Invalid records and returns the error of an input that cannot be decoded or violates its constraints
+	Invalid(index int, err error) error
+}
+
+// FunctionAdapter calls an external function without reflection.
+// The adapters are generated by internal/gen/registry for all functions of ExternalFunctionsMap.
+//
+// Parameters:
+//   - ctx: the context of the request, passed to the function if it accepts one
+//   - decode: the decoder of the function inputs
+//
+// Returns:
+//   - []interface{}: the outputs of the function, without the trailing error
+//   - error: the decoding error or the error returned by the function
+type FunctionAdapter func(ctx context.Context, decode InputDecoder) ([]interface{}, error)
+
+// decodeInput decodes an input with the parse function of its type and checks it against its constraints.
+// The generated adapters pick the typed decoder of each input below, so no input goes through reflection.
+//
+// Parameters:
+//   - decode: the decoder of the function inputs
+//   - index: the index of the input
+//   - parse: parses the string value of the input
+//   - convert: converts an input that is decoded already
+//   - check: checks the typed value against the constraints of the input, may be nil
+//
+// Returns:
+//   - T: the typed input value, the zero value for an omitted input
+//   - error: an error if the input cannot be decoded or violates its constraints
+func decodeInput[T any](decode InputDecoder, index int, parse func(string) (T, error), convert func(interface{}) (T, bool), check func(*internalstates.InputConstraints, T) error) (T, error) {
+	var typed T
+	text, value, err := decode.Input(index)
+	switch {
+	case err != nil:
+		return typed, err
+	case value != nil:
+		var ok bool
+		typed, ok = convert(value)
+		if !ok {
+			return typed, decode.Invalid(index, fmt.Errorf("is of type %T, expected %T", value, typed))
+		}
+	case text != nil:
+		typed, err = parse(*text)
+		if err != nil {
+			return typed, decode.Invalid(index, fmt.Errorf("cannot be converted to %T: %v", typed, err))
+		}
+	default:
+		return typed, nil
+	}
+
+	if check != nil {
+		err = check(decode.Constraints(index), typed)
+		if err != nil {
+			return typed, decode.Invalid(index, err)
+		}
+	}
+	return typed, nil
+}
+
+// assertInput converts an input that is decoded already by asserting its type
+func assertInput[T any](value interface{}) (T, bool) {
+	typed, ok := value.(T)
+	return typed, ok
+}
+
+// decodeText decodes a string input, e.g. of an enumerable type, taken as it is
+func decodeText[T ~string](decode InputDecoder, index int) (T, error) {
+	parse := func(text string) (T, error) { return T(text), nil }
+	convert := func(value interface{}) (T, bool) {
+		switch value := value.(type) {
+		case T:
+			return value, true
+		case string:
+			return T(value), true
+		}
+		return "", false
+	}
+	check := func(constraints *internalstates.InputConstraints, value T) error {
+		return constraints.CheckText(string(value))
+	}
+	return decodeInput(decode, index, parse, convert, check)
+}
+
+// decodeInt decodes a signed integer input, an empty string is 0
+func decodeInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](decode InputDecoder, index int) (T, error) {
+	parse := func(text string) (T, error) {
+		if text == "" {
+			return 0, nil
+		}
+		number, err := strconv.ParseInt(text, 10, 64)
+		if err == nil && int64(T(number)) != number {
+			err = fmt.Errorf("value %v out of range", number)
+		}
+		return T(number), err
+	}
+	check := func(constraints *internalstates.InputConstraints, value T) error {
+		return constraints.CheckNumber(float64(value))
+	}
+	return decodeInput(decode, index, parse, assertInput[T], check)
+}
+
+// decodeUint decodes an unsigned integer input, an empty string is 0
+func decodeUint[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](decode InputDecoder, index int) (T, error) {
+	parse := func(text string) (T, error) {
+		if text == "" {
+			return 0, nil
+		}
+		number, err := strconv.ParseUint(text, 10, 64)
+		if err == nil && uint64(T(number)) != number {
+			err = fmt.Errorf("value %v out of range", number)
+		}
+		return T(number), err
+	}
+	check := func(constraints *internalstates.InputConstraints, value T) error {
+		return constraints.CheckNumber(float64(value))
+	}
+	return decodeInput(decode, index, parse, assertInput[T], check)
+}
+
+// decodeFloat decodes a floating-point input, an empty string is 0
+func decodeFloat[T ~float32 | ~float64](decode InputDecoder, index int) (T, error) {
+	parse := func(text string) (T, error) {
+		if text == "" {
+			return 0, nil
+		}
+		number, err := strconv.ParseFloat(text, 64)
+		return T(number), err
+	}
+	check := func(constraints *internalstates.InputConstraints, value T) error {
+		return constraints.CheckNumber(float64(value))
+	}
+	return decodeInput(decode, index, parse, assertInput[T], check)
+}
+
+// decodeComplex decodes a complex number input, an empty string is 0
+func decodeComplex[T ~complex64 | ~complex128](decode InputDecoder, index int) (T, error) {
+	parse := func(text string) (T, error) {
+		if text == "" {
+			return 0, nil
+		}
+		number, err := strconv.ParseComplex(text, 128)
+		return T(number), err
+	}
+	return decodeInput(decode, index, parse, assertInput[T], nil)
+}
+
+// decodeBool decodes a boolean input, an empty string is false
+func decodeBool[T ~bool](decode InputDecoder, index int) (T, error) {
+	parse := func(text string) (T, error) {
+		if text == "" {
+			return false, nil
+		}
+		value, err := strconv.ParseBool(text)
+		return T(value), err
+	}
+	return decodeInput(decode, index, parse, assertInput[T], nil)
+}
+
+// decodeJSON decodes an input of any other type from its JSON value, an empty string is the zero value
+func decodeJSON[T any](decode InputDecoder, index int) (T, error) {
+	parse := func(text string) (T, error) {
+		var value T
+		if text == "" {
+			return value, nil
+		}
+		err := json.Unmarshal([]byte(text), &value)
+		return value, err
+	}
+	return decodeInput(decode, index, parse, assertInput[T], nil)
+}
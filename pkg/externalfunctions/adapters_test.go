@@ -0,0 +1,141 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDecoder provides the string values and the already decoded values of the inputs
+type fakeDecoder struct {
+	texts       map[int]string
+	values      map[int]interface{}
+	constraints *internalstates.InputConstraints
+}
+
+func (d *fakeDecoder) Input(index int) (*string, interface{}, error) {
+	if value, ok := d.values[index]; ok {
+		return nil, value, nil
+	}
+	if text, ok := d.texts[index]; ok {
+		return &text, nil, nil
+	}
+	return nil, nil, nil
+}
+
+func (d *fakeDecoder) Constraints(index int) *internalstates.InputConstraints {
+	if d.constraints == nil {
+		return &internalstates.InputConstraints{}
+	}
+	return d.constraints
+}
+
+func (d *fakeDecoder) Invalid(index int, err error) error {
+	return fmt.Errorf("input %d: %v", index, err)
+}
+
+func TestDecodeInputs(t *testing.T) {
+	decoder := &fakeDecoder{
+		texts:  map[int]string{0: "user", 1: "42", 2: "true", 3: `[{"role": "user", "content": "hello"}]`, 4: "", 5: "0.5", 6: "7"},
+		values: map[int]interface{}{7: "assistant", 8: []string{"mesh"}},
+	}
+
+	role, err := decodeText[AppendMessageHistoryRole](decoder, 0)
+	require.NoError(t, err)
+	assert.Equal(t, user, role)
+	number, err := decodeInt[int](decoder, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 42, number)
+	flag, err := decodeBool[bool](decoder, 2)
+	require.NoError(t, err)
+	assert.True(t, flag)
+	history, err := decodeJSON[[]sharedtypes.HistoricMessage](decoder, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []sharedtypes.HistoricMessage{{Role: "user", Content: "hello"}}, history)
+	ratio, err := decodeFloat[float32](decoder, 5)
+	require.NoError(t, err)
+	assert.Equal(t, float32(0.5), ratio)
+	count, err := decodeUint[uint8](decoder, 6)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(7), count)
+
+	// empty strings and omitted inputs are zero values
+	number, err = decodeInt[int](decoder, 4)
+	require.NoError(t, err)
+	assert.Zero(t, number)
+	keywords, err := decodeJSON[[]string](decoder, 9)
+	require.NoError(t, err)
+	assert.Nil(t, keywords)
+
+	// decoded values are taken as they are, strings are converted to enumerable types
+	role, err = decodeText[AppendMessageHistoryRole](decoder, 7)
+	require.NoError(t, err)
+	assert.Equal(t, assistant, role)
+	keywords, err = decodeJSON[[]string](decoder, 8)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mesh"}, keywords)
+}
+
+func TestDecodeInputsInvalid(t *testing.T) {
+	decoder := &fakeDecoder{
+		texts:  map[int]string{0: "many", 1: "300", 2: "[1,"},
+		values: map[int]interface{}{3: 42},
+	}
+
+	_, err := decodeInt[int](decoder, 0)
+	assert.ErrorContains(t, err, `input 0: cannot be converted to int: strconv.ParseInt: parsing "many": invalid syntax`)
+	_, err = decodeUint[uint8](decoder, 1)
+	assert.ErrorContains(t, err, "input 1: cannot be converted to uint8: value 300 out of range")
+	_, err = decodeJSON[[]int](decoder, 2)
+	assert.ErrorContains(t, err, "input 2: cannot be converted to []int")
+	_, err = decodeText[string](decoder, 3)
+	assert.EqualError(t, err, "input 3: is of type int, expected string")
+
+	// the typed values are checked against the constraints of the input
+	minimum := 1.0
+	decoder = &fakeDecoder{texts: map[int]string{0: "0", 1: "slow"}, constraints: &internalstates.InputConstraints{Min: &minimum, Enum: []string{"fast", "exact"}}}
+	_, err = decodeInt[int](decoder, 0)
+	assert.EqualError(t, err, "input 0: must be at least 1, got 0")
+	_, err = decodeText[string](decoder, 1)
+	assert.EqualError(t, err, "input 1: must be one of [fast exact], got 'slow'")
+
+	// errors of the decoder are passed on
+	_, err = decodeBool[bool](&failingDecoder{}, 0)
+	assert.EqualError(t, err, "missing required input")
+}
+
+// failingDecoder fails every input
+type failingDecoder struct {
+	fakeDecoder
+}
+
+func (d *failingDecoder) Input(index int) (*string, interface{}, error) {
+	return nil, nil, errors.New("missing required input")
+}
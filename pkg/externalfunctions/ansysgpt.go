@@ -24,6 +24,7 @@ package externalfunctions
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -34,6 +35,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
@@ -186,21 +189,23 @@ func AnsysGPTExtractFieldsFromQuery(query string, fieldValues map[string][]strin
 //   - @displayName: Rephrase Request New
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - template: the template for the rephrase request
 //   - query: the user query
 //   - history: the conversation history
 //
 // Returns:
 //   - rephrasedQuery: the rephrased query
-func AnsysGPTPerformLLMRephraseRequestNew(template string, query string, history []sharedtypes.HistoricMessage) (rephrasedQuery string) {
-	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
+//   - error: an error if the request to aali-llm fails
+func AnsysGPTPerformLLMRephraseRequestNew(ctx context.Context, template string, query string, history []sharedtypes.HistoricMessage) (rephrasedQuery string, err error) {
+	redact.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
 
 	historyMessages := ""
 
 	if len(history) >= 1 {
 		historyMessages += history[len(history)-2].Content
 	} else {
-		return query
+		return query, nil
 	}
 
 	// Create map for the data to be used in the template
@@ -210,7 +215,7 @@ func AnsysGPTPerformLLMRephraseRequestNew(template string, query string, history
 
 	// Format the template
 	userTemplate := formatTemplate(template, dataMap)
-	logging.Log.Debugf(&logging.ContextMap{}, "User template: %v", userTemplate)
+	redact.Log.Debugf(&logging.ContextMap{}, "User template: %v", userTemplate)
 
 	// create example
 	exampleHistory := make([]sharedtypes.HistoricMessage, 2)
@@ -224,37 +229,40 @@ func AnsysGPTPerformLLMRephraseRequestNew(template string, query string, history
 	}
 
 	// Perform the general request
-	rephrasedQuery, _, err := performGeneralRequest(userTemplate, exampleHistory, false, "You are a query rephrasing assistant. You receive a 'previous user query' as well as a 'current user query' and rephrase the 'current user query' to include any relevant information from the 'previous user query'.", nil)
+	rephrasedQuery, _, err = performGeneralRequest(ctx, userTemplate, exampleHistory, false, "You are a query rephrasing assistant. You receive a 'previous user query' as well as a 'current user query' and rephrase the 'current user query' to include any relevant information from the 'previous user query'.", nil)
 	if err != nil {
-		panic(err)
+		return "", classError(err, ErrUpstreamUnavailable)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Rephrased query: %v", rephrasedQuery)
+	redact.Log.Debugf(&logging.ContextMap{}, "Rephrased query: %v", rephrasedQuery)
 
-	return rephrasedQuery
+	return rephrasedQuery, nil
 }
 
 // AnsysGPTPerformLLMRephraseRequest performs a rephrase request to LLM
 //
 // Tags:
 //   - @displayName: Rephrase Request
+//   - @deprecated: superseded by AnsysGPTPerformLLMRephraseRequestNew
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - template: the template for the rephrase request
 //   - query: the user query
 //   - history: the conversation history
 //
 // Returns:
 //   - rephrasedQuery: the rephrased query
-func AnsysGPTPerformLLMRephraseRequest(userTemplate string, query string, history []sharedtypes.HistoricMessage, systemPrompt string) (rephrasedQuery string) {
-	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
+//   - error: an error if the request to aali-llm fails
+func AnsysGPTPerformLLMRephraseRequest(ctx context.Context, userTemplate string, query string, history []sharedtypes.HistoricMessage, systemPrompt string) (rephrasedQuery string, err error) {
+	redact.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
 
 	historyMessages := ""
 
 	if len(history) >= 1 {
 		historyMessages += "user:" + history[len(history)-2].Content + "\n"
 	} else {
-		return query
+		return query, nil
 	}
 
 	// Create map for the data to be used in the template
@@ -264,17 +272,17 @@ func AnsysGPTPerformLLMRephraseRequest(userTemplate string, query string, histor
 
 	// Format the template
 	userTemplate = formatTemplate(userTemplate, dataMap)
-	logging.Log.Debugf(&logging.ContextMap{}, "User template for repharasing query: %v", userTemplate)
+	redact.Log.Debugf(&logging.ContextMap{}, "User template for repharasing query: %v", userTemplate)
 
 	// Perform the general request
-	rephrasedQuery, _, err := performGeneralRequest(userTemplate, nil, false, systemPrompt, nil)
+	rephrasedQuery, _, err = performGeneralRequest(ctx, userTemplate, nil, false, systemPrompt, nil)
 	if err != nil {
-		panic(err)
+		return "", classError(err, ErrUpstreamUnavailable)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Rephrased query: %v", rephrasedQuery)
+	redact.Log.Debugf(&logging.ContextMap{}, "Rephrased query: %v", rephrasedQuery)
 
-	return rephrasedQuery
+	return rephrasedQuery, nil
 }
 
 // AnsysGPTBuildFinalQuery builds the final query for Ansys GPT
@@ -289,7 +297,7 @@ func AnsysGPTPerformLLMRephraseRequest(userTemplate string, query string, histor
 // Returns:
 //   - finalQuery: the final query
 func AnsysGPTBuildFinalQuery(refrasedQuery string, context []sharedtypes.ACSSearchResponse) (finalQuery string, errorResponse string, displayFixedMessageToUser bool) {
-	logging.Log.Debugf(&logging.ContextMap{}, "Building final query for Ansys GPT with context of length: %v", len(context))
+	redact.Log.Debugf(&logging.ContextMap{}, "Building final query for Ansys GPT with context of length: %v", len(context))
 
 	// check if there is no context
 	if len(context) == 0 {
@@ -313,18 +321,20 @@ func AnsysGPTBuildFinalQuery(refrasedQuery string, context []sharedtypes.ACSSear
 //   - @displayName: LLM Request
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - finalQuery: the final query
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
 //
 // Returns:
 //   - stream: the stream channel
-func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.HistoricMessage, systemPrompt string, isStream bool) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func AnsysGPTPerformLLMRequest(ctx context.Context, finalQuery string, history []sharedtypes.HistoricMessage, systemPrompt string, isStream bool) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(finalQuery, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil)
+	responseChannel := sendChatRequest(ctx, finalQuery, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -332,10 +342,10 @@ func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.Historic
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// else Process all responses
@@ -343,7 +353,7 @@ func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.Historic
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -359,7 +369,7 @@ func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.Historic
 	close(responseChannel)
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // AnsysGPTReturnIndexList returns the index list for Ansys GPT
@@ -392,7 +402,7 @@ func AnsysGPTReturnIndexList(indexGroups []string) (indexList []string) {
 		case "Ansys Semiconductor":
 			indexList = append(indexList, "ansysgpt-scbu")
 		default:
-			logging.Log.Errorf(&logging.ContextMap{}, "Invalid indexGroup: %v\n", indexGroup)
+			redact.Log.Errorf(&logging.ContextMap{}, "Invalid indexGroup: %v\n", indexGroup)
 			return
 		}
 	}
@@ -406,6 +416,7 @@ func AnsysGPTReturnIndexList(indexGroups []string) (indexList []string) {
 //   - @displayName: ACS Semantic Hybrid Search
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - query: the query string
 //   - embeddedQuery: the embedded query
 //   - indexList: the index list
@@ -421,7 +432,8 @@ func AnsysGPTReturnIndexList(indexGroups []string) (indexList []string) {
 //
 // Returns:
 //   - output: the search results
-func AnsysGPTACSSemanticHybridSearchs(
+//   - error: an error if the search fails
+func AnsysGPTACSSemanticHybridSearchs(ctx context.Context,
 	acsEndpoint string,
 	acsApiKey string,
 	acsApiVersion string,
@@ -429,19 +441,18 @@ func AnsysGPTACSSemanticHybridSearchs(
 	embeddedQuery []float32,
 	indexList []string,
 	filter map[string]string,
-	topK int) (output []sharedtypes.ACSSearchResponse) {
+	topK int) (output []sharedtypes.ACSSearchResponse, err error) {
 
 	output = make([]sharedtypes.ACSSearchResponse, 0)
 	for _, indexName := range indexList {
-		partOutput, err := ansysGPTACSSemanticHybridSearch(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexName, filter, topK, false, nil)
+		partOutput, err := ansysGPTACSSemanticHybridSearch(ctx, acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexName, filter, topK, false, nil)
 		if err != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error in semantic hybrid search: %v", err)
-			panic(err)
+			return nil, classError(fmt.Errorf("Error in semantic hybrid search: %w", err), ErrUpstreamUnavailable)
 		}
 		output = append(output, partOutput...)
 	}
 
-	return output
+	return output, nil
 }
 
 // AnsysGPTRemoveNoneCitationsFromSearchResponse removes none citations from search response
@@ -485,7 +496,7 @@ func AnsysGPTRemoveNoneCitationsFromSearchResponse(semanticSearchOutput []shared
 // Returns:
 //   - reorderedSemanticSearchOutput: the reordered search response
 func AnsysGPTReorderSearchResponseAndReturnOnlyTopK(semanticSearchOutput []sharedtypes.ACSSearchResponse, topK int) (reorderedSemanticSearchOutput []sharedtypes.ACSSearchResponse) {
-	logging.Log.Debugf(&logging.ContextMap{}, "Reordering search response of length %v based on reranker_score and returning only top %v results", len(semanticSearchOutput), topK)
+	redact.Log.Debugf(&logging.ContextMap{}, "Reordering search response of length %v based on reranker_score and returning only top %v results", len(semanticSearchOutput), topK)
 	// Sorting by Weight * SearchRerankerScore in descending order
 	sort.Slice(semanticSearchOutput, func(i, j int) bool {
 		return semanticSearchOutput[i].Weight*semanticSearchOutput[i].SearchRerankerScore > semanticSearchOutput[j].Weight*semanticSearchOutput[j].SearchRerankerScore
@@ -524,7 +535,7 @@ func AnsysGPTGetSystemPrompt(query string, prohibitedWords []string, template st
 
 	// Format the template
 	systemTemplate := formatTemplate(template, dataMap)
-	logging.Log.Debugf(&logging.ContextMap{}, "System prompt for final query: %v", systemTemplate)
+	redact.Log.Debugf(&logging.ContextMap{}, "System prompt for final query: %v", systemTemplate)
 
 	// return system prompt
 	return systemTemplate
@@ -536,6 +547,7 @@ func AnsysGPTGetSystemPrompt(query string, prohibitedWords []string, template st
 //   - @displayName: AIS Rephrase Request
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - systemTemplate: the system template for the rephrase request
 //   - userTemplate: the user template for the rephrase request
 //   - query: the user query
@@ -543,8 +555,9 @@ func AnsysGPTGetSystemPrompt(query string, prohibitedWords []string, template st
 //
 // Returns:
 //   - rephrasedQuery: the rephrased query
-func AisPerformLLMRephraseRequest(systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage, tokenCountModelName string) (rephrasedQuery string, inputTokenCount int, outputTokenCount int) {
-	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
+//   - error: an error if the request to aali-llm fails or the tokens cannot be counted
+func AisPerformLLMRephraseRequest(ctx context.Context, systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage, tokenCountModelName string) (rephrasedQuery string, inputTokenCount int, outputTokenCount int, err error) {
+	redact.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
 
 	// create "chat_history" string
 	historyMessages := ""
@@ -564,9 +577,9 @@ func AisPerformLLMRephraseRequest(systemTemplate string, userTemplate string, qu
 
 	// Format the user and system template
 	userPrompt := formatTemplate(userTemplate, dataMap)
-	logging.Log.Debugf(&logging.ContextMap{}, "User template for repharasing query: %v", userTemplate)
+	redact.Log.Debugf(&logging.ContextMap{}, "User template for repharasing query: %v", userTemplate)
 	systemPrompt := formatTemplate(systemTemplate, dataMap)
-	logging.Log.Debugf(&logging.ContextMap{}, "System template for repharasing query: %v", systemTemplate)
+	redact.Log.Debugf(&logging.ContextMap{}, "System template for repharasing query: %v", systemTemplate)
 
 	// create options
 	var maxTokens int32 = 500
@@ -577,24 +590,24 @@ func AisPerformLLMRephraseRequest(systemTemplate string, userTemplate string, qu
 	}
 
 	// Perform the general request
-	rephrasedQuery, _, err := performGeneralRequest(userPrompt, nil, false, systemPrompt, options)
+	rephrasedQuery, _, err = performGeneralRequest(ctx, userPrompt, nil, false, systemPrompt, options)
 	if err != nil {
-		panic(err)
+		return "", 0, 0, classError(err, ErrUpstreamUnavailable)
 	}
 
 	// calculate input and output token count
 	inputTokenCount, err = openAiTokenCount(tokenCountModelName, userPrompt+systemPrompt)
 	if err != nil {
-		panic(err)
+		return "", 0, 0, logError(nil, ErrInvalidInput, "Error getting input token count: %v", err)
 	}
 	outputTokenCount, err = openAiTokenCount(tokenCountModelName, rephrasedQuery)
 	if err != nil {
-		panic(err)
+		return "", 0, 0, logError(nil, ErrInvalidInput, "Error getting output token count: %v", err)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Rephrased query: %v", rephrasedQuery)
+	redact.Log.Debugf(&logging.ContextMap{}, "Rephrased query: %v", rephrasedQuery)
 
-	return rephrasedQuery, inputTokenCount, outputTokenCount
+	return rephrasedQuery, inputTokenCount, outputTokenCount, nil
 }
 
 // AisReturnIndexList returns the index list for AIS
@@ -669,7 +682,7 @@ func AisReturnIndexList(accessPoint string, physics []string, version []string)
 			"scbu-data-except-alh",
 		)
 	default:
-		logging.Log.Errorf(&logging.ContextMap{}, "Invalid accessPoint: %v\n", accessPoint)
+		redact.Log.Errorf(&logging.ContextMap{}, "Invalid accessPoint: %v\n", accessPoint)
 		return
 	}
 
@@ -682,6 +695,11 @@ func AisReturnIndexList(accessPoint string, physics []string, version []string)
 //   - @displayName: AIS ACS Semantic Hybrid Search
 //
 // Parameters:
+//   - ctx: the context of the request
+//   - acsEndpoint: the ACS endpoint
+//   - acsApiKey: the ACS API key
+//   - @secret
+//   - acsApiVersion: the ACS API version
 //   - query: the query string
 //   - embeddedQuery: the embedded query
 //   - indexList: the index list
@@ -690,7 +708,8 @@ func AisReturnIndexList(accessPoint string, physics []string, version []string)
 //
 // Returns:
 //   - output: the search results
-func AisAcsSemanticHybridSearchs(
+//   - error: an error if the search fails on every index
+func AisAcsSemanticHybridSearchs(ctx context.Context,
 	acsEndpoint string,
 	acsApiKey string,
 	acsApiVersion string,
@@ -698,10 +717,11 @@ func AisAcsSemanticHybridSearchs(
 	embeddedQuery []float32,
 	indexList []string,
 	physics []string,
-	topK int) []sharedtypes.ACSSearchResponse {
+	topK int) ([]sharedtypes.ACSSearchResponse, error) {
 
-	// Create a channel to collect results
+	// Create channels to collect the results and errors
 	resultChan := make(chan []sharedtypes.ACSSearchResponse, len(indexList))
+	errorChan := make(chan error, len(indexList))
 
 	// Create a WaitGroup to ensure all goroutines complete
 	var wg sync.WaitGroup
@@ -710,34 +730,22 @@ func AisAcsSemanticHybridSearchs(
 	// Launch a goroutine for each index
 	for _, indexName := range indexList {
 		go func(idx string) {
-			defer func() {
-				r := recover()
-				if r != nil {
-					logging.Log.Errorf(&logging.ContextMap{}, "panic in paralell processing of ACS requests: %v", r)
-				}
-			}()
 			defer wg.Done()
 			// Run the search for this index
-			result, err := ansysGPTACSSemanticHybridSearch(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, idx, nil, topK, true, physics)
+			result, err := ansysGPTACSSemanticHybridSearch(ctx, acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, idx, nil, topK, true, physics)
 			if err != nil {
-				logging.Log.Errorf(&logging.ContextMap{}, "Error in semantic hybrid search: %v", err)
+				redact.Log.Errorf(&logging.ContextMap{}, "Error in semantic hybrid search: %v", err)
+				errorChan <- err
 				return
 			}
 			resultChan <- result
 		}(indexName)
 	}
 
-	// Launch a goroutine to close the channel once all searches are complete
-	go func() {
-		defer func() {
-			r := recover()
-			if r != nil {
-				logging.Log.Errorf(&logging.ContextMap{}, "panic in closing ACS result channel: %v", r)
-			}
-		}()
-		wg.Wait()
-		close(resultChan)
-	}()
+	// Wait for all searches to complete
+	wg.Wait()
+	close(resultChan)
+	close(errorChan)
 
 	// Collect all results
 	var output []sharedtypes.ACSSearchResponse
@@ -745,7 +753,12 @@ func AisAcsSemanticHybridSearchs(
 		output = append(output, results...)
 	}
 
-	return output
+	// The searches on the other indexes still answer if some indexes fail
+	if len(indexList) > 0 && len(errorChan) == len(indexList) {
+		return nil, classError(<-errorChan, ErrUpstreamUnavailable)
+	}
+
+	return output, nil
 }
 
 // AisChangeAcsResponsesByFactor changes the ACS responses by a factor
@@ -790,6 +803,7 @@ func AisChangeAcsResponsesByFactor(factors map[string]float64, semanticSearchOut
 //   - @displayName: AEC Get Context from Retriever Module
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - retrieverModuleEndpoint: the endpoint of the retriever module
 //   - userQuery: the user query
 //   - dataSources: the data sources
@@ -800,14 +814,15 @@ func AisChangeAcsResponsesByFactor(factors map[string]float64, semanticSearchOut
 //
 // Returns:
 //   - context: the context retrieved from the retriever module
-func AecGetContextFromRetrieverModule(
+//   - error: an error if the request to the retriever module fails
+func AecGetContextFromRetrieverModule(ctx context.Context,
 	retrieverModuleEndpoint string,
 	userQuery string,
 	dataSources []string,
 	physics []string,
 	topK int,
 	plattform string,
-	retrieverModuleKey string) (context []sharedtypes.AnsysGPTRetrieverModuleChunk) {
+	retrieverModuleKey string) (context []sharedtypes.AnsysGPTRetrieverModuleChunk, err error) {
 
 	// Prepare properties
 	if len(physics) == 0 {
@@ -818,7 +833,8 @@ func AecGetContextFromRetrieverModule(
 
 	// Create HTTP client with timeout
 	client := &http.Client{
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
+		Transport: tracing.NewTransport(nil),
 	}
 
 	// Create the request body
@@ -833,13 +849,13 @@ func AecGetContextFromRetrieverModule(
 	// Marshal the request body to JSON
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
-		panic(fmt.Errorf("error marshalling request body: %v", err))
+		return nil, logError(nil, ErrUpstreamUnavailable, "error marshalling request body: %v", err)
 	}
 
 	// Create a new HTTP request
-	request, err := http.NewRequest("POST", retrieverModuleEndpoint, bytes.NewBuffer(jsonBody))
+	request, err := http.NewRequestWithContext(ctx, "POST", retrieverModuleEndpoint, bytes.NewBuffer(jsonBody))
 	if err != nil {
-		panic(fmt.Errorf("error creating request: %v", err))
+		return nil, logError(nil, ErrUpstreamUnavailable, "error creating request: %v", err)
 	}
 
 	// Set headers
@@ -849,22 +865,22 @@ func AecGetContextFromRetrieverModule(
 	// Create an HTTP client and make the request
 	resp, err := client.Do(request)
 	if err != nil {
-		panic(fmt.Errorf("error making request: %v", err))
+		return nil, logError(nil, ErrUpstreamUnavailable, "error making request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	// Check the response status
 	if resp.StatusCode != 200 {
-		panic(fmt.Errorf("error response from retriever module: %v", resp.Status))
+		return nil, logError(nil, ErrUpstreamUnavailable, "error response from retriever module: %v", resp.Status)
 	}
 
 	// Parse the response
 	response := map[string]sharedtypes.AnsysGPTRetrieverModuleChunk{}
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	if err != nil {
-		panic(fmt.Errorf("error decoding response: %v", err))
+		return nil, logError(nil, ErrUpstreamUnavailable, "error decoding response: %v", err)
 	}
-	logging.Log.Debugf(&logging.ContextMap{}, "Received response from retriever module: %v", response)
+	redact.Log.Debugf(&logging.ContextMap{}, "Received response from retriever module: %v", response)
 
 	// Extract the context from the response
 	context = make([]sharedtypes.AnsysGPTRetrieverModuleChunk, len(response))
@@ -872,17 +888,20 @@ func AecGetContextFromRetrieverModule(
 		// Extract int from chunkNum
 		_, chunkNumstring, found := strings.Cut(chunkNum, "chunk ")
 		if !found {
-			panic(fmt.Errorf("error extracting chunk number from '%v'", chunkNum))
+			return nil, logError(nil, ErrUpstreamUnavailable, "error extracting chunk number from '%v'", chunkNum)
 		}
 		chunkNumInt, err := strconv.Atoi(chunkNumstring)
 		if err != nil {
-			panic(fmt.Errorf("error converting chunk number to int: %v", err))
+			return nil, logError(nil, ErrUpstreamUnavailable, "error converting chunk number to int: %v", err)
+		}
+		if chunkNumInt < 1 || chunkNumInt > len(context) {
+			return nil, logError(nil, ErrUpstreamUnavailable, "chunk number %v out of range", chunkNumInt)
 		}
 		// Store the chunk in the context slice
 		context[chunkNumInt-1] = chunk
 	}
 
-	return context
+	return context, nil
 }
 
 // AecPerformLLMFinalRequest performs a final request to LLM
@@ -891,6 +910,7 @@ func AecGetContextFromRetrieverModule(
 //   - @displayName: AEC Final Request
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - systemTemplate: the system template for the final request
 //   - userTemplate: the user template for the final request
 //   - query: the user query
@@ -901,7 +921,8 @@ func AecGetContextFromRetrieverModule(
 //
 // Returns:
 //   - stream: the stream channel
-func AecPerformLLMFinalRequest(systemTemplate string,
+//   - error: an error if the request to aali-llm fails or the tokens cannot be counted
+func AecPerformLLMFinalRequest(ctx context.Context, systemTemplate string,
 	userTemplate string,
 	query string,
 	history []sharedtypes.HistoricMessage,
@@ -915,9 +936,9 @@ func AecPerformLLMFinalRequest(systemTemplate string,
 	tokenCountModelName string,
 	isStream bool,
 	userEmail string,
-	jwtToken string) (message string, stream *chan string) {
+	jwtToken string) (message string, stream *chan string, err error) {
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM final request")
+	redact.Log.Debugf(&logging.ContextMap{}, "Performing LLM final request")
 
 	// create "chat_history" string
 	historyMessages := ""
@@ -938,8 +959,7 @@ func AecPerformLLMFinalRequest(systemTemplate string,
 		for _, example := range context {
 			json, err := json.Marshal(example)
 			if err != nil {
-				logging.Log.Errorf(&logging.ContextMap{}, "Error marshalling context: %v", err)
-				return "", nil
+				return "", nil, logError(nil, ErrInvalidInput, "Error marshalling context: %v", err)
 			}
 			contextString += fmt.Sprintf("\"chunk %v\": %v", chunkNr, string(json)) + ", "
 			chunkNr++
@@ -978,9 +998,9 @@ func AecPerformLLMFinalRequest(systemTemplate string,
 
 	// Format the user and system template
 	userPrompt := formatTemplate(userTemplate, dataMap)
-	logging.Log.Debugf(&logging.ContextMap{}, "User template for final query: %v", userPrompt)
+	redact.Log.Debugf(&logging.ContextMap{}, "User template for final query: %v", userPrompt)
 	systemPrompt := formatTemplate(systemTemplate, dataMap)
-	logging.Log.Debugf(&logging.ContextMap{}, "System template for final query: %v", systemPrompt)
+	redact.Log.Debugf(&logging.ContextMap{}, "System template for final query: %v", systemPrompt)
 
 	// create options
 	var maxTokens int32 = 2000
@@ -990,24 +1010,24 @@ func AecPerformLLMFinalRequest(systemTemplate string,
 		Temperature: &temperature,
 	}
 
+	// calculate input token count before sending the request
+	inputTokenCount, err := openAiTokenCount(tokenCountModelName, userPrompt+systemPrompt)
+	if err != nil {
+		return "", nil, logError(nil, ErrInvalidInput, "Error getting input token count: %v", err)
+	}
+	totalInputTokenCount := previousInputTokenCount + inputTokenCount
+
 	// get the LLM handler endpoint.
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequest(userPrompt, "general", nil, 0, systemPrompt, llmHandlerEndpoint, nil, options, nil)
+	responseChannel, answered := sendChatRequestWithModel(ctx, userPrompt, "general", nil, 0, systemPrompt, llmHandlerEndpoint, nil, options, nil)
 
 	// Create a stream channel
 	streamChannel := make(chan string, 400)
 
-	// calculate input token count
-	inputTokenCount, err := openAiTokenCount(tokenCountModelName, userPrompt+systemPrompt)
-	if err != nil {
-		panic(err)
-	}
-	totalInputTokenCount := previousInputTokenCount + inputTokenCount
-
 	// Start a goroutine to transfer the data from the response channel to the stream channel.
-	go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, true, tokenCountEndpoint, totalInputTokenCount, previousOutputTokenCount, tokenCountModelName, jwtToken, userEmail, true, contextString)
+	go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, true, tokenCountEndpoint, totalInputTokenCount, previousOutputTokenCount, tokenCountModelName, jwtToken, userEmail, true, contextString, answered)
 
-	return "", &streamChannel
+	return "", &streamChannel, nil
 }
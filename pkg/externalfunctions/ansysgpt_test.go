@@ -0,0 +1,170 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rephraseHistory is the conversation history of the rephrase request tests
+var rephraseHistory = []sharedtypes.HistoricMessage{
+	{Role: "user", Content: "How to create a beam?"},
+	{Role: "assistant", Content: "Use the beam tool."},
+}
+
+func TestAnsysGPTPerformLLMRequest(t *testing.T) {
+	mock := serveLLMFixtures(t)
+
+	message, stream, err := AnsysGPTPerformLLMRequest(context.Background(), "What is a beam?", rephraseHistory, "Be brief.", false)
+	require.NoError(t, err)
+	assert.Equal(t, "What is a beam?", message)
+	assert.Nil(t, stream)
+
+	_, stream, err = AnsysGPTPerformLLMRequest(context.Background(), "What is a beam?", nil, "", true)
+	require.NoError(t, err)
+	assert.Equal(t, "What is a beam?", readStream(t, stream))
+	assert.NoError(t, StreamError(stream))
+
+	request := mock.Requests()[0]
+	assert.Equal(t, "Be brief.", request.SystemPrompt)
+	assert.Equal(t, rephraseHistory, request.ConversationHistory)
+
+	_, _, err = AnsysGPTPerformLLMRequest(context.Background(), "please fail", nil, "", false)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestAnsysGPTPerformLLMRephraseRequestNew(t *testing.T) {
+	mock := serveLLMFixtures(t)
+
+	// the previous user query is filled into the template, which the mock echoes
+	rephrased, err := AnsysGPTPerformLLMRephraseRequestNew(context.Background(), "{chat_history} | {query}", "How to make it larger?", rephraseHistory)
+	require.NoError(t, err)
+	assert.Equal(t, "How to create a beam? | How to make it larger?", rephrased)
+
+	request := mock.Requests()[0]
+	assert.Contains(t, request.SystemPrompt, "query rephrasing assistant")
+	assert.Len(t, request.ConversationHistory, 2)
+
+	// queries without history are not rephrased
+	rephrased, err = AnsysGPTPerformLLMRephraseRequestNew(context.Background(), "{query}", "How to make it larger?", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "How to make it larger?", rephrased)
+	assert.Len(t, mock.Requests(), 1)
+
+	_, err = AnsysGPTPerformLLMRephraseRequestNew(context.Background(), "please fail", "How to make it larger?", rephraseHistory)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestAnsysGPTPerformLLMRephraseRequest(t *testing.T) {
+	mock := serveLLMFixtures(t)
+
+	rephrased, err := AnsysGPTPerformLLMRephraseRequest(context.Background(), "{chat_history}{query}", "How to make it larger?", rephraseHistory, "Rephrase.")
+	require.NoError(t, err)
+	assert.Equal(t, "user:How to create a beam?\nHow to make it larger?", rephrased)
+	assert.Equal(t, "Rephrase.", mock.Requests()[0].SystemPrompt)
+
+	// queries without history are not rephrased
+	rephrased, err = AnsysGPTPerformLLMRephraseRequest(context.Background(), "{query}", "How to make it larger?", nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "How to make it larger?", rephrased)
+	assert.Len(t, mock.Requests(), 1)
+}
+
+func TestAisPerformLLMRephraseRequest(t *testing.T) {
+	mock := serveLLMFixtures(t)
+
+	rephrased, inputTokenCount, outputTokenCount, err := AisPerformLLMRephraseRequest(context.Background(), "History: {chat_history}", "{query}", "How to make it larger?", rephraseHistory, "gpt-4o")
+	require.NoError(t, err)
+	assert.Equal(t, "How to make it larger?", rephrased)
+
+	// the history is formatted into the system prompt
+	systemPrompt := "History: \"User\": \"How to create a beam?\"\n\"AI\": \"Use the beam tool.\"\n"
+	assert.Equal(t, tokenCount(t, "gpt-4o", "How to make it larger?"+systemPrompt), inputTokenCount)
+	assert.Equal(t, tokenCount(t, "gpt-4o", rephrased), outputTokenCount)
+
+	request := mock.Requests()[0]
+	assert.Equal(t, systemPrompt, request.SystemPrompt)
+	assert.EqualValues(t, 500, *request.ModelOptions.MaxTokens)
+	assert.Zero(t, *request.ModelOptions.Temperature)
+
+	_, _, _, err = AisPerformLLMRephraseRequest(context.Background(), "", "please fail", "", nil, "gpt-4o")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	_, _, _, err = AisPerformLLMRephraseRequest(context.Background(), "", "How to make it larger?", "", nil, "llama")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestAecPerformLLMFinalRequest(t *testing.T) {
+	mock := serveLLMFixtures(t)
+	tokenCounts := make(chan TokenCountUpdateRequest, 1)
+	tokenCountServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer jwt", r.Header.Get("Authorization"))
+		var update TokenCountUpdateRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		tokenCounts <- update
+	}))
+	t.Cleanup(tokenCountServer.Close)
+
+	_, stream, err := AecPerformLLMFinalRequest(context.Background(), "Answer with {prohibit_word_list}", "{chat_history}{query}", "What is a beam?",
+		rephraseHistory, nil, []string{"secret"}, nil, nil, tokenCountServer.URL, 10, 5, "gpt-4o", true, "user@example.com", "jwt")
+	require.NoError(t, err)
+
+	// the answer is followed by the token counts and the context
+	userPrompt := "`HumanMessage`: `How to create a beam?`\n`AIMessage`: `Use the beam tool.`\nWhat is a beam?"
+	inputTokenCount := 10 + tokenCount(t, "gpt-4o", userPrompt+"Answer with secret, ")
+	outputTokenCount := 5 + tokenCount(t, "gpt-4o", userPrompt)
+	assert.Equal(t, userPrompt+fmt.Sprintf("$&$input_token_count$&$:$&$%d$&$;$&$output_token_count$&$:$&$%d$&$;$&$context$&$:$&$$&$;", inputTokenCount, outputTokenCount), readStream(t, stream))
+	assert.NoError(t, StreamError(stream))
+	assert.Equal(t, TokenCountUpdateRequest{InputToken: inputTokenCount, OutputToken: outputTokenCount, Platform: "Eng. Copilot"}, <-tokenCounts)
+
+	request := mock.Requests()[0]
+	assert.Equal(t, "Answer with secret, ", request.SystemPrompt)
+	assert.Empty(t, request.ConversationHistory)
+	assert.EqualValues(t, 2000, *request.ModelOptions.MaxTokens)
+}
+
+func TestAecPerformLLMFinalRequestTokenCountError(t *testing.T) {
+	serveLLMFixtures(t)
+	tokenCountServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(tokenCountServer.Close)
+
+	// the answer is still streamed, but the stream ends with the error
+	_, stream, err := AecPerformLLMFinalRequest(context.Background(), "", "{query}", "What is a beam?", nil, nil, nil, nil, nil, tokenCountServer.URL, 0, 0, "gpt-4o", true, "", "jwt")
+	require.NoError(t, err)
+	message := readStream(t, stream)
+	assert.Equal(t, "What is a beam?$&$context$&$:$&$$&$;", message)
+	err = StreamError(stream)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUpstreamUnavailable)
+	assert.ErrorContains(t, err, "error in updating token count")
+}
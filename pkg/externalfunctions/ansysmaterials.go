@@ -23,12 +23,14 @@
 package externalfunctions
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
 	"sync"
 
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
@@ -59,7 +61,7 @@ func SerializeResponse(criteriaSuggestions []sharedtypes.MaterialCriterionWithGu
 
 	responseJson, err := json.Marshal(response)
 	if err != nil {
-		logging.Log.Debugf(&logging.ContextMap{}, "Failed to serialize suggested criteria into json: %v", err)
+		redact.Log.Debugf(&logging.ContextMap{}, "Failed to serialize suggested criteria into json: %v", err)
 		panic("Failed to serialize suggested criteria into json")
 	}
 
@@ -88,7 +90,7 @@ func AddGuidsToAttributes(criteriaSuggestions []sharedtypes.MaterialLlmCriterion
 		guid, exists := attributeMap[lowerAttrName]
 
 		if !exists {
-			logging.Log.Debugf(&logging.ContextMap{}, "Could not find attribute to match: %s", lowerAttrName)
+			redact.Log.Debugf(&logging.ContextMap{}, "Could not find attribute to match: %s", lowerAttrName)
 			panic("Could not find attribute to match")
 		}
 
@@ -125,7 +127,7 @@ func FilterOutNonExistingAttributes(criteriaSuggestions []sharedtypes.MaterialLl
 		if attributeMap[strings.ToLower(suggestion.AttributeName)] {
 			filteredCriteria = append(filteredCriteria, suggestion)
 		} else {
-			logging.Log.Debugf(&logging.ContextMap{}, "Filtered out non existing attribute: %s", suggestion.AttributeName)
+			redact.Log.Debugf(&logging.ContextMap{}, "Filtered out non existing attribute: %s", suggestion.AttributeName)
 		}
 	}
 
@@ -169,23 +171,23 @@ func FilterOutDuplicateAttributes(criteriaSuggestions []sharedtypes.MaterialLlmC
 func ExtractCriteriaSuggestions(llmResponse string) (criteriaSuggestions []sharedtypes.MaterialLlmCriterion) {
 	criteriaText := ExtractJson(llmResponse)
 	if criteriaText == "" {
-		logging.Log.Debugf(&logging.ContextMap{}, "No valid JSON found in LLM response: %s", llmResponse)
+		redact.Log.Debugf(&logging.ContextMap{}, "No valid JSON found in LLM response: %s", llmResponse)
 		return nil
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Attempting to parse JSON:\n%s", criteriaText)
+	redact.Log.Debugf(&logging.ContextMap{}, "Attempting to parse JSON:\n%s", criteriaText)
 
 	var criteria LlmCriteria
 	err := json.Unmarshal([]byte(criteriaText), &criteria)
 	if err != nil {
-		logging.Log.Debugf(&logging.ContextMap{}, "Failed to deserialize criteria JSON from LLM response: %v; Raw JSON: %s", err, criteriaText)
+		redact.Log.Debugf(&logging.ContextMap{}, "Failed to deserialize criteria JSON from LLM response: %v; Raw JSON: %s", err, criteriaText)
 		return nil
 	}
 
 	if len(criteria.Criteria) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "Deserialized JSON successfully but found 0 criteria. Object: %+v", criteria)
+		redact.Log.Debugf(&logging.ContextMap{}, "Deserialized JSON successfully but found 0 criteria. Object: %+v", criteria)
 	} else {
-		logging.Log.Debugf(&logging.ContextMap{}, "Successfully extracted %d criteria.", len(criteria.Criteria))
+		redact.Log.Debugf(&logging.ContextMap{}, "Successfully extracted %d criteria.", len(criteria.Criteria))
 	}
 	return criteria.Criteria
 }
@@ -198,6 +200,7 @@ func ExtractCriteriaSuggestions(llmResponse string) (criteriaSuggestions []share
 //   - @displayName: Multiple General LLM Requests (Specific Models, No Stream, Attribute Extraction, OpenAI Token Output)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input string
 //   - history: the conversation history for context
 //   - systemPrompt: the system prompt to guide the LLM
@@ -208,12 +211,12 @@ func ExtractCriteriaSuggestions(llmResponse string) (criteriaSuggestions []share
 // Returns:
 //   - uniqueCriterion: a deduplicated list of extracted attributes (criteria) from all responses
 //   - tokenCount: the total token count (input tokens × n + combined output tokens)
-func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, tokenCountModelName string, n int) (uniqueCriterion []sharedtypes.MaterialLlmCriterion, tokenCount int) {
+func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, tokenCountModelName string, n int) (uniqueCriterion []sharedtypes.MaterialLlmCriterion, tokenCount int) {
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Helper function to send a request and get the response as string
 	sendRequest := func() string {
-		responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
+		responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
 		defer close(responseChannel)
 
 		var responseStr string
@@ -229,7 +232,7 @@ func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(inp
 		return responseStr
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "System prompt: %s", systemPrompt)
+	redact.Log.Debugf(&logging.ContextMap{}, "System prompt: %s", systemPrompt)
 
 	// Collect all responses
 	allResponses := runRequestsInParallel(n, sendRequest)
@@ -253,10 +256,10 @@ func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(inp
 	outputTokenCount := getTokenCount(tokenCountModelName, combinedResponseText)
 
 	var totalTokenCount = inputTokenCount*n + outputTokenCount
-	logging.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
+	redact.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
 
 	if len(allCriteria) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "No valid criteria found in any response")
+		redact.Log.Debugf(&logging.ContextMap{}, "No valid criteria found in any response")
 		return []sharedtypes.MaterialLlmCriterion{}, outputTokenCount
 	}
 
@@ -276,7 +279,7 @@ func runRequestsInParallel(n int, sendRequest func() string) []string {
 			defer wg.Done()
 			defer func() {
 				if r := recover(); r != nil {
-					logging.Log.Errorf(&logging.ContextMap{}, "Recovered from panic in LLM request: %v", r)
+					redact.Log.Errorf(&logging.ContextMap{}, "Recovered from panic in LLM request: %v", r)
 				}
 			}()
 			response := sendRequest()
@@ -291,7 +294,7 @@ func runRequestsInParallel(n int, sendRequest func() string) []string {
 
 	var allResponses []string
 	for response := range responseChan {
-		logging.Log.Debugf(&logging.ContextMap{}, "Raw LLM response: %s", response)
+		redact.Log.Debugf(&logging.ContextMap{}, "Raw LLM response: %s", response)
 		allResponses = append(allResponses, response)
 	}
 	return allResponses
@@ -313,7 +316,7 @@ func ExtractJson(text string) (json string) {
 		return strings.TrimSpace(matches[0])
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "No valid JSON found in response %s", text)
+	redact.Log.Debugf(&logging.ContextMap{}, "No valid JSON found in response %s", text)
 	return ""
 }
 
@@ -328,7 +331,7 @@ func ExtractJson(text string) (json string) {
 // Returns:
 //   - none
 func LogRequestSuccess() {
-	logging.Log.Infof(&logging.ContextMap{}, "Request successful")
+	redact.Log.Infof(&logging.ContextMap{}, "Request successful")
 	return
 }
 
@@ -343,7 +346,7 @@ func LogRequestSuccess() {
 // Returns:
 //   - none
 func LogRequestFailed() {
-	logging.Log.Infof(&logging.ContextMap{}, "Request failed")
+	redact.Log.Infof(&logging.ContextMap{}, "Request failed")
 	return
 }
 
@@ -359,6 +362,6 @@ func LogRequestFailed() {
 // Returns:
 //   - none
 func LogRequestFailedDebugWithMessage(msg1, msg2 string) {
-	logging.Log.Debugf(&logging.ContextMap{}, "Request failed:%s %s", msg1, msg2)
+	redact.Log.Debugf(&logging.ContextMap{}, "Request failed:%s %s", msg1, msg2)
 	return
 }
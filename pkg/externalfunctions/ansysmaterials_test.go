@@ -0,0 +1,72 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/llmmock"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// criteriaAnswer is an answer of the mock aali-llm suggesting material criteria
+const criteriaAnswer = `The criteria are: {"criteria": [
+	{"attributeName": "Density", "explanation": "light", "confidence": 0.9},
+	{"attributeName": "density", "explanation": "light again", "confidence": 0.5},
+	{"attributeName": "Yield Strength", "explanation": "strong", "confidence": 0.8}
+]}`
+
+func TestPerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(t *testing.T) {
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{
+		{Times: 1, Error: &llmmock.Error{Code: 400, Message: "invalid request"}},
+		{Chat: criteriaAnswer},
+	}})
+
+	// the failed request is left out, the criteria of the others are deduplicated by name
+	criteria, count := PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(context.Background(), "A light and strong material.", nil, "Suggest criteria.", []string{"gpt-4o"}, "gpt-4o", 3)
+	assert.Equal(t, []sharedtypes.MaterialLlmCriterion{
+		{AttributeName: "Density", Explanation: "light", Confidence: 0.9},
+		{AttributeName: "Yield Strength", Explanation: "strong", Confidence: 0.8},
+	}, criteria)
+	assert.Equal(t, 3*tokenCount(t, "gpt-4o", "A light and strong material.")+tokenCount(t, "gpt-4o", criteriaAnswer+criteriaAnswer), count)
+
+	requests := mock.Requests()
+	assert.Len(t, requests, 3)
+	for _, request := range requests {
+		assert.Equal(t, "Suggest criteria.", request.SystemPrompt)
+		assert.Equal(t, []string{"gpt-4o"}, request.ModelIds)
+	}
+}
+
+func TestPerformMultipleGeneralRequestsAndExtractAttributesWithoutCriteria(t *testing.T) {
+	serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{{Chat: "No criteria."}}})
+
+	// only the output tokens are counted without criteria
+	criteria, count := PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(context.Background(), "A material.", nil, "", nil, "gpt-4o", 2)
+	assert.Empty(t, criteria)
+	assert.NotNil(t, criteria)
+	assert.Equal(t, tokenCount(t, "gpt-4o", strings.Repeat("No criteria.", 2)), count)
+}
@@ -31,12 +31,14 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/ansys/aali-flowkit/pkg/tracing"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/russross/blackfriday/v2"
 
 	"github.com/ansys/aali-flowkit/pkg/meshpilot/ampgraphdb"
 	"github.com/ansys/aali-flowkit/pkg/meshpilot/azure"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
 	"github.com/qdrant/go-client/qdrant"
@@ -50,128 +52,98 @@ import (
 //   - @displayName: SimilartitySearchOnPathDescriptions
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - instruction: the user query
 //   - toolName: the tool name
 //
 // Returns:
 //   - descriptions: the list of descriptions
-func SimilartitySearchOnPathDescriptions(instruction string, toolName string) (descriptions []string) {
+//   - error: an error if the tool name is invalid or the similarity search fails
+func SimilartitySearchOnPathDescriptions(ctx context.Context, instruction string, toolName string) (descriptions []string, err error) {
 	descriptions = []string{}
-	ctx := &logging.ContextMap{}
+	logCtx := &logging.ContextMap{}
 
 	db_endpoint := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["MESHPILOT_DB_ENDPOINT"]
-	logging.Log.Debugf(ctx, "DB Endpoint: %q", db_endpoint)
+	redact.Log.Debugf(logCtx, "DB Endpoint: %q", db_endpoint)
 
 	toolName1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_1_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 1 from the configuration")
 	}
 
 	toolName2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_2_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 2 from the configuration")
 	}
 
 	toolName3, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_3_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 3 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 3 from the configuration")
 	}
 
 	toolName4, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_4_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 4 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 4 from the configuration")
 	}
 
 	toolName5, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_5_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 5 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 5 from the configuration")
 	}
 
 	toolName6, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_6_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 6 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 6 from the configuration")
 	}
 
 	toolName7, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_7_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 7 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 7 from the configuration")
 	}
 
 	toolName8, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_8_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 8 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 8 from the configuration")
 	}
 
 	toolName10, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_10_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load tool name 10 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load tool name 10 from the configuration")
 	}
 
 	collection1Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_1_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load collection name 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load collection name 1 from the configuration")
 	}
 
 	collection2Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_2_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load collection name 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load collection name 2 from the configuration")
 	}
 
 	collection3Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_3_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load collection name 3 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load collection name 3 from the configuration")
 	}
 
 	collection4Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_4_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load collection name 4 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load collection name 4 from the configuration")
 	}
 
 	collection5Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_5_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load collection name 5 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load collection name 5 from the configuration")
 	}
 
 	collection6Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_6_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load collection name 6 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load collection name 6 from the configuration")
 	}
 
 	collection7Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_7_NAME"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load collection name 7 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrNotFound, "failed to load collection name 7 from the configuration")
 	}
 
 	collection_name := ""
@@ -192,68 +164,54 @@ func SimilartitySearchOnPathDescriptions(instruction string, toolName string) (d
 		toolName == toolName3 {
 		collection_name = collection1Name
 	} else {
-		errorMessage := fmt.Sprintf("Invalid Tool Name: %q", toolName)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrInvalidInput, "Invalid Tool Name: %q", toolName)
 	}
 
 	db_url := fmt.Sprintf("%s%s%s", db_endpoint, "/qdrant/similar_descriptions/from/", collection_name)
-	logging.Log.Debugf(ctx, "Constructed URL: %s", db_url)
+	redact.Log.Debugf(logCtx, "Constructed URL: %s", db_url)
 
 	body := map[string]string{
 		"query": instruction,
 	}
 	bodyBytes, err := json.Marshal(body)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Failed to marshal request body: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrInvalidInput, "Failed to marshal request body: %v", err)
 	}
-	logging.Log.Debugf(ctx, "Request Body: %s", string(bodyBytes))
+	redact.Log.Debugf(logCtx, "Request Body: %s", string(bodyBytes))
 
-	req, err := http.NewRequest("POST", db_url, bytes.NewBuffer(bodyBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", db_url, bytes.NewBuffer(bodyBytes))
 	if err != nil {
-		errorMessage := fmt.Sprintf("Failed to create request: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrUpstreamUnavailable, "Failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: tracing.NewTransport(nil)}
 	resp, err := client.Do(req)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Failed to send request: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrUpstreamUnavailable, "Failed to send request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorMessage := fmt.Sprintf("Unexpected status code: %d", resp.StatusCode)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrUpstreamUnavailable, "Unexpected status code: %d", resp.StatusCode)
 	}
 
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Failed to read response body: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrUpstreamUnavailable, "Failed to read response body: %v", err)
 	}
-	logging.Log.Debugf(ctx, "Response: %s", string(responseBody))
+	redact.Log.Debugf(logCtx, "Response: %s", string(responseBody))
 
 	var response struct {
 		Descriptions []string `json:"descriptions"`
 	}
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Failed to unmarshal response: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(logCtx, ErrUpstreamUnavailable, "Failed to unmarshal response: %v", err)
 	}
 
 	descriptions = response.Descriptions
-	logging.Log.Debugf(ctx, "Descriptions: %q", descriptions)
+	redact.Log.Debugf(logCtx, "Descriptions: %q", descriptions)
 	return
 }
 
@@ -274,7 +232,7 @@ func FindRelevantPathDescriptionByPrompt(descriptions []string, message string)
 	ctx := &logging.ContextMap{}
 
 	if len(descriptions) == 0 {
-		logging.Log.Error(ctx, "no descriptions provided to this function")
+		redact.Log.Error(ctx, "no descriptions provided to this function")
 		return
 	}
 
@@ -285,12 +243,12 @@ func FindRelevantPathDescriptionByPrompt(descriptions []string, message string)
 
 	if len(message) == 0 {
 		errorMessage := fmt.Sprintf("no message found from the choice")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	// Log the response content for debugging
-	logging.Log.Debugf(ctx, "Response Content: %s", message)
+	redact.Log.Debugf(ctx, "Response Content: %s", message)
 
 	// Strip backticks and "json" label from the response content
 	cleanedContent := strings.TrimSpace(message)
@@ -306,24 +264,24 @@ func FindRelevantPathDescriptionByPrompt(descriptions []string, message string)
 
 	err := json.Unmarshal([]byte(cleanedContent), &output)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Failed to unmarshal response content: %s, error: %v", cleanedContent, err)
-		logging.Log.Warn(ctx, "Falling back to the first description as relevant.")
+		redact.Log.Errorf(ctx, "Failed to unmarshal response content: %s, error: %v", cleanedContent, err)
+		redact.Log.Warn(ctx, "Falling back to the first description as relevant.")
 		relevantDescription = descriptions[0]
 		return
 	}
 
-	logging.Log.Debugf(ctx, "The Index: %d", output.Index)
+	redact.Log.Debugf(ctx, "The Index: %d", output.Index)
 
 	if output.Index < len(descriptions) && output.Index >= 0 {
 		relevantDescription = descriptions[output.Index]
 	} else {
 		errorMessage := fmt.Sprintf("Output Index: %d, out of range( 0, %d )", output.Index, len(descriptions))
-		logging.Log.Error(ctx, errorMessage)
-		logging.Log.Warn(ctx, "Falling back to the first description as relevant.")
+		redact.Log.Error(ctx, errorMessage)
+		redact.Log.Warn(ctx, "Falling back to the first description as relevant.")
 		relevantDescription = descriptions[0]
 	}
 
-	logging.Log.Infof(ctx, "The relevant description: %s", relevantDescription)
+	redact.Log.Infof(ctx, "The relevant description: %s", relevantDescription)
 
 	return
 }
@@ -338,18 +296,17 @@ func FindRelevantPathDescriptionByPrompt(descriptions []string, message string)
 //
 // Returns:
 //   - properties: the list of descriptions
-func FetchPropertiesFromPathDescription(db_name, description string) (properties []string) {
+//   - error: an error if the graph database cannot be queried
+func FetchPropertiesFromPathDescription(db_name, description string) (properties []string, err error) {
 
 	ctx := &logging.ContextMap{}
 
-	logging.Log.Infof(ctx, "Fetching Properties From Path Descriptions...")
+	redact.Log.Infof(ctx, "Fetching Properties From Path Descriptions...")
 
-	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
+	err = ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
-		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return nil, logError(ctx, ErrUpstreamUnavailable, "error initializing graphdb: %v", err)
 	}
 
 	query := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_GET_PROPERTIES_QUERY"]
@@ -357,12 +314,10 @@ func FetchPropertiesFromPathDescription(db_name, description string) (properties
 	properties, err = ampgraphdb.GraphDbDriver.GetProperties(description, query)
 
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error fetching properties from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(ctx, ErrUpstreamUnavailable, "Error fetching properties from path description: %v", err)
 	}
 
-	logging.Log.Debugf(ctx, "Propetries: %q\n", properties)
+	redact.Log.Debugf(ctx, "Propetries: %q\n", properties)
 	return
 }
 
@@ -376,18 +331,17 @@ func FetchPropertiesFromPathDescription(db_name, description string) (properties
 //
 // Returns:
 //   - actionDescriptions: action descriptions
-func FetchNodeDescriptionsFromPathDescription(db_name, description string) (actionDescriptions string) {
+//   - error: an error if the graph database cannot be queried
+func FetchNodeDescriptionsFromPathDescription(db_name, description string) (actionDescriptions string, err error) {
 
 	ctx := &logging.ContextMap{}
 
-	logging.Log.Infof(ctx, "Fetching Node Descriptions From Path Descriptions...")
+	redact.Log.Infof(ctx, "Fetching Node Descriptions From Path Descriptions...")
 
-	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
+	err = ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
-		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return "", logError(ctx, ErrUpstreamUnavailable, "error initializing graphdb: %v", err)
 	}
 
 	// Get environment variables
@@ -396,13 +350,11 @@ func FetchNodeDescriptionsFromPathDescription(db_name, description string) (acti
 	summaries, err := ampgraphdb.GraphDbDriver.GetSummaries(description, query)
 
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error fetching summaries from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return "", logError(ctx, ErrUpstreamUnavailable, "Error fetching summaries from path description: %v", err)
 	}
 
 	actionDescriptions = summaries
-	logging.Log.Debugf(ctx, "Summaries: %q\n", actionDescriptions)
+	redact.Log.Debugf(ctx, "Summaries: %q\n", actionDescriptions)
 
 	return
 }
@@ -418,33 +370,28 @@ func FetchNodeDescriptionsFromPathDescription(db_name, description string) (acti
 //
 // Returns:
 //   - actions: the list of actions to execute
-func FetchActionsPathFromPathDescription(db_name, description, nodeLabel string) (actions []map[string]string) {
+//   - error: an error if the graph database cannot be queried
+func FetchActionsPathFromPathDescription(db_name, description, nodeLabel string) (actions []map[string]string, err error) {
 	ctx := &logging.ContextMap{}
 
-	logging.Log.Infof(ctx, "Fetching Actions From Path Descriptions...")
+	redact.Log.Infof(ctx, "Fetching Actions From Path Descriptions...")
 
-	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
+	err = ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
-		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return nil, logError(ctx, ErrUpstreamUnavailable, "error initializing graphdb: %v", err)
 	}
 
 	// Get the node label 1 from the configuration
 	nodeLabel1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_FETCH_PATH_NODES_QUERY_NODE_LABEL_1"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load node label 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(ctx, ErrNotFound, "failed to load node label 1 from the configuration")
 	}
 
 	// Get the node label 2 from the configuration
 	nodeLabel2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_FETCH_PATH_NODES_QUERY_NODE_LABEL_2"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load node label 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(ctx, ErrNotFound, "failed to load node label 2 from the configuration")
 	}
 
 	var query string
@@ -453,16 +400,12 @@ func FetchActionsPathFromPathDescription(db_name, description, nodeLabel string)
 	} else if nodeLabel == nodeLabel2 {
 		query, exists = config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_GET_ACTIONS_QUERY_LABEL_2"]
 	} else {
-		errorMessage := fmt.Sprintf("Invalid Node Label: %q", nodeLabel)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(ctx, ErrInvalidInput, "Invalid Node Label: %q", nodeLabel)
 	}
 
 	actions, err = ampgraphdb.GraphDbDriver.GetActions(description, query)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error fetching actions from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return nil, logError(ctx, ErrUpstreamUnavailable, "Error fetching actions from path description: %v", err)
 	}
 
 	return
@@ -487,11 +430,11 @@ func SynthesizeActionsTool4(message string, actions []map[string]string) (update
 
 	if len(message) == 0 {
 		errorMessage := fmt.Sprintf("the message is empty, cannot synthesize actions")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
-	logging.Log.Debugf(ctx, "The Message: %s\n", message)
+	redact.Log.Debugf(ctx, "The Message: %s\n", message)
 
 	// Clean the response content
 	cleanedContent := strings.TrimSpace(message)
@@ -508,33 +451,33 @@ func SynthesizeActionsTool4(message string, actions []map[string]string) (update
 	err := json.Unmarshal([]byte(cleanedContent), &output)
 	if err != nil {
 		errorMessage := fmt.Sprintf("SynthesizeActionsTool4: Failed to unmarshal response: %v", err)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	scopePattern := output.ScopePattern
 
-	logging.Log.Debugf(ctx, "scopePattern: %q\n", scopePattern)
+	redact.Log.Debugf(ctx, "scopePattern: %q\n", scopePattern)
 
 	// Get synthesize actions find key from configuration
 	synthesizeActionsFindKey, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_FIND_KEY"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	synthesizeActionsValue, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_TOOL4_VALUE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	synthesizeActionsReplaceKey, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_REPLACE_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -548,7 +491,7 @@ func SynthesizeActionsTool4(message string, actions []map[string]string) (update
 		}
 	}
 
-	logging.Log.Debugf(ctx, "The Updated Actions: %q\n", updatedActions)
+	redact.Log.Debugf(ctx, "The Updated Actions: %q\n", updatedActions)
 
 	return
 }
@@ -573,37 +516,37 @@ func SynthesizeActionsTool13(content string) (result string) {
 		panic(fmt.Sprintf("unmarshal UnitSystem failed: %v", err))
 	}
 	unitSystem := out.UnitSystem
-	logging.Log.Infof(ctx, "Synthesized UnitSystem: %s", unitSystem)
+	redact.Log.Infof(ctx, "Synthesized UnitSystem: %s", unitSystem)
 
 	message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_13_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_13_ACTION_SUCCESS_MESSAGE from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	actionKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 	actionKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 	actionValue1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_13_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_13_NAME from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 	actionValue2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -623,13 +566,13 @@ func SynthesizeActionsTool13(content string) (result string) {
 	resultStream, err := json.Marshal(finalMessage)
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to marshal final message for tool 13: %v", err)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	result = string(resultStream)
-	logging.Log.Infof(ctx, "SynthesizeActionsTool13 result: %s", result)
-	logging.Log.Infof(ctx, "successfully synthesized actions for tool 13")
+	redact.Log.Infof(ctx, "SynthesizeActionsTool13 result: %s", result)
+	redact.Log.Infof(ctx, "successfully synthesized actions for tool 13")
 
 	return
 }
@@ -656,40 +599,40 @@ func SynthesizeActionsTool14(content string) (result string) {
 	}
 
 	Argument := out.Argument
-	logging.Log.Infof(ctx, "Synthesized Argument: %s", Argument)
+	redact.Log.Infof(ctx, "Synthesized Argument: %s", Argument)
 
 	message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_14_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_14_ACTION_SUCCESS_MESSAGE from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	actionKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	actionKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	actionValue1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_14_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_14_NAME from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	actionValue2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -709,13 +652,13 @@ func SynthesizeActionsTool14(content string) (result string) {
 	resultStream, err := json.Marshal(finalMessage)
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to marshal final message for tool 14: %v", err)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	result = string(resultStream)
-	logging.Log.Infof(ctx, "SynthesizeActionsTool14 result: %s", result)
-	logging.Log.Infof(ctx, "successfully synthesized actions for tool 14")
+	redact.Log.Infof(ctx, "SynthesizeActionsTool14 result: %s", result)
+	redact.Log.Infof(ctx, "successfully synthesized actions for tool 14")
 
 	return result
 }
@@ -739,17 +682,17 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	updatedActions = actions
 
 	if len(properties) == 0 {
-		logging.Log.Infof(ctx, "No properties to synthesize actions")
+		redact.Log.Infof(ctx, "No properties to synthesize actions")
 		return
 	}
 
 	if len(message) == 0 {
 		errorMessage := fmt.Sprintf("the message is empty, cannot synthesize actions")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
-	logging.Log.Debugf(ctx, "The Message: %s\n", message)
+	redact.Log.Debugf(ctx, "The Message: %s\n", message)
 
 	var output map[string]interface{}
 
@@ -757,7 +700,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	err := json.Unmarshal([]byte(message), &output)
 	if err != nil {
 		// Log the error and fallback to an empty output
-		logging.Log.Errorf(ctx, "Failed to unmarshal response content: %s, error: %v", message, err)
+		redact.Log.Errorf(ctx, "Failed to unmarshal response content: %s, error: %v", message, err)
 
 		// Attempt to clean the response and retry unmarshaling
 		cleanedContent := strings.TrimSpace(message)
@@ -769,19 +712,19 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 
 		err = json.Unmarshal([]byte(cleanedContent), &output)
 		if err != nil {
-			logging.Log.Errorf(ctx, "Failed to unmarshal cleaned response content: %s, error: %v", cleanedContent, err)
-			logging.Log.Warn(ctx, "Returning an empty output as fallback.")
+			redact.Log.Errorf(ctx, "Failed to unmarshal cleaned response content: %s, error: %v", cleanedContent, err)
+			redact.Log.Warn(ctx, "Returning an empty output as fallback.")
 			output = make(map[string]interface{})
 		}
 	}
 
-	logging.Log.Debugf(ctx, "The LLM Output of properties processing: %q\n", output)
+	redact.Log.Debugf(ctx, "The LLM Output of properties processing: %q\n", output)
 
 	// Get synthesize actions find key from configuration
 	synthesizeActionsFindKey, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_FIND_KEY"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -789,7 +732,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	synthesizeActionsReplaceKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_REPLACE_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions replace key 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -797,7 +740,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	synthesizeActionsReplaceKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_REPLACE_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions replace key 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -805,7 +748,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	synthesizeOutputKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_OUTPUT_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize output key 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -813,7 +756,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	synthesizeOutputKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_OUTPUT_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize output key 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -853,11 +796,11 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 				)
 			}
 		default:
-			logging.Log.Infof(ctx, "Key: %s, Value is of a different type: %T", key, v)
+			redact.Log.Infof(ctx, "Key: %s, Value is of a different type: %T", key, v)
 		}
 	}
 
-	logging.Log.Debugf(ctx, "The SynthesizeActions Updated Actions: %q\n", updatedActions)
+	redact.Log.Debugf(ctx, "The SynthesizeActions Updated Actions: %q\n", updatedActions)
 
 	return
 }
@@ -891,7 +834,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool2Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_2_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 2 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -899,7 +842,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool4Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_4_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 4 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -907,7 +850,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool5Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_5_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 5 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -915,7 +858,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool6Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_6_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 6 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -923,7 +866,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool7Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_7_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 7 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -931,7 +874,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool8Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_8_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 8 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -939,14 +882,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool10Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_10_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 10 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool16Name, exexists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_16_NAME"]
 	if !exexists {
 		errorMessage := fmt.Sprintf("failed to load tool 16 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -954,7 +897,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool17Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_17_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 17 name from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -962,7 +905,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool2ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_2_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 2 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -970,14 +913,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool2NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_2_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 2 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool4ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_4_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 4 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -985,14 +928,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool4NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_4_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 4 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool5ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_5_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 5 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1000,14 +943,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool5NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_5_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 5 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool6ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_6_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 6 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1015,14 +958,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool6NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_6_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 6 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool7ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_7_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 7 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1030,14 +973,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool7NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_7_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 7 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool8ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_8_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 8 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1045,14 +988,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool8NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_8_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 8 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool10ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_10_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 10 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1060,35 +1003,35 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	tool10NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_10_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 10 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool16ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_16_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 16 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool16NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_16_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 16 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool17ActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_17_ACTION_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 17 action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	tool17NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_17_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 17 no action message from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1148,7 +1091,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 		}
 	} else {
 		errorMessage := fmt.Sprintf("Invalid toolName %s", toolName)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1161,12 +1104,12 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to convert actions to json: %v", err)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	result = string(bytesStream)
-	logging.Log.Info(ctx, "successfully converted actions to json")
+	redact.Log.Info(ctx, "successfully converted actions to json")
 
 	return
 }
@@ -1182,43 +1125,36 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 //
 // Returns:
 //   - solutions: the list of solutions in json
-func GetSolutionsToFixProblem(db_name, fmFailureCode, primeMeshFailureCode string) (solutions string) {
+//   - error: an error if the graph database cannot be queried
+func GetSolutionsToFixProblem(db_name, fmFailureCode, primeMeshFailureCode string) (solutions string, err error) {
 
 	ctx := &logging.ContextMap{}
 
-	logging.Log.Infof(ctx, "Get Solutions To Fix Problem...")
+	redact.Log.Infof(ctx, "Get Solutions To Fix Problem...")
 
-	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
+	err = ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
-		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return "", logError(ctx, ErrUpstreamUnavailable, "error initializing graphdb: %v", err)
 	}
 
 	query, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_GET_SOLUTIONS_QUERY"]
 	if !exists {
-		errorMessage := fmt.Sprintf("failed to load query from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return "", logError(ctx, ErrNotFound, "failed to load query from the configuration")
 	}
 
 	solutionsVec, err := ampgraphdb.GraphDbDriver.GetSolutions(fmFailureCode, primeMeshFailureCode, query)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error fetching solutions from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return "", logError(ctx, ErrUpstreamUnavailable, "Error fetching solutions from path description: %v", err)
 	}
 
 	byteStream, err := json.Marshal(solutionsVec)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error marshalling solutions: %v\n", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		return "", logError(ctx, ErrUpstreamUnavailable, "Error marshalling solutions: %v", err)
 	}
 
 	solutions = string(byteStream)
-	logging.Log.Info(ctx, "found solutions to fix problem...")
+	redact.Log.Info(ctx, "found solutions to fix problem...")
 	return
 }
 
@@ -1244,12 +1180,12 @@ func GetSelectedSolution(arguments string) (solution string) {
 
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to un marshal index output")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	solution = output.Solution
-	logging.Log.Infof(ctx, "Selected Solution: %s", solution)
+	redact.Log.Infof(ctx, "Selected Solution: %s", solution)
 	return
 }
 
@@ -1302,7 +1238,7 @@ func AppendToolHistory(toolHistory []map[string]string, toolId, toolName, toolAr
 		updatedToolHistory = append(updatedToolHistory, tool)
 	}
 
-	logging.Log.Info(ctx, fmt.Sprintf("Updated Tool History: %q", updatedToolHistory))
+	redact.Log.Info(ctx, fmt.Sprintf("Updated Tool History: %q", updatedToolHistory))
 	return
 }
 
@@ -1332,7 +1268,7 @@ func AppendMeshPilotHistory(history []map[string]string, role, content string) (
 		"content": content,
 	})
 
-	logging.Log.Debugf(ctx, "Updated history: %q", updatedHistory)
+	redact.Log.Debugf(ctx, "Updated history: %q", updatedHistory)
 	return
 }
 
@@ -1356,7 +1292,7 @@ func ParseHistory(historyJson string) (history []map[string]string) {
 	err := json.Unmarshal([]byte(historyJson), &historyMap)
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to unmarshal history json: %v", err)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1364,7 +1300,7 @@ func ParseHistory(historyJson string) (history []map[string]string) {
 	for _, item := range historyMap {
 		history = append(history, item)
 	}
-	logging.Log.Debugf(ctx, "Parsed history: %q", history)
+	redact.Log.Debugf(ctx, "Parsed history: %q", history)
 	return
 }
 
@@ -1381,8 +1317,8 @@ func ParseHistory(historyJson string) (history []map[string]string) {
 func GetActionsFromConfig(toolName string) (result string) {
 	ctx := &logging.ContextMap{}
 
-	logging.Log.Info(ctx, "Get Actions From Config...")
-	logging.Log.Infof(ctx, "Tool Name: %q", toolName)
+	redact.Log.Info(ctx, "Get Actions From Config...")
+	redact.Log.Infof(ctx, "Tool Name: %q", toolName)
 
 	// Configuration keys for different tools, for now only tool 9 and tool 11
 	configKeys := map[string]map[string]string{
@@ -1423,7 +1359,7 @@ func GetActionsFromConfig(toolName string) (result string) {
 		value, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES[key]
 		if !exists {
 			errorMessage := fmt.Sprintf("%s: %s", errorMsg, key)
-			logging.Log.Error(ctx, errorMessage)
+			redact.Log.Error(ctx, errorMessage)
 			panic(errorMessage)
 		}
 		return value
@@ -1474,7 +1410,7 @@ func GetActionsFromConfig(toolName string) (result string) {
 		selectedMessage = tool18ResultMessage
 	} else {
 		errorMessage := fmt.Sprintf("Invalid toolName %s", toolName)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1494,14 +1430,14 @@ func GetActionsFromConfig(toolName string) (result string) {
 		bytesStream, err := json.Marshal(finalMessage)
 		if err != nil {
 			errorMessage := fmt.Sprintf("failed to convert actions to json: %v", err)
-			logging.Log.Error(ctx, errorMessage)
+			redact.Log.Error(ctx, errorMessage)
 			panic(errorMessage)
 		}
 		result = string(bytesStream)
-		logging.Log.Infof(ctx, "successfully converted actions to json: %q", result)
+		redact.Log.Infof(ctx, "successfully converted actions to json: %q", result)
 	} else {
 		errorMessage := fmt.Sprintf("Invalid toolName %s", toolName)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1514,19 +1450,21 @@ func GetActionsFromConfig(toolName string) (result string) {
 //   - @displayName: SimilartitySearchOnPathDescriptions (Qdrant)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - instruction: the user query
 //   - toolName: the tool name
 //
 // Returns:
 //   - descriptions: the list of descriptions
-func SimilartitySearchOnPathDescriptionsQdrant(vector []float32, collection string, similaritySearchResults int, similaritySearchMinScore float64) (descriptions []string) {
+//   - error: an error if the vector database cannot be queried
+func SimilartitySearchOnPathDescriptionsQdrant(ctx context.Context, vector []float32, collection string, similaritySearchResults int, similaritySearchMinScore float64) (descriptions []string, err error) {
 	descriptions = []string{}
 
 	logCtx := &logging.ContextMap{}
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(logCtx, "unable to create qdrant client: %q", err)
+		return nil, logError(logCtx, ErrUpstreamUnavailable, "unable to create qdrant client: %q", err)
 	}
 
 	limit := uint64(similaritySearchResults)
@@ -1540,34 +1478,32 @@ func SimilartitySearchOnPathDescriptionsQdrant(vector []float32, collection stri
 		WithPayload:    qdrant.NewWithPayloadInclude("Description"),
 	}
 
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
-		logPanic(logCtx, "error in qdrant query: %q", err)
+		return nil, logError(logCtx, ErrUpstreamUnavailable, "error in qdrant query: %q", err)
 	}
-	logging.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
+	redact.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
 
 	for i, scoredPoint := range scoredPoints {
-		logging.Log.Debugf(&logging.ContextMap{}, "Result #%d:", i)
-		logging.Log.Debugf(&logging.ContextMap{}, "Similarity score: %v", scoredPoint.Score)
+		redact.Log.Debugf(&logging.ContextMap{}, "Result #%d:", i)
+		redact.Log.Debugf(&logging.ContextMap{}, "Similarity score: %v", scoredPoint.Score)
 		dbResponse, err := qdrant_utils.QdrantPayloadToType[map[string]interface{}](scoredPoint.GetPayload())
 
 		if err != nil {
-			errMsg := fmt.Sprintf("error converting qdrant payload to dbResponse: %q", err)
-			logging.Log.Errorf(logCtx, "%s", errMsg)
-			panic(errMsg)
+			return nil, logError(logCtx, ErrUpstreamUnavailable, "error converting qdrant payload to dbResponse: %q", err)
 		}
 
 		description, ok := dbResponse["Description"].(string)
 		if !ok {
-			logging.Log.Errorf(&logging.ContextMap{}, "Description not found or not a string for scored point #%d", i)
+			redact.Log.Errorf(&logging.ContextMap{}, "Description not found or not a string for scored point #%d", i)
 			continue
 		}
-		logging.Log.Debugf(&logging.ContextMap{}, "Description: %s", description)
+		redact.Log.Debugf(&logging.ContextMap{}, "Description: %s", description)
 
 		descriptions = append(descriptions, description)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Descriptions: %q", descriptions)
+	redact.Log.Debugf(&logging.ContextMap{}, "Descriptions: %q", descriptions)
 	return
 }
 
@@ -1588,7 +1524,7 @@ func ParseHistoryToHistoricMessages(historyJson string) (history []sharedtypes.H
 	err := json.Unmarshal([]byte(historyJson), &historyMaps)
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to unmarshal history json: %v", err)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1628,13 +1564,13 @@ func GenerateSubWorkflowPrompt(userInstruction string) (systemPrompt string, use
 	systemPromptTemplate, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_SUBWORKFLOW_IDENTIFICATION_SYSTEM_PROMPT"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load system prompt template from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 	userPromptTemplate, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_SUBWORKFLOW_IDENTIFICATION_USER_PROMPT"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load user prompt template from the configuration")
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
@@ -1642,9 +1578,9 @@ func GenerateSubWorkflowPrompt(userInstruction string) (systemPrompt string, use
 	systemPrompt = fmt.Sprintf(systemPromptTemplate, subworkflowListStr.String())
 	userPrompt = fmt.Sprintf(userPromptTemplate, userInstruction)
 
-	logging.Log.Debugf(ctx, "Generated System Prompt: %s", systemPrompt)
+	redact.Log.Debugf(ctx, "Generated System Prompt: %s", systemPrompt)
 
-	logging.Log.Debugf(ctx, "Generated User Prompt: %s", userPrompt)
+	redact.Log.Debugf(ctx, "Generated User Prompt: %s", userPrompt)
 	return systemPrompt, userPrompt
 }
 
@@ -1664,7 +1600,7 @@ func GenerateUserPrompt(userInstruction string, userPromptTemplate string) (user
 
 	userPrompt = fmt.Sprintf(userPromptTemplate, userInstruction)
 
-	logging.Log.Debugf(ctx, "Generated User Prompt: %s", userPrompt)
+	redact.Log.Debugf(ctx, "Generated User Prompt: %s", userPrompt)
 
 	return
 }
@@ -1686,7 +1622,7 @@ func GenerateUserPromptWithList(userInstruction string, userList []string, userP
 
 	userPrompt = fmt.Sprintf(userPromptTemplate, userList, userInstruction)
 
-	logging.Log.Debugf(ctx, "Generated User Prompt: %s", userPrompt)
+	redact.Log.Debugf(ctx, "Generated User Prompt: %s", userPrompt)
 
 	return
 }
@@ -1719,17 +1655,17 @@ func ProcessSubworkflowIdentificationOutput(llmOutput string) (status string, wo
 	}
 	err := json.Unmarshal([]byte(cleaned), &result)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Failed to parse LLM output as JSON: %v, content: %s", err, cleaned)
+		redact.Log.Errorf(ctx, "Failed to parse LLM output as JSON: %v, content: %s", err, cleaned)
 		return "failure", ""
 	}
 
 	// Check if subworkflow is valid
 	if result.Subworkflow == "" || strings.ToLower(result.Subworkflow) == "none" {
-		logging.Log.Warnf(ctx, "No valid subworkflow found in LLM output: %s", cleaned)
+		redact.Log.Warnf(ctx, "No valid subworkflow found in LLM output: %s", cleaned)
 		return "failure", ""
 	}
 
-	logging.Log.Debugf(ctx, "Identified Subworkflow: %s", result.Subworkflow)
+	redact.Log.Debugf(ctx, "Identified Subworkflow: %s", result.Subworkflow)
 
 	return "success", result.Subworkflow
 }
@@ -1745,9 +1681,9 @@ func ProcessSubworkflowIdentificationOutput(llmOutput string) (status string, wo
 // Returns:
 //   - html: content in html format
 func MarkdownToHTML(markdown string) (html string) {
-	logging.Log.Info(&logging.ContextMap{}, "Converting Markdown to HTML...")
+	redact.Log.Info(&logging.ContextMap{}, "Converting Markdown to HTML...")
 	// Use blackfriday to convert markdown to HTML
-	logging.Log.Debugf(&logging.ContextMap{}, "Markdown content: %s", markdown)
+	redact.Log.Debugf(&logging.ContextMap{}, "Markdown content: %s", markdown)
 	html = string(blackfriday.Run([]byte(markdown)))
 	return html
 }
@@ -1764,7 +1700,7 @@ func MarkdownToHTML(markdown string) (html string) {
 //   - result: response schema sent to chat interface
 func FinalizeMessage(message string) (result string) {
 	ctx := &logging.ContextMap{}
-	logging.Log.Info(ctx, "Finalizing message...")
+	redact.Log.Info(ctx, "Finalizing message...")
 
 	actions := make([]map[string]string, 0)
 
@@ -1777,13 +1713,13 @@ func FinalizeMessage(message string) (result string) {
 
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to convert actions to json: %v", err)
-		logging.Log.Error(ctx, errorMessage)
+		redact.Log.Error(ctx, errorMessage)
 		panic(errorMessage)
 	}
 
 	result = string(bytesStream)
-	logging.Log.Debugf(ctx, "Final message: %s", result)
-	logging.Log.Info(ctx, "successfully converted actions to json")
+	redact.Log.Debugf(ctx, "Final message: %s", result)
+	redact.Log.Info(ctx, "successfully converted actions to json")
 
 	return result
 }
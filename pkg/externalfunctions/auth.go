@@ -31,6 +31,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 )
 
@@ -40,41 +42,42 @@ import (
 //   - @displayName: Verify API Key
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - apiKey: The API key to check.
 //   - mongoDbUrl: The URL of the MongoDB database.
+//   - @secret
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
 //
 // Returns:
 //   - isAuthenticated: A boolean indicating whether the API key is authenticated.
-func CheckApiKeyAuthMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (isAuthenticated bool) {
+//   - error: an error if the MongoDB database cannot be accessed
+func CheckApiKeyAuthMongoDb(ctx context.Context, apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (isAuthenticated bool, err error) {
 
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error initializing mongoDb client: %w", err), ErrUpstreamUnavailable)
 	}
 	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if customer exists
-	exists, customer, err := mongoDbGetCustomerByApiKey(mongoDbContext, apiKey)
+	exists, customer, err := mongoDbGetCustomerByApiKey(ctx, mongoDbContext, apiKey)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error getting customer by API key: %w", err), ErrUpstreamUnavailable)
 	}
 	if !exists {
-		logging.Log.Warnf(&logging.ContextMap{}, "Authenticating failed: given API key not found in database")
-		return false
+		redact.Log.Warnf(&logging.ContextMap{}, "Authenticating failed: given API key not found in database")
+		return false, nil
 	}
 
 	// check if customer is allowed access
 	if customer.AccessDenied {
-		logging.Log.Warnf(&logging.ContextMap{}, "Authenticating failed: access denied for given API key")
-		return false
+		redact.Log.Warnf(&logging.ContextMap{}, "Authenticating failed: access denied for given API key")
+		return false, nil
 	}
 
-	return true
+	return true, nil
 }
 
 // CheckCreateUserIdMongoDb checks if a user ID exists in the MongoDB database and creates it if it doesn't.
@@ -83,32 +86,33 @@ func CheckApiKeyAuthMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName
 //   - @displayName: Check and Create User ID
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - userId: The user ID to check.
 //   - tokenLimitForNewUsers: The token limit for new users.
 //   - mongoDbUrl: The URL of the MongoDB database.
+//   - @secret
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
 //
 // Returns:
 //   - existingUser: A boolean indicating whether the user ID already exists.
-func CheckCreateUserIdMongoDb(userId string, tokenLimitForNewUsers int, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (existingUser bool) {
+//   - error: an error if the MongoDB database cannot be accessed
+func CheckCreateUserIdMongoDb(ctx context.Context, userId string, tokenLimitForNewUsers int, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (existingUser bool, err error) {
 
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error initializing mongoDb client: %w", err), ErrUpstreamUnavailable)
 	}
 	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if customer for userid exists if not, create it
-	existingUser, _, err = mongoDbGetCreateCustomerByUserId(mongoDbContext, userId, tokenLimitForNewUsers)
+	existingUser, _, err = mongoDbGetCreateCustomerByUserId(ctx, mongoDbContext, userId, tokenLimitForNewUsers)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error getting or creating customer by userId: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error getting or creating customer by userId: %w", err), ErrUpstreamUnavailable)
 	}
 
-	return existingUser
+	return existingUser, nil
 }
 
 // UpdateTotalTokenCountForCustomerMongoDb updates the total token count for the given customer in the MongoDB database.
@@ -117,42 +121,45 @@ func CheckCreateUserIdMongoDb(userId string, tokenLimitForNewUsers int, mongoDbU
 //   - @displayName: Update Total Token Count
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - apiKey: The API key of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
+//   - @secret
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
 //   - additionalTokenCount: The number of additional tokens to add to the total token count.
 //
 // Returns:
 //   - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.
-func UpdateTotalTokenCountForCustomerMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalTokenCount int) (tokenLimitReached bool) {
+//   - error: an error if the MongoDB database cannot be accessed
+func UpdateTotalTokenCountForCustomerMongoDb(ctx context.Context, apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalTokenCount int) (tokenLimitReached bool, err error) {
 
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error initializing mongoDb client: %w", err), ErrUpstreamUnavailable)
 	}
 	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// update token count
-	err = mongoDbAddToTotalTokenCount(mongoDbContext, "api_key", apiKey, additionalTokenCount)
+	err = mongoDbAddToTotalTokenCount(ctx, mongoDbContext, "api_key", apiKey, additionalTokenCount)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error updating total token count for customer: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error updating total token count for customer: %w", err), ErrUpstreamUnavailable)
 	}
 
 	// check if customer is over the limit
-	exists, customer, err := mongoDbGetCustomerByApiKey(mongoDbContext, apiKey)
-	if err != nil || !exists {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+	exists, customer, err := mongoDbGetCustomerByApiKey(ctx, mongoDbContext, apiKey)
+	if err != nil {
+		return false, classError(fmt.Errorf("Error getting customer by API key: %w", err), ErrUpstreamUnavailable)
+	}
+	if !exists {
+		return false, logError(nil, ErrNotFound, "customer not found for the given API key")
 	}
 	if customer.TotalTokenCount >= customer.TokenLimit {
-		return true
+		return true, nil
 	}
 
-	return false
+	return false, nil
 }
 
 // UpdateTotalTokenCountForUserIdMongoDb updates the total token count for the given user ID in the MongoDB database.
@@ -161,42 +168,45 @@ func UpdateTotalTokenCountForCustomerMongoDb(apiKey string, mongoDbUrl string, m
 //   - @displayName: Update Total Token Count by User ID
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - userId: The user ID of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
+//   - @secret
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
 //   - additionalTokenCount: The number of additional tokens to add to the total token count.
 //
 // Returns:
 //   - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.
-func UpdateTotalTokenCountForUserIdMongoDb(userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalTokenCount int) (tokenLimitReached bool) {
+//   - error: an error if the MongoDB database cannot be accessed
+func UpdateTotalTokenCountForUserIdMongoDb(ctx context.Context, userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalTokenCount int) (tokenLimitReached bool, err error) {
 
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error initializing mongoDb client: %w", err), ErrUpstreamUnavailable)
 	}
 	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// update token count
-	err = mongoDbAddToTotalTokenCount(mongoDbContext, "user_id", userId, additionalTokenCount)
+	err = mongoDbAddToTotalTokenCount(ctx, mongoDbContext, "user_id", userId, additionalTokenCount)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error updating total token count for customer: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error updating total token count for customer: %w", err), ErrUpstreamUnavailable)
 	}
 
 	// check if customer is over the limit
-	exists, customer, err := mongoDbGetCreateCustomerByUserId(mongoDbContext, userId, 0)
-	if err != nil || !exists {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+	exists, customer, err := mongoDbGetCreateCustomerByUserId(ctx, mongoDbContext, userId, 0)
+	if err != nil {
+		return false, classError(fmt.Errorf("Error getting customer by user ID: %w", err), ErrUpstreamUnavailable)
+	}
+	if !exists {
+		return false, logError(nil, ErrNotFound, "customer not found for the given user ID")
 	}
 	if customer.TotalTokenCount >= customer.TokenLimit {
-		return true
+		return true, nil
 	}
 
-	return false
+	return false, nil
 }
 
 // DenyCustomerAccessAndSendWarningMongoDb denies access to the customer and sends a warning if necessary.
@@ -205,41 +215,44 @@ func UpdateTotalTokenCountForUserIdMongoDb(userId string, mongoDbUrl string, mon
 //   - @displayName: Deny Customer Access
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - apiKey: The API key of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
+//   - @secret
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
 //
 // Returns:
 //   - customerName: The name of the customer.
 //   - sendWarning: A boolean indicating whether a warning should be sent to the customer.
-func DenyCustomerAccessAndSendWarningMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (customerName string, sendWarning bool) {
+//   - error: an error if the MongoDB database cannot be accessed
+func DenyCustomerAccessAndSendWarningMongoDb(ctx context.Context, apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (customerName string, sendWarning bool, err error) {
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		return "", false, classError(fmt.Errorf("Error initializing mongoDb client: %w", err), ErrUpstreamUnavailable)
 	}
 	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if warning for customer needs to be sent
-	exists, customer, err := mongoDbGetCustomerByApiKey(mongoDbContext, apiKey)
-	if err != nil || !exists {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+	exists, customer, err := mongoDbGetCustomerByApiKey(ctx, mongoDbContext, apiKey)
+	if err != nil {
+		return "", false, classError(fmt.Errorf("Error getting customer by API key: %w", err), ErrUpstreamUnavailable)
+	}
+	if !exists {
+		return "", false, logError(nil, ErrNotFound, "customer not found for the given API key")
 	}
 	if !customer.WarningSent {
 		sendWarning = true
 	}
 
 	// deny customer access and set warning sent
-	err = mongoDbUpdateAccessAndWarning(mongoDbContext, "api_key", apiKey)
+	err = mongoDbUpdateAccessAndWarning(ctx, mongoDbContext, "api_key", apiKey)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error updating access and warning for customer: %v", err)
-		panic(err)
+		return "", false, classError(fmt.Errorf("Error updating access and warning for customer: %w", err), ErrUpstreamUnavailable)
 	}
 
-	return customer.CustomerName, sendWarning
+	return customer.CustomerName, sendWarning, nil
 }
 
 // DenyCustomerAccessAndSendWarningMongoDbUserId denies access to the customer by user ID and sends a warning if necessary.
@@ -248,40 +261,43 @@ func DenyCustomerAccessAndSendWarningMongoDb(apiKey string, mongoDbUrl string, m
 //   - @displayName: Deny Customer Access by User ID
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - userId: The user ID of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
+//   - @secret
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
 //
 // Returns:
 //   - sendWarning: A boolean indicating whether a warning should be sent to the customer.
-func DenyCustomerAccessAndSendWarningMongoDbUserId(userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (sendWarning bool) {
+//   - error: an error if the MongoDB database cannot be accessed
+func DenyCustomerAccessAndSendWarningMongoDbUserId(ctx context.Context, userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (sendWarning bool, err error) {
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error initializing mongoDb client: %w", err), ErrUpstreamUnavailable)
 	}
 	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if warning for customer needs to be sent
-	exists, customer, err := mongoDbGetCreateCustomerByUserId(mongoDbContext, userId, 0)
-	if err != nil || !exists {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+	exists, customer, err := mongoDbGetCreateCustomerByUserId(ctx, mongoDbContext, userId, 0)
+	if err != nil {
+		return false, classError(fmt.Errorf("Error getting customer by user ID: %w", err), ErrUpstreamUnavailable)
+	}
+	if !exists {
+		return false, logError(nil, ErrNotFound, "customer not found for the given user ID")
 	}
 	if !customer.WarningSent {
 		sendWarning = true
 	}
 
 	// deny customer access and set warning sent
-	err = mongoDbUpdateAccessAndWarning(mongoDbContext, "user_id", userId)
+	err = mongoDbUpdateAccessAndWarning(ctx, mongoDbContext, "user_id", userId)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error updating access and warning for customer: %v", err)
-		panic(err)
+		return false, classError(fmt.Errorf("Error updating access and warning for customer: %w", err), ErrUpstreamUnavailable)
 	}
 
-	return sendWarning
+	return sendWarning, nil
 }
 
 // SendLogicAppNotificationEmail sends a POST request to the email service.
@@ -290,11 +306,16 @@ func DenyCustomerAccessAndSendWarningMongoDbUserId(userId string, mongoDbUrl str
 //   - @displayName: Send Email Notification
 //
 // Parameters:
-//   - endpoint: The email service endpoint.
+//   - ctx: the context of the request
+//   - logicAppEndpoint: The email service endpoint, including its access signature.
+//   - @secret
 //   - email: The email address.
 //   - subject: The email subject.
 //   - content: The email content.
-func SendLogicAppNotificationEmail(logicAppEndpoint string, email string, subject string, content string) {
+//
+// Returns:
+//   - error: an error if the notification cannot be sent
+func SendLogicAppNotificationEmail(ctx context.Context, logicAppEndpoint string, email string, subject string, content string) error {
 	// Create the request body
 	requestBody := EmailRequest{
 		Email:   email,
@@ -305,20 +326,21 @@ func SendLogicAppNotificationEmail(logicAppEndpoint string, email string, subjec
 	// Convert the request body to JSON
 	jsonData, err := json.Marshal(requestBody)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error marshaling JSON: %v", err)
-		panic(fmt.Errorf("error marshaling JSON: %v", err))
+		redact.Log.Errorf(&logging.ContextMap{}, "Error marshaling JSON: %v", err)
+		return logError(nil, ErrInvalidInput, "error marshaling JSON: %v", err)
 	}
 
 	// Create a new HTTP client with timeout
 	client := &http.Client{
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
+		Transport: tracing.NewTransport(nil),
 	}
 
 	// Create the POST request
-	req, err := http.NewRequest("POST", logicAppEndpoint, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", logicAppEndpoint, bytes.NewBuffer(jsonData))
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error creating request: %v", err)
-		panic(fmt.Errorf("error creating request: %v", err))
+		redact.Log.Errorf(&logging.ContextMap{}, "Error creating request: %v", err)
+		return logError(nil, ErrUpstreamUnavailable, "error creating request: %v", err)
 	}
 
 	// Set headers
@@ -327,16 +349,17 @@ func SendLogicAppNotificationEmail(logicAppEndpoint string, email string, subjec
 	// Send the request
 	resp, err := client.Do(req)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error sending request: %v", err)
-		panic(fmt.Errorf("error sending request: %v", err))
+		redact.Log.Errorf(&logging.ContextMap{}, "Error sending request: %v", err)
+		return logError(nil, ErrUpstreamUnavailable, "error sending request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	// Check response status
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		logging.Log.Errorf(&logging.ContextMap{}, "Unexpected status code: %d", resp.StatusCode)
-		panic(fmt.Errorf("unexpected status code: %d", resp.StatusCode))
+		redact.Log.Errorf(&logging.ContextMap{}, "Unexpected status code: %d", resp.StatusCode)
+		return logError(nil, ErrUpstreamUnavailable, "unexpected status code: %d", resp.StatusCode)
 	}
+	return nil
 }
 
 // CreateMessageWithVariable creates a message with a variable.
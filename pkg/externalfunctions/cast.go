@@ -605,45 +605,3 @@ func CastByteToAny(data byte) any {
 func CastRuneToAny(data rune) any {
 	return data
 }
-
-func init() {
-	ExternalFunctionsMap["CastAnyToString"] = CastAnyToString
-	ExternalFunctionsMap["CastAnyToBool"] = CastAnyToBool
-	ExternalFunctionsMap["CastAnyToInt8"] = CastAnyToInt8
-	ExternalFunctionsMap["CastAnyToInt16"] = CastAnyToInt16
-	ExternalFunctionsMap["CastAnyToInt32"] = CastAnyToInt32
-	ExternalFunctionsMap["CastAnyToInt64"] = CastAnyToInt64
-	ExternalFunctionsMap["CastAnyToInt"] = CastAnyToInt
-	ExternalFunctionsMap["CastAnyToUint8"] = CastAnyToUint8
-	ExternalFunctionsMap["CastAnyToUint16"] = CastAnyToUint16
-	ExternalFunctionsMap["CastAnyToUint32"] = CastAnyToUint32
-	ExternalFunctionsMap["CastAnyToUint64"] = CastAnyToUint64
-	ExternalFunctionsMap["CastAnyToUint"] = CastAnyToUint
-	ExternalFunctionsMap["CastAnyToFloat32"] = CastAnyToFloat32
-	ExternalFunctionsMap["CastAnyToFloat64"] = CastAnyToFloat64
-	ExternalFunctionsMap["CastAnyToComplex64"] = CastAnyToComplex64
-	ExternalFunctionsMap["CastAnyToComplex128"] = CastAnyToComplex128
-	ExternalFunctionsMap["CastAnyToByte"] = CastAnyToByte
-	ExternalFunctionsMap["CastAnyToRune"] = CastAnyToRune
-	ExternalFunctionsMap["CastArrayMapStringAnyToAny"] = CastArrayMapStringAnyToAny
-	ExternalFunctionsMap["CastAnyToInterface"] = CastAnyToInterface
-	ExternalFunctionsMap["CastInterfaceToAny"] = CastInterfaceToAny
-	ExternalFunctionsMap["CastStringToAny"] = CastStringToAny
-	ExternalFunctionsMap["CastBoolToAny"] = CastBoolToAny
-	ExternalFunctionsMap["CastInt8ToAny"] = CastInt8ToAny
-	ExternalFunctionsMap["CastInt16ToAny"] = CastInt16ToAny
-	ExternalFunctionsMap["CastInt32ToAny"] = CastInt32ToAny
-	ExternalFunctionsMap["CastInt64ToAny"] = CastInt64ToAny
-	ExternalFunctionsMap["CastIntToAny"] = CastIntToAny
-	ExternalFunctionsMap["CastUint8ToAny"] = CastUint8ToAny
-	ExternalFunctionsMap["CastUint16ToAny"] = CastUint16ToAny
-	ExternalFunctionsMap["CastUint32ToAny"] = CastUint32ToAny
-	ExternalFunctionsMap["CastUint64ToAny"] = CastUint64ToAny
-	ExternalFunctionsMap["CastUintToAny"] = CastUintToAny
-	ExternalFunctionsMap["CastFloat32ToAny"] = CastFloat32ToAny
-	ExternalFunctionsMap["CastFloat64ToAny"] = CastFloat64ToAny
-	ExternalFunctionsMap["CastComplex64ToAny"] = CastComplex64ToAny
-	ExternalFunctionsMap["CastComplex128ToAny"] = CastComplex128ToAny
-	ExternalFunctionsMap["CastByteToAny"] = CastByteToAny
-	ExternalFunctionsMap["CastRuneToAny"] = CastRuneToAny
-}
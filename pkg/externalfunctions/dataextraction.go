@@ -35,6 +35,7 @@ import (
 
 	"github.com/ansys/aali-flowkit/pkg/privatefunctions/codegeneration"
 	"github.com/ansys/aali-flowkit/pkg/privatefunctions/graphdb"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/qdrant/go-client/qdrant"
 
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
@@ -54,6 +55,7 @@ import (
 //   - @displayName: List Github Files
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
@@ -64,22 +66,21 @@ import (
 //
 // Returns:
 //   - githubFilesToExtract: github files to extract.
-func GetGithubFilesToExtract(githubRepoName string, githubRepoOwner string,
+//   - error: an error if the files cannot be fetched from GitHub
+func GetGithubFilesToExtract(ctx context.Context, githubRepoName string, githubRepoOwner string,
 	githubRepoBranch string, githubAccessToken string, githubFileExtensions []string,
-	githubFilteredDirectories []string, githubExcludedDirectories []string) (githubFilesToExtract []string) {
+	githubFilteredDirectories []string, githubExcludedDirectories []string) (githubFilesToExtract []string, err error) {
 	// If github repo name is empty, return empty list.
 	if githubRepoName == "" {
-		return githubFilesToExtract
+		return githubFilesToExtract, nil
 	}
 
-	client, ctx := dataExtractNewGithubClient(githubAccessToken)
+	client := dataExtractNewGithubClient(ctx, githubAccessToken)
 
 	// Retrieve the specified branch SHA (commit hash) from the GitHub repository. This is used to identify the latest state of the branch.
 	branch, _, err := client.Repositories.GetBranch(ctx, githubRepoOwner, githubRepoName, githubRepoBranch, 1)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error getting branch %s: %v", githubRepoBranch, err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, logError(nil, ErrUpstreamUnavailable, "Error getting branch %s: %v", githubRepoBranch, err)
 	}
 
 	// Extract the SHA from the branch information.
@@ -88,9 +89,7 @@ func GetGithubFilesToExtract(githubRepoName string, githubRepoOwner string,
 	// Retrieve the Git tree associated with the SHA. This tree represents the directory structure (files and subdirectories) of the repository at the specified SHA.
 	tree, _, err := client.Git.GetTree(ctx, githubRepoOwner, githubRepoName, sha, true)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error getting tree: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, logError(nil, ErrUpstreamUnavailable, "Error getting tree: %v", err)
 	}
 
 	// Extract the files that need to be extracted from the tree.
@@ -98,10 +97,10 @@ func GetGithubFilesToExtract(githubRepoName string, githubRepoOwner string,
 
 	// Log the files that need to be extracted.
 	for _, file := range githubFilesToExtract {
-		logging.Log.Debugf(&logging.ContextMap{}, "Github file to extract: %s \n", file)
+		redact.Log.Debugf(&logging.ContextMap{}, "Github file to extract: %s \n", file)
 	}
 
-	return githubFilesToExtract
+	return githubFilesToExtract, nil
 }
 
 // GetLocalFilesToExtract gets all files from local that need to be extracted.
@@ -117,18 +116,17 @@ func GetGithubFilesToExtract(githubRepoName string, githubRepoOwner string,
 //
 // Returns:
 //   - localFilesToExtract: local files to extract.
+//   - error: an error if the files cannot be read
 func GetLocalFilesToExtract(localPath string, localFileExtensions []string,
-	localFilteredDirectories []string, localExcludedDirectories []string) (localFilesToExtract []string) {
+	localFilteredDirectories []string, localExcludedDirectories []string) (localFilesToExtract []string, err error) {
 	// If local path is empty, return empty list.
 	if localPath == "" {
-		return localFilesToExtract
+		return localFilesToExtract, nil
 	}
 
 	// Check if the local path exists.
 	if _, err := os.Stat(localPath); os.IsNotExist(err) {
-		errMessage := fmt.Sprintf("Local path does not exist: %s", localPath)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, logError(nil, ErrNotFound, "Local path does not exist: %s", localPath)
 	}
 
 	localFiles := &[]string{}
@@ -140,19 +138,17 @@ func GetLocalFilesToExtract(localPath string, localFileExtensions []string,
 	}
 
 	// Walk through all files and directories executing the walker function.
-	err := filepath.Walk(localPath, walkFn)
+	err = filepath.Walk(localPath, walkFn)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error walking through the files: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, logError(nil, ErrUpstreamUnavailable, "Error walking through the files: %v", err)
 	}
 
 	// Log the files that need to be extracted.
 	for _, file := range *localFiles {
-		logging.Log.Debugf(&logging.ContextMap{}, "Local file to extract: %s \n", file)
+		redact.Log.Debugf(&logging.ContextMap{}, "Local file to extract: %s \n", file)
 	}
 
-	return *localFiles
+	return *localFiles, nil
 }
 
 // AppendStringSlices creates a new slice by appending all elements of the provided slices.
@@ -184,26 +180,27 @@ func AppendStringSlices(slice1, slice2, slice3, slice4, slice5 []string) []strin
 //   - @displayName: Download Github File Content
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
 //   - gihubFilePath: path to file in the github repository.
 //   - githubAccessToken: access token for github.
+//   - @secret
 //
 // Returns:
 //   - checksum: checksum of file.
 //   - content: content of file.
-func DownloadGithubFileContent(githubRepoName string, githubRepoOwner string,
-	githubRepoBranch string, gihubFilePath string, githubAccessToken string) (checksum string, content []byte) {
+//   - error: an error if the files cannot be fetched from GitHub
+func DownloadGithubFileContent(ctx context.Context, githubRepoName string, githubRepoOwner string,
+	githubRepoBranch string, gihubFilePath string, githubAccessToken string) (checksum string, content []byte, err error) {
 
-	checksum, content, err := downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
+	checksum, content, err = downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return "", nil, logError(nil, ErrUpstreamUnavailable, "Error getting file content from github: %v", err)
 	}
 
-	return checksum, content
+	return checksum, content, nil
 }
 
 // DownloadGithubFilesContent downloads file content from github and returns checksum and content.
@@ -212,6 +209,7 @@ func DownloadGithubFileContent(githubRepoName string, githubRepoOwner string,
 //   - @displayName: Download Github Files Content
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
@@ -220,22 +218,21 @@ func DownloadGithubFileContent(githubRepoName string, githubRepoOwner string,
 //
 // Returns:
 //   - filesMap: map of file paths to file content.
-func DownloadGithubFilesContent(githubRepoName string, githubRepoOwner string,
-	githubRepoBranch string, gihubFilePaths []string, githubAccessToken string) (filesMap map[string][]byte) {
+//   - error: an error if the files cannot be fetched from GitHub
+func DownloadGithubFilesContent(ctx context.Context, githubRepoName string, githubRepoOwner string,
+	githubRepoBranch string, gihubFilePaths []string, githubAccessToken string) (filesMap map[string][]byte, err error) {
 	filesMap = make(map[string][]byte)
 
 	for _, gihubFilePath := range gihubFilePaths {
-		_, content, err := downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
+		_, content, err := downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "Error getting file content from github: %v", err)
 		}
 
 		filesMap[gihubFilePath] = content
 	}
 
-	return filesMap
+	return filesMap, nil
 }
 
 // GetLocalFileContent reads local file and returns checksum and content.
@@ -249,16 +246,15 @@ func DownloadGithubFilesContent(githubRepoName string, githubRepoOwner string,
 // Returns:
 //   - checksum: checksum of file.
 //   - content: content of file.
-func GetLocalFileContent(localFilePath string) (checksum string, content []byte) {
+//   - error: an error if the files cannot be read
+func GetLocalFileContent(localFilePath string) (checksum string, content []byte, err error) {
 	// Get the checksum and content of the local file.
-	checksum, content, err := getLocalFileContent(localFilePath)
+	checksum, content, err = getLocalFileContent(localFilePath)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error getting file content from local: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return "", nil, logError(nil, ErrNotFound, "Error getting file content from local: %v", err)
 	}
 
-	return checksum, content
+	return checksum, content, nil
 }
 
 // GetLocalFilesContent reads local files and returns content.
@@ -271,21 +267,20 @@ func GetLocalFileContent(localFilePath string) (checksum string, content []byte)
 //
 // Returns:
 //   - filesMap: map of file paths to file content.
-func GetLocalFilesContent(localFilePaths []string) (filesMap map[string][]byte) {
+//   - error: an error if the files cannot be read
+func GetLocalFilesContent(localFilePaths []string) (filesMap map[string][]byte, err error) {
 	filesMap = make(map[string][]byte)
 
 	for _, localFilePath := range localFilePaths {
 		_, content, err := getLocalFileContent(localFilePath)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error getting file content from local: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrNotFound, "Error getting file content from local: %v", err)
 		}
 
 		filesMap[localFilePath] = content
 	}
 
-	return filesMap
+	return filesMap, nil
 }
 
 // GetDocumentType returns the document type of a file.
@@ -312,17 +307,20 @@ func GetDocumentType(filePath string) (documentType string) {
 //   - @displayName: Split Content
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - content: content to split.
 //   - documentType: type of document.
 //   - chunkSize: size of the chunks.
+//   - @min: 1
 //   - chunkOverlap: overlap of the chunks.
+//   - @min: 0
 //
 // Returns:
 //   - output: chunks as an slice of strings.
-func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int, chunkOverlap int) (output []string) {
+//   - error: an error if the content cannot be split
+func LangchainSplitter(ctx context.Context, bytesContent []byte, documentType string, chunkSize int, chunkOverlap int) (output []string, err error) {
 	output = []string{}
 	var splittedChunks []schema.Document
-	var err error
 
 	// Creating a reader from the content of the file.
 	reader := bytes.NewReader(bytesContent)
@@ -339,9 +337,7 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 		htmlLoader := documentloaders.NewHTML(reader)
 		splittedChunks, err = htmlLoader.LoadAndSplit(context.Background(), splitter)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error getting file content from github: %v", err)
 		}
 
 		for _, chunk := range splittedChunks {
@@ -349,27 +345,21 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 		}
 
 	case "py", "ipynb":
-		output, err = dataExtractionPerformSplitterRequest(bytesContent, "py", chunkSize, chunkOverlap)
+		output, err = dataExtractionPerformSplitterRequest(ctx, bytesContent, "py", chunkSize, chunkOverlap)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error splitting python document: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "Error splitting python document: %v", err)
 		}
 
 	case "pdf":
-		output, err = dataExtractionPerformSplitterRequest(bytesContent, "pdf", chunkSize, chunkOverlap)
+		output, err = dataExtractionPerformSplitterRequest(ctx, bytesContent, "pdf", chunkSize, chunkOverlap)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error splitting pdf document: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "Error splitting pdf document: %v", err)
 		}
 
 	case "pptx", "ppt":
-		output, err = dataExtractionPerformSplitterRequest(bytesContent, "ppt", chunkSize, chunkOverlap)
+		output, err = dataExtractionPerformSplitterRequest(ctx, bytesContent, "ppt", chunkSize, chunkOverlap)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error splitting ppt document: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "Error splitting ppt document: %v", err)
 		}
 
 	default:
@@ -377,9 +367,7 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 		txtLoader := documentloaders.NewText(reader)
 		splittedChunks, err = txtLoader.LoadAndSplit(context.Background(), splitter)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error getting file content from github: %v", err)
 		}
 
 		for _, chunk := range splittedChunks {
@@ -388,9 +376,9 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 	}
 
 	// Log number of chunks created.
-	logging.Log.Debugf(&logging.ContextMap{}, "Splitted document in %v chunks \n", len(output))
+	redact.Log.Debugf(&logging.ContextMap{}, "Splitted document in %v chunks \n", len(output))
 
-	return output
+	return output, nil
 }
 
 // GenerateDocumentTree generates a tree structure from the document chunks.
@@ -399,6 +387,7 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 //   - @displayName: Document Tree
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - documentName: name of the document.
 //   - documentId: id of the document.
 //   - documentChunks: chunks of the document.
@@ -411,10 +400,11 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 //
 // Returns:
 //   - documentData: tree structure of the document.
-func GenerateDocumentTree(documentName string, documentId string, documentChunks []string,
-	embeddingsDimensions int, getSummary bool, getKeywords bool, numKeywords int, chunkSize int, numLlmWorkers int) (returnedDocumentData []sharedtypes.DbData) {
+//   - error: an error if the summaries, keywords or embeddings cannot be created
+func GenerateDocumentTree(ctx context.Context, documentName string, documentId string, documentChunks []string,
+	embeddingsDimensions int, getSummary bool, getKeywords bool, numKeywords int, chunkSize int, numLlmWorkers int) (returnedDocumentData []sharedtypes.DbData, err error) {
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Processing document: %s with %v leaf chunks \n", documentName, len(documentChunks))
+	redact.Log.Debugf(&logging.ContextMap{}, "Processing document: %s with %v leaf chunks \n", documentName, len(documentChunks))
 
 	// Create llm handler input channel and wait group.
 	llmHandlerInputChannel := make(chan *DataExtractionLLMInputChannelItem, 40)
@@ -424,7 +414,7 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 	// Start LLM Handler workers.
 	for i := 0; i < numLlmWorkers; i++ {
 		llmHandlerWaitGroup.Add(1)
-		go dataExtractionLLMHandlerWorker(&llmHandlerWaitGroup, llmHandlerInputChannel, errorChannel, embeddingsDimensions)
+		go dataExtractionLLMHandlerWorker(ctx, &llmHandlerWaitGroup, llmHandlerInputChannel, errorChannel, embeddingsDimensions)
 	}
 
 	// Create root data object.
@@ -450,7 +440,7 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 	// Create child data objects.
 	orderedChildDataObjects, err := dataExtractionDocumentLevelHandler(llmHandlerInputChannel, errorChannel, documentChunks, documentId, documentName, getSummary, getKeywords, uint32(numKeywords))
 	if err != nil {
-		panic(err.Error())
+		return nil, classError(err, ErrUpstreamUnavailable)
 	}
 
 	// If summary is disabled -> flat structure, only iterate over chunks.
@@ -514,7 +504,7 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 
 			orderedChildDataObjectsFromBranches, err := dataExtractionDocumentLevelHandler(llmHandlerInputChannel, errorChannel, textChunks, documentId, documentName, getSummary, getKeywords, uint32(numKeywords))
 			if err != nil {
-				panic(err.Error())
+				return nil, classError(err, ErrUpstreamUnavailable)
 			}
 
 			// Exit if only one -> assign details to root.
@@ -573,14 +563,12 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 
 	// Send batch embedding request to LLM handler. Set max batch size to 1000.
 	maxBatchSize := 100
-	err = dataExtractionProcessBatchEmbeddings(documentData, maxBatchSize)
+	err = dataExtractionProcessBatchEmbeddings(ctx, documentData, maxBatchSize)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error in dataExtractionProcessBatchEmbeddings: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, classError(fmt.Errorf("Error in dataExtractionProcessBatchEmbeddings: %w", err), ErrUpstreamUnavailable)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Finished processing document: %s \n", documentName)
+	redact.Log.Debugf(&logging.ContextMap{}, "Finished processing document: %s \n", documentName)
 
 	// Copy document data to returned document data
 	returnedDocumentData = make([]sharedtypes.DbData, len(documentData))
@@ -592,7 +580,7 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 	close(llmHandlerInputChannel)
 	llmHandlerWaitGroup.Wait()
 
-	return returnedDocumentData
+	return returnedDocumentData, nil
 }
 
 // LoadCodeGenerationElements loads code generation elements from an xml or json file.
@@ -606,36 +594,30 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 //
 // Returns:
 //   - elements: code generation elements.
-func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elements []sharedtypes.CodeGenerationElement) {
+//   - error: an error if the files cannot be loaded or parsed
+func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elements []sharedtypes.CodeGenerationElement, err error) {
 	// Get the file extension.
 	fileExtension := filepath.Ext(elementsFilePath)
 
 	// Create object definition document.
 	objectDefinitionDoc := codegeneration.XMLObjectDefinitionDocument{}
 
-	var err error
 	switch fileExtension {
 	case ".xml":
 		// Unmarshal the XML content into the object definition document.
 		err = xml.Unmarshal([]byte(content), &objectDefinitionDoc)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error unmarshalling object definition document: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error unmarshalling object definition document: %v", err)
 		}
 	case ".json":
 		// Unmarshal the JSON content into a list of assembly members.
 		err = json.Unmarshal(content, &objectDefinitionDoc.Members)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error unmarshalling object definition document: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error unmarshalling object definition document: %v", err)
 		}
 
 	default:
-		errMessage := fmt.Sprintf("Unknown file extension: %s", fileExtension)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, logError(nil, ErrInvalidInput, "Unknown file extension: %s", fileExtension)
 	}
 
 	for _, objectDefinition := range objectDefinitionDoc.Members {
@@ -666,9 +648,7 @@ func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elemen
 		// Create a list with all the return types of the element.
 		element.ReturnElementList, err = codegeneration.CreateReturnList(objectDefinition.ReturnType)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error creating return element list: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error creating return element list: %v", err)
 		}
 
 		switch prefix {
@@ -727,25 +707,21 @@ func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elemen
 			element.Dependencies = dependencies
 
 		default:
-			errMessage := fmt.Sprintf("Unknown prefix: %s", prefix)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Unknown prefix: %s", prefix)
 		}
 
 		// Get name pseudocode and formatted name.
 		element.NamePseudocode, element.NameFormatted, err = codegeneration.ProcessElementName(element.Name, element.Dependencies)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error processing element name: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error processing element name: %v", err)
 		}
 
 		elements = append(elements, element)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Loaded %v code generation elements from file: %s", len(elements), elementsFilePath)
+	redact.Log.Debugf(&logging.ContextMap{}, "Loaded %v code generation elements from file: %s", len(elements), elementsFilePath)
 
-	return elements
+	return elements, nil
 }
 
 func mapToSparseVec(m map[uint]float32) *qdrant.Vector {
@@ -766,29 +742,31 @@ func mapToSparseVec(m map[uint]float32) *qdrant.Vector {
 //   - @displayName: Store Elements in Vector Database
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - elements: code generation elements.
 //   - elementsCollectionName: name of the collection.
 //   - batchSize: batch size for embeddings.
 //   - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)
-func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement, elementsCollectionName string, batchSize int, vectorDistance string) {
+//
+// Returns:
+//   - error: an error if the embeddings cannot be created or the vector database cannot be accessed
+func StoreElementsInVectorDatabase(ctx context.Context, elements []sharedtypes.CodeGenerationElement, elementsCollectionName string, batchSize int, vectorDistance string) error {
 	// Set default batch size if not provided.
 	if batchSize <= 0 {
 		batchSize = 2
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Storing %v code generation elements in the vector database", len(elements))
+	redact.Log.Debugf(&logging.ContextMap{}, "Storing %v code generation elements in the vector database", len(elements))
 
 	// Generate dense and sparse embeddings
-	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddings(elements, batchSize)
+	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddings(ctx, elements, batchSize)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error generating embeddings for elements: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return classError(fmt.Errorf("Error generating embeddings for elements: %w", err), ErrUpstreamUnavailable)
 	}
 
 	// if you have no embeddings, quit
 	if len(denseEmbeddings) == 0 {
-		return
+		return nil
 	}
 	// assume that all embeddings have same length
 	vectorSize := uint64(len(denseEmbeddings[0]))
@@ -818,13 +796,9 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 		Port: config.GlobalConfig.QDRANT_PORT,
 	})
 	if err != nil {
-		errMessage := fmt.Sprintf("Error creating qdrant client: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return logError(nil, ErrUpstreamUnavailable, "Error creating qdrant client: %v", err)
 	}
 
-	ctx := context.TODO()
-
 	// Create the collection.
 	err = qdrant_utils.CreateCollectionIfNotExists(
 		ctx,
@@ -839,9 +813,7 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 		}),
 	)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error creating the collection: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return logError(nil, ErrUpstreamUnavailable, "Error creating the collection: %v", err)
 	}
 
 	// insert into db
@@ -850,9 +822,7 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 		Points:         points,
 	})
 	if err != nil {
-		errMessage := fmt.Sprintf("Error inserting data into the vector database: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return logError(nil, ErrUpstreamUnavailable, "Error inserting data into the vector database: %v", err)
 	}
 
 	// create some indexes
@@ -872,11 +842,10 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 	for _, index := range indexes {
 		_, err = client.CreateFieldIndex(ctx, index)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error creating index on field %q: %v", index.FieldName, err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return logError(nil, ErrUpstreamUnavailable, "Error creating index on field %q: %v", index.FieldName, err)
 		}
 	}
+	return nil
 }
 
 // StoreElementsInGraphDatabase stores elements in the graph database.
@@ -886,37 +855,35 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 //
 // Parameters:
 //   - elements: code generation elements.
-func StoreElementsInGraphDatabase(elements []sharedtypes.CodeGenerationElement) {
+//
+// Returns:
+//   - error: an error if the graph database cannot be accessed
+func StoreElementsInGraphDatabase(elements []sharedtypes.CodeGenerationElement) error {
 	ctx := &logging.ContextMap{}
 
 	// Initialize the graph database.
 	err := graphdb.Initialize(config.GlobalConfig.GRAPHDB_ADDRESS)
 	if err != nil {
-		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error initializing graphdb: %v", err)
 	}
 
 	err = graphdb.GraphDbDriver.CreateSchema()
 	if err != nil {
-		logPanic(ctx, "error error creating aali schema: %v", err)
+		return logError(ctx, ErrUpstreamUnavailable, "error error creating aali schema: %v", err)
 	}
 
 	// Add the elements to the graph database.
 	err = graphdb.GraphDbDriver.AddCodeGenerationElementNodes(elements)
 	if err != nil {
-		errMsg := fmt.Sprintf("error adding code gen element nodes to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error adding code gen element nodes to graphdb: %v", err)
 	}
 
 	// Add the dependencies to the graph database.
 	err = graphdb.GraphDbDriver.CreateCodeGenerationRelationships(elements)
 	if err != nil {
-		errMsg := fmt.Sprintf("error adding code gen relationships to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error adding code gen relationships to graphdb: %v", err)
 	}
+	return nil
 }
 
 // LoadAndCheckExampleDependencies loads and checks the dependencies of the examples.
@@ -933,19 +900,18 @@ func StoreElementsInGraphDatabase(elements []sharedtypes.CodeGenerationElement)
 // Returns:
 //   - checkedDependenciesMap: checked dependencies.
 //   - equivalencesMap: equivalences.
+//   - error: an error if the dependencies cannot be loaded
 func LoadAndCheckExampleDependencies(
 	dependenciesContent []byte,
 	elements []sharedtypes.CodeGenerationElement,
 	instancesReplacementDict map[string]string,
 	InstancesReplacementPriorityList []string,
-) (checkedDependenciesMap map[string][]string, equivalencesMap map[string]map[string]string) {
+) (checkedDependenciesMap map[string][]string, equivalencesMap map[string]map[string]string, err error) {
 	// Unmarshal the JSON content into the dependencies map.
 	var dependenciesMap map[string][]string
-	err := json.Unmarshal(dependenciesContent, &dependenciesMap)
+	err = json.Unmarshal(dependenciesContent, &dependenciesMap)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error unmarshalling dependencies: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, nil, logError(nil, ErrInvalidInput, "Error unmarshalling dependencies: %v", err)
 	}
 
 	// Initialize maps.
@@ -1055,7 +1021,7 @@ func LoadAndCheckExampleDependencies(
 		equivalencesMap[key] = uniqueEquivalences
 	}
 
-	return checkedDependenciesMap, equivalencesMap
+	return checkedDependenciesMap, equivalencesMap, nil
 }
 
 // LoadCodeGenerationExamples loads code generation examples from the provided paths.
@@ -1064,6 +1030,7 @@ func LoadAndCheckExampleDependencies(
 //   - @displayName: Load Code Generation Examples
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - source: source of the examples (local or github).
 //   - examplesToExtract: paths to the examples.
 //   - githubRepoName: name of the github repository.
@@ -1077,7 +1044,8 @@ func LoadAndCheckExampleDependencies(
 //
 // Returns:
 //   - examples: code generation examples.
-func LoadCodeGenerationExamples(
+//   - error: an error if the files cannot be loaded or parsed
+func LoadCodeGenerationExamples(ctx context.Context,
 	source string,
 	examplesToExtract []string,
 	githubRepoName string,
@@ -1087,7 +1055,7 @@ func LoadCodeGenerationExamples(
 	dependencies map[string][]string,
 	equivalencesMap map[string]map[string]string,
 	chunkSize int,
-	chunkOverlap int) (examples []sharedtypes.CodeGenerationExample) {
+	chunkOverlap int) (examples []sharedtypes.CodeGenerationExample, err error) {
 	// Initialize the examples slice.
 	examples = []sharedtypes.CodeGenerationExample{}
 
@@ -1100,29 +1068,21 @@ func LoadCodeGenerationExamples(
 		case "local":
 			_, content, err = getLocalFileContent(examplePath)
 			if err != nil {
-				errMessage := fmt.Sprintf("Error getting local file content: %v", err)
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				return nil, logError(nil, ErrNotFound, "Error getting local file content: %v", err)
 			}
 		case "github":
-			_, content, err = downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, examplePath, githubAccessToken)
+			_, content, err = downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, examplePath, githubAccessToken)
 			if err != nil {
-				errMessage := fmt.Sprintf("Error getting github file content: %v", err)
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				return nil, logError(nil, ErrUpstreamUnavailable, "Error getting github file content: %v", err)
 			}
 		default:
-			errMessage := fmt.Sprintf("Unknown data source: %s", source)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Unknown data source: %s", source)
 		}
 
 		// Create the chunks for the current element.
 		chunks, err := dataExtractionTextSplitter(string(content), chunkSize, chunkOverlap)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error splitting text into chunks: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error splitting text into chunks: %v", err)
 		}
 
 		// The name should be only the file name
@@ -1146,7 +1106,7 @@ func LoadCodeGenerationExamples(
 		examples = append(examples, example)
 	}
 
-	return examples
+	return examples, nil
 }
 
 // StoreExamplesInVectorDatabase stores examples in the vector database.
@@ -1155,11 +1115,15 @@ func LoadCodeGenerationExamples(
 //   - @displayName: Store Examples in Vector Database
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - examples: code generation examples.
 //   - examplesCollectionName: name of the collection.
 //   - batchSize: batch size for embeddings.
 //   - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)
-func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample, examplesCollectionName string, batchSize int, vectorDistance string) {
+//
+// Returns:
+//   - error: an error if the embeddings cannot be created or the vector database cannot be accessed
+func StoreExamplesInVectorDatabase(ctx context.Context, examples []sharedtypes.CodeGenerationExample, examplesCollectionName string, batchSize int, vectorDistance string) error {
 	// Set default batch size if not provided.
 	if batchSize <= 0 {
 		batchSize = 2
@@ -1201,16 +1165,14 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 	}
 
 	// Generate dense and sparse embeddings
-	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForExamples(vectorExamples, batchSize)
+	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForExamples(ctx, vectorExamples, batchSize)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error generating embeddings for examples: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return classError(fmt.Errorf("Error generating embeddings for examples: %w", err), ErrUpstreamUnavailable)
 	}
 
 	// if you have no embeddings, quit
 	if len(denseEmbeddings) == 0 {
-		return
+		return nil
 	}
 	// assume that all embeddings have same length
 	vectorSize := uint64(len(denseEmbeddings[0]))
@@ -1254,11 +1216,9 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "Error creating qdrant client: %v", err)
+		return logError(nil, ErrUpstreamUnavailable, "Error creating qdrant client: %v", err)
 	}
 
-	ctx := context.TODO()
-
 	// Create the collection.
 	err = qdrant_utils.CreateCollectionIfNotExists(
 		ctx,
@@ -1273,7 +1233,7 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 		}),
 	)
 	if err != nil {
-		logPanic(nil, "Error creating the collection: %v", err)
+		return logError(nil, ErrUpstreamUnavailable, "Error creating the collection: %v", err)
 	}
 
 	// insert into db
@@ -1282,7 +1242,7 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 		Points:         points,
 	})
 	if err != nil {
-		logPanic(nil, "Error inserting data into the vector database: %v", err)
+		return logError(nil, ErrUpstreamUnavailable, "Error inserting data into the vector database: %v", err)
 	}
 
 	// create some indexes
@@ -1302,11 +1262,10 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 	for _, index := range indexes {
 		_, err = client.CreateFieldIndex(ctx, index)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error creating index on field %q: %v", index.FieldName, err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return logError(nil, ErrUpstreamUnavailable, "Error creating index on field %q: %v", index.FieldName, err)
 		}
 	}
+	return nil
 }
 
 // StoreExamplesInGraphDatabase stores examples in the graph database.
@@ -1316,37 +1275,35 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 //
 // Parameters:
 //   - examples: code generation examples.
-func StoreExamplesInGraphDatabase(examples []sharedtypes.CodeGenerationExample) {
+//
+// Returns:
+//   - error: an error if the graph database cannot be accessed
+func StoreExamplesInGraphDatabase(examples []sharedtypes.CodeGenerationExample) error {
 	ctx := &logging.ContextMap{}
 
 	// Initialize the graph database.
 	err := graphdb.Initialize(config.GlobalConfig.GRAPHDB_ADDRESS)
 	if err != nil {
-		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error initializing graphdb: %v", err)
 	}
 
 	err = graphdb.GraphDbDriver.CreateSchema()
 	if err != nil {
-		logPanic(ctx, "error error creating aali schema: %v", err)
+		return logError(ctx, ErrUpstreamUnavailable, "error error creating aali schema: %v", err)
 	}
 
 	// Add the elements to the graph database.
 	err = graphdb.GraphDbDriver.AddCodeGenerationExampleNodes(examples)
 	if err != nil {
-		errMsg := fmt.Sprintf("error adding code gen example nodes to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error adding code gen example nodes to graphdb: %v", err)
 	}
 
 	// Add the dependencies to the graph database.
 	err = graphdb.GraphDbDriver.CreateCodeGenerationExampleRelationships(examples)
 	if err != nil {
-		errMsg := fmt.Sprintf("error adding code gen example relationships to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error adding code gen example relationships to graphdb: %v", err)
 	}
+	return nil
 }
 
 // LoadUserGuideSections loads user guide sections from the provided paths.
@@ -1355,6 +1312,7 @@ func StoreExamplesInGraphDatabase(examples []sharedtypes.CodeGenerationExample)
 //   - @displayName: Load User Guide Sections
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - source: source of the sections (local or github).
 //   - sectionFilePaths: paths to the sections.
 //   - githubRepoName: name of the github repository.
@@ -1364,8 +1322,9 @@ func StoreExamplesInGraphDatabase(examples []sharedtypes.CodeGenerationExample)
 //
 // Returns:
 //   - sections: user guide sections.
-func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoName string, githubRepoOwner string,
-	githubRepoBranch string, githubAccessToken string) (sections []sharedtypes.CodeGenerationUserGuideSection) {
+//   - error: an error if the files cannot be loaded or parsed
+func LoadUserGuideSections(ctx context.Context, source string, sectionFilePaths []string, githubRepoName string, githubRepoOwner string,
+	githubRepoBranch string, githubAccessToken string) (sections []sharedtypes.CodeGenerationUserGuideSection, err error) {
 	// Initialize the sections.
 	sections = []sharedtypes.CodeGenerationUserGuideSection{}
 
@@ -1377,21 +1336,15 @@ func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoN
 		case "local":
 			_, content, err = getLocalFileContent(path)
 			if err != nil {
-				errMessage := fmt.Sprintf("Error getting local file content: %v", err)
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				return nil, logError(nil, ErrNotFound, "Error getting local file content: %v", err)
 			}
 		case "github":
-			_, content, err = downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, path, githubAccessToken)
+			_, content, err = downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, path, githubAccessToken)
 			if err != nil {
-				errMessage := fmt.Sprintf("Error getting github file content: %v", err)
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				return nil, logError(nil, ErrUpstreamUnavailable, "Error getting github file content: %v", err)
 			}
 		default:
-			errMessage := fmt.Sprintf("Unknown data source: %s", source)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Unknown data source: %s", source)
 		}
 
 		// Initialize the sections.
@@ -1400,18 +1353,16 @@ func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoN
 		// Unmarshal the JSON content into the sections.
 		err = json.Unmarshal(content, &newSections)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error unmarshalling user guide sections: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrInvalidInput, "Error unmarshalling user guide sections: %v", err)
 		}
 
 		// Add the new sections to the sections.
 		sections = append(sections, newSections...)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Loaded %v user guide sections \n", len(sections))
+	redact.Log.Debugf(&logging.ContextMap{}, "Loaded %v user guide sections \n", len(sections))
 
-	return sections
+	return sections, nil
 }
 
 // StoreUserGuideSectionsInVectorDatabase stores user guide sections in the vector database.
@@ -1420,13 +1371,17 @@ func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoN
 //   - @displayName: Store User Guide Sections in Vector Database
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - sections: user guide sections.
 //   - userGuideCollectionName: name of the collection.
 //   - batchSize: batch size for embeddings.
 //   - chunkSize: size of the chunks.
 //   - chunkOverlap: overlap of the chunks.
 //   - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)
-func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGenerationUserGuideSection, userGuideCollectionName string, batchSize int, chunkSize int, chunkOverlap int, vectorDistance string) {
+//
+// Returns:
+//   - error: an error if the embeddings cannot be created or the vector database cannot be accessed
+func StoreUserGuideSectionsInVectorDatabase(ctx context.Context, sections []sharedtypes.CodeGenerationUserGuideSection, userGuideCollectionName string, batchSize int, chunkSize int, chunkOverlap int, vectorDistance string) error {
 	// Set default batch size if not provided.
 	if batchSize <= 0 {
 		batchSize = 2
@@ -1438,9 +1393,7 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 		// Create the chunks for the current element.
 		chunks, err := dataExtractionTextSplitter(section.Content, chunkSize, chunkOverlap)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error splitting text into chunks: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return logError(nil, ErrInvalidInput, "Error splitting text into chunks: %v", err)
 		}
 		section.Chunks = chunks
 
@@ -1479,16 +1432,14 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 	}
 
 	// Generate dense and sparse embeddings
-	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(vectorUserGuideSectionChunks, batchSize)
+	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(ctx, vectorUserGuideSectionChunks, batchSize)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error generating embeddings for user guide sections: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return classError(fmt.Errorf("Error generating embeddings for user guide sections: %w", err), ErrUpstreamUnavailable)
 	}
 
 	// if you have no embeddings, quit
 	if len(denseEmbeddings) == 0 {
-		return
+		return nil
 	}
 	// assume that all embeddings have same length
 	vectorSize := uint64(len(denseEmbeddings[0]))
@@ -1522,11 +1473,9 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "Error creating qdrant client: %v", err)
+		return logError(nil, ErrUpstreamUnavailable, "Error creating qdrant client: %v", err)
 	}
 
-	ctx := context.TODO()
-
 	// Create the collection.
 	err = qdrant_utils.CreateCollectionIfNotExists(
 		ctx,
@@ -1540,9 +1489,7 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 			"sparse_vector": {},
 		}))
 	if err != nil {
-		errMessage := fmt.Sprintf("Error creating the collection: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return logError(nil, ErrUpstreamUnavailable, "Error creating the collection: %v", err)
 	}
 
 	// insert into db
@@ -1551,9 +1498,7 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 		Points:         points,
 	})
 	if err != nil {
-		errMessage := fmt.Sprintf("Error inserting data into the vector database: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return logError(nil, ErrUpstreamUnavailable, "Error inserting data into the vector database: %v", err)
 	}
 
 	// create some indexes
@@ -1578,11 +1523,10 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 	for _, index := range indexes {
 		_, err = client.CreateFieldIndex(ctx, index)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error creating index on field %q: %v", index.FieldName, err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return logError(nil, ErrUpstreamUnavailable, "Error creating index on field %q: %v", index.FieldName, err)
 		}
 	}
+	return nil
 }
 
 // StoreUserGuideSectionsInGraphDatabase stores user guide sections in the graph database.
@@ -1593,37 +1537,35 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 // Parameters:
 //   - elements: user guide sections.
 //   - label: label for the sections (UserGuide by default).
-func StoreUserGuideSectionsInGraphDatabase(sections []sharedtypes.CodeGenerationUserGuideSection) {
+//
+// Returns:
+//   - error: an error if the graph database cannot be accessed
+func StoreUserGuideSectionsInGraphDatabase(sections []sharedtypes.CodeGenerationUserGuideSection) error {
 	ctx := &logging.ContextMap{}
 
 	// Initialize the graph database.
 	err := graphdb.Initialize(config.GlobalConfig.GRAPHDB_ADDRESS)
 	if err != nil {
-		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error initializing graphdb: %v", err)
 	}
 
 	err = graphdb.GraphDbDriver.CreateSchema()
 	if err != nil {
-		logPanic(ctx, "error error creating aali schema: %v", err)
+		return logError(ctx, ErrUpstreamUnavailable, "error error creating aali schema: %v", err)
 	}
 
 	// Add the elements to the graph database.
 	err = graphdb.GraphDbDriver.AddUserGuideSectionNodes(sections)
 	if err != nil {
-		errMsg := fmt.Sprintf("error adding user guide section nodes to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error adding user guide section nodes to graphdb: %v", err)
 	}
 
 	// Add the dependencies to the graph database.
 	err = graphdb.GraphDbDriver.CreateUserGuideSectionRelationships(sections)
 	if err != nil {
-		errMsg := fmt.Sprintf("error adding user guide section relationships to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		return logError(ctx, ErrUpstreamUnavailable, "error adding user guide section relationships to graphdb: %v", err)
 	}
+	return nil
 }
 
 // CreateGeneralDataExtractionDocumentObjects creates general data extraction document objects from
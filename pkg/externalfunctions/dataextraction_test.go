@@ -33,12 +33,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ansys/aali-flowkit/pkg/llmmock"
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
 	"github.com/ansys/aali-sharedtypes/pkg/aali_graphdb"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
 	"github.com/google/uuid"
+	"github.com/pandodao/tokenizer-go"
 	"github.com/qdrant/go-client/qdrant"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -95,7 +97,10 @@ func TestGetLocalFileContent(t *testing.T) {
 	expectedChecksum := hex.EncodeToString(hash.Sum(nil))
 
 	// Call the function with the test file.
-	actualChecksum, actualContent := GetLocalFileContent(tempFile.Name())
+	actualChecksum, actualContent, err := GetLocalFileContent(tempFile.Name())
+	if err != nil {
+		t.Fatalf("failed to get local file content: %v", err)
+	}
 
 	// Check if the actual checksum matches the expected checksum.
 	if actualChecksum != expectedChecksum {
@@ -135,6 +140,103 @@ func TestAppendStringSlices(t *testing.T) {
 	}
 }
 
+func TestGenerateDocumentTree(t *testing.T) {
+	serveLLMFixtures(t)
+
+	// without summaries the chunks are the leaves of the root
+	tree, err := GenerateDocumentTree(context.Background(), "guide.md", "guide", []string{"mesh", "solver"}, 4, false, true, 3, 100, 2)
+	assert.NoError(t, err)
+	require.Len(t, tree, 3)
+	root := tree[0]
+	assert.Equal(t, "root", root.Level)
+	assert.Equal(t, []uuid.UUID{tree[1].Guid, tree[2].Guid}, root.ChildIds)
+	assert.Equal(t, []float32{0.5, 0.5, 0.5, 0.5}, root.Embedding)
+	for _, leaf := range tree[1:] {
+		assert.Equal(t, "leaf", leaf.Level)
+		assert.Equal(t, root.Guid, *leaf.ParentId)
+		assert.Len(t, leaf.Embedding, 4)
+		assert.Len(t, leaf.Keywords, 3)
+		assert.Empty(t, leaf.Summary)
+	}
+	assert.Equal(t, tree[2].Guid, *tree[1].NextSiblingId)
+	assert.Equal(t, tree[1].Guid, *tree[2].PreviousSiblingId)
+}
+
+func TestGenerateDocumentTreeWithSummaries(t *testing.T) {
+	serveLLMFixtures(t)
+
+	// the summaries of the leaves are grouped into internal nodes fitting the chunk size, until one node is left for the root
+	chunkSize := 2*tokenizer.MustCalToken("A short summary.") + 1
+	tree, err := GenerateDocumentTree(context.Background(), "guide.md", "guide", []string{"mesh", "solver", "boundary"}, 4, true, false, 0, chunkSize, 2)
+	assert.NoError(t, err)
+	require.Len(t, tree, 6)
+
+	root := tree[0]
+	assert.Equal(t, "root", root.Level)
+	assert.Equal(t, "A short summary.", root.Summary)
+	assert.Equal(t, "A short summary.A short summary.", root.Text)
+	assert.Len(t, root.Embedding, 4)
+	leaves, internals := tree[1:4], tree[4:]
+	assert.Equal(t, []uuid.UUID{internals[0].Guid, internals[1].Guid}, root.ChildIds)
+	for _, internal := range internals {
+		assert.Equal(t, "internal", internal.Level)
+		assert.Equal(t, root.Guid, *internal.ParentId)
+	}
+	assert.Equal(t, []uuid.UUID{leaves[0].Guid, leaves[1].Guid}, internals[0].ChildIds)
+	assert.Equal(t, []uuid.UUID{leaves[2].Guid}, internals[1].ChildIds)
+	for _, leaf := range leaves {
+		assert.Equal(t, "leaf", leaf.Level)
+		assert.Equal(t, "A short summary.", leaf.Summary)
+		assert.Len(t, leaf.Embedding, 4)
+	}
+}
+
+func TestGenerateDocumentTreeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixtures *llmmock.Fixtures
+		timeout  time.Duration
+		class    error
+	}{
+		{
+			name:     "error response",
+			fixtures: &llmmock.Fixtures{Fixtures: []llmmock.Fixture{{Error: &llmmock.Error{Code: 400, Message: "invalid request"}}}},
+			class:    ErrInvalidInput,
+		},
+		{
+			// the summary request ends once the context of the request is done
+			name:     "canceled request",
+			fixtures: &llmmock.Fixtures{Fixtures: []llmmock.Fixture{{Delay: time.Hour, Chat: "A short summary."}}},
+			timeout:  50 * time.Millisecond,
+			class:    ErrUpstreamUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serveMockLLM(t, test.fixtures)
+			ctx := context.Background()
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+
+			errs := make(chan error, 1)
+			go func() {
+				_, err := GenerateDocumentTree(ctx, "guide.md", "guide", []string{"mesh"}, 4, true, false, 0, 100, 1)
+				errs <- err
+			}()
+			select {
+			case err := <-errs:
+				assert.ErrorIs(t, err, test.class)
+			case <-time.After(10 * time.Second):
+				t.Fatal("the document tree is still being generated")
+			}
+		})
+	}
+}
+
 type flowkitTestContainersConfig struct {
 	qdrant       bool
 	aaliEmbedder bool
@@ -397,7 +499,7 @@ func TestStoreElementsInVectorDatabase(t *testing.T) {
 	}
 	assert.Len(t, expectedPayloads, len(elements))
 
-	StoreElementsInVectorDatabase(elements, COLLECTIONNAME, 2, "cosine")
+	StoreElementsInVectorDatabase(context.Background(), elements, COLLECTIONNAME, 2, "cosine")
 
 	// query qdrant to make sure things are as they should be
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -518,7 +620,7 @@ func TestStoreExamplesInVectorDatabase(t *testing.T) {
 		},
 	}
 
-	StoreExamplesInVectorDatabase(examples, COLLECTIONNAME, 2, "cosine")
+	StoreExamplesInVectorDatabase(context.Background(), examples, COLLECTIONNAME, 2, "cosine")
 
 	// query qdrant to make sure things are as they should be
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -653,7 +755,7 @@ func TestStoreUserGuideSectionsInVectorDatabase(t *testing.T) {
 		},
 	}
 
-	StoreUserGuideSectionsInVectorDatabase(sections, COLLECTIONNAME, 2, 5, 1, "cosine")
+	StoreUserGuideSectionsInVectorDatabase(context.Background(), sections, COLLECTIONNAME, 2, 5, 1, "cosine")
 
 	// query qdrant to make sure things are as they should be
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -0,0 +1,146 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+)
+
+// Error classes for the trailing error returned by external functions.
+// Functions wrap one of these (e.g. with logError or fmt.Errorf("%w")) so that
+// the gRPC server can map the failure to the matching status code.
+var (
+	// ErrInvalidInput is returned when the inputs of a function are invalid.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrNotFound is returned when a requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrUpstreamUnavailable is returned when a downstream service (aali-llm, Qdrant, graphdb, ...) cannot be reached.
+	ErrUpstreamUnavailable = errors.New("upstream unavailable")
+	// ErrQuotaExceeded is returned when a token or request quota has been exhausted.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// streamErrors holds the errors that ended stream channels, keyed by the channel.
+// The error travels next to the channel rather than in it, so no message of a stream can be mistaken for an error.
+var streamErrors sync.Map
+
+// logError logs an error message and returns it wrapped into the given error class.
+//
+// Parameters:
+//   - ctx: the logging context, a new one is used if nil
+//   - class: the error class (ErrInvalidInput, ErrNotFound, ...)
+//   - msg: the format string of the message
+//   - args: the arguments of the format string
+//
+// Returns:
+//   - error: the error wrapping the error class
+func logError(ctx *logging.ContextMap, class error, msg string, args ...any) error {
+	errMsg := fmt.Sprintf(msg, args...)
+	if ctx == nil {
+		ctx = &logging.ContextMap{}
+	}
+	redact.Log.Error(ctx, errMsg)
+	return fmt.Errorf("%w: %s", class, errMsg)
+}
+
+// responseError logs an error response of aali-llm and returns it wrapped into the error class of its code.
+// Client errors keep their meaning (bad request, not found, too many requests), everything else means aali-llm
+// could not answer.
+//
+// Parameters:
+//   - response: the error response
+//
+// Returns:
+//   - error: the error wrapping the error class
+func responseError(response sharedtypes.HandlerResponse) error {
+	if response.Error == nil {
+		return logError(nil, ErrUpstreamUnavailable, "error in request %v", response.InstructionGuid)
+	}
+	class := ErrUpstreamUnavailable
+	switch response.Error.Code {
+	case http.StatusBadRequest:
+		class = ErrInvalidInput
+	case http.StatusNotFound:
+		class = ErrNotFound
+	case http.StatusTooManyRequests:
+		class = ErrQuotaExceeded
+	}
+	return logError(nil, class, "error in request %v: %v", response.InstructionGuid, response.Error.Message)
+}
+
+// classError returns an error that already wraps an error class unchanged, and logs and wraps any other
+// error into the given error class.
+//
+// Parameters:
+//   - err: the error
+//   - class: the error class of errors without one
+//
+// Returns:
+//   - error: the error wrapping an error class
+func classError(err error, class error) error {
+	for _, known := range []error{ErrInvalidInput, ErrNotFound, ErrUpstreamUnavailable, ErrQuotaExceeded} {
+		if errors.Is(err, known) {
+			return err
+		}
+	}
+	return logError(nil, class, "%v", err)
+}
+
+// CloseStream closes a stream channel, recording the error the stream ended with.
+// Streaming functions close their stream channel with it instead of close, so the gRPC server can report the error.
+//
+// Parameters:
+//   - stream: the stream channel
+//   - err: the error ending the stream, nil if the stream is complete
+func CloseStream(stream chan string, err error) {
+	if err != nil {
+		streamErrors.Store(stream, err)
+	}
+	close(stream)
+}
+
+// StreamError returns the error a stream channel ended with.
+// It must be called once the channel is drained; the error is forgotten afterwards.
+//
+// Parameters:
+//   - stream: the stream channel
+//
+// Returns:
+//   - error: the error wrapping its error class, nil if the stream is complete
+func StreamError(stream *chan string) error {
+	if stream == nil {
+		return nil
+	}
+	err, ok := streamErrors.LoadAndDelete(*stream)
+	if !ok {
+		return nil
+	}
+	return err.(error)
+}
@@ -20,157 +20,4167 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
 // SOFTWARE.
 
+// Code generated by internal/gen/registry/gen.go; DO NOT EDIT.
+
 package externalfunctions
 
+import (
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+)
+
+// FunctionSourceFiles maps the function categories to the source files the registry is generated from
+var FunctionSourceFiles = map[string]string{
+	"llm_handler":      "llmhandler.go",
+	"knowledge_db":     "knowledgedb.go",
+	"ansys_gpt":        "ansysgpt.go",
+	"data_extraction":  "dataextraction.go",
+	"generic":          "generic.go",
+	"cast":             "cast.go",
+	"ansys_mesh_pilot": "ansysmeshpilot.go",
+	"qdrant":           "qdrant.go",
+	"auth":             "auth.go",
+	"mcp":              "mcp.go",
+	"ansys_materials":  "ansysmaterials.go",
+	"rhsc":             "rhsc.go",
+}
+
+// ExternalFunctionsMap maps the function names to the functions called by the gRPC server
 var ExternalFunctionsMap = map[string]interface{}{
-	// llm handler
-	"PerformVectorEmbeddingRequest":                                                  PerformVectorEmbeddingRequest,
-	"PerformVectorEmbeddingRequestWithTokenLimitCatch":                               PerformVectorEmbeddingRequestWithTokenLimitCatch,
-	"PerformBatchEmbeddingRequest":                                                   PerformBatchEmbeddingRequest,
-	"PerformBatchHybridEmbeddingRequest":                                             PerformBatchHybridEmbeddingRequest,
-	"PerformKeywordExtractionRequest":                                                PerformKeywordExtractionRequest,
-	"PerformGeneralRequest":                                                          PerformGeneralRequest,
-	"PerformGeneralRequestWithImages":                                                PerformGeneralRequestWithImages,
-	"PerformGeneralModelSpecificationRequest":                                        PerformGeneralModelSpecificationRequest,
-	"PerformGeneralRequestSpecificModel":                                             PerformGeneralRequestSpecificModel,
-	"PerformGeneralRequestSpecificModelAndModelOptions":                              PerformGeneralRequestSpecificModelAndModelOptions,
+	// llm_handler
+	"AppendMessageHistory":                              AppendMessageHistory,
+	"BuildFinalQueryForCodeLLMRequest":                  BuildFinalQueryForCodeLLMRequest,
+	"BuildFinalQueryForGeneralLLMRequest":               BuildFinalQueryForGeneralLLMRequest,
+	"BuildLibraryContext":                               BuildLibraryContext,
+	"CheckTokenLimitReached":                            CheckTokenLimitReached,
+	"PerformBatchEmbeddingRequest":                      PerformBatchEmbeddingRequest,
+	"PerformBatchHybridEmbeddingRequest":                PerformBatchHybridEmbeddingRequest,
+	"PerformCodeLLMRequest":                             PerformCodeLLMRequest,
+	"PerformGeneralModelSpecificationRequest":           PerformGeneralModelSpecificationRequest,
+	"PerformGeneralRequest":                             PerformGeneralRequest,
+	"PerformGeneralRequestNoStreaming":                  PerformGeneralRequestNoStreaming,
+	"PerformGeneralRequestSpecificModel":                PerformGeneralRequestSpecificModel,
+	"PerformGeneralRequestSpecificModelAndModelOptions": PerformGeneralRequestSpecificModelAndModelOptions,
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput": PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput,
 	"PerformGeneralRequestSpecificModelModelOptionsAndImages":                        PerformGeneralRequestSpecificModelModelOptionsAndImages,
 	"PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput":                PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput,
-	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput": PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput,
-	"PerformCodeLLMRequest":                                                          PerformCodeLLMRequest,
-	"PerformGeneralRequestNoStreaming":                                               PerformGeneralRequestNoStreaming,
-	"BuildLibraryContext":                                                            BuildLibraryContext,
-	"BuildFinalQueryForGeneralLLMRequest":                                            BuildFinalQueryForGeneralLLMRequest,
-	"BuildFinalQueryForCodeLLMRequest":                                               BuildFinalQueryForCodeLLMRequest,
-	"AppendMessageHistory":                                                           AppendMessageHistory,
+	"PerformGeneralRequestWithImages":                                                PerformGeneralRequestWithImages,
+	"PerformKeywordExtractionRequest":                                                PerformKeywordExtractionRequest,
+	"PerformStructuredRequest":                                                       PerformStructuredRequest,
+	"PerformSummaryRequest":                                                          PerformSummaryRequest,
+	"PerformVectorEmbeddingRequest":                                                  PerformVectorEmbeddingRequest,
+	"PerformVectorEmbeddingRequestWithTokenLimitCatch":                               PerformVectorEmbeddingRequestWithTokenLimitCatch,
 	"ShortenMessageHistory":                                                          ShortenMessageHistory,
-	"CheckTokenLimitReached":                                                         CheckTokenLimitReached,
 
-	// knowledge db
-	"SendVectorsToKnowledgeDB": SendVectorsToKnowledgeDB,
-	"GetListCollections":       GetListCollections,
-	"RetrieveDependencies":     RetrieveDependencies,
-	"GeneralGraphDbQuery":      GeneralGraphDbQuery,
+	// knowledge_db
+	"AddDataRequest":           AddDataRequest,
 	"AddGraphDbParameter":      AddGraphDbParameter,
-	"GeneralQuery":             GeneralQuery,
-	"SimilaritySearch":         SimilaritySearch,
+	"CreateCollectionRequest":  CreateCollectionRequest,
+	"CreateDbFilter":           CreateDbFilter,
 	"CreateKeywordsDbFilter":   CreateKeywordsDbFilter,
-	"CreateTagsDbFilter":       CreateTagsDbFilter,
 	"CreateMetadataDbFilter":   CreateMetadataDbFilter,
-	"CreateDbFilter":           CreateDbFilter,
+	"CreateTagsDbFilter":       CreateTagsDbFilter,
+	"GeneralGraphDbQuery":      GeneralGraphDbQuery,
+	"GeneralQuery":             GeneralQuery,
+	"GetListCollections":       GetListCollections,
+	"RetrieveDependencies":     RetrieveDependencies,
+	"SendVectorsToKnowledgeDB": SendVectorsToKnowledgeDB,
+	"SimilaritySearch":         SimilaritySearch,
 
-	// ansys gpt
+	// ansys_gpt
+	"AecGetContextFromRetrieverModule":               AecGetContextFromRetrieverModule,
+	"AecPerformLLMFinalRequest":                      AecPerformLLMFinalRequest,
+	"AisAcsSemanticHybridSearchs":                    AisAcsSemanticHybridSearchs,
+	"AisChangeAcsResponsesByFactor":                  AisChangeAcsResponsesByFactor,
+	"AisPerformLLMRephraseRequest":                   AisPerformLLMRephraseRequest,
+	"AisReturnIndexList":                             AisReturnIndexList,
+	"AnsysGPTACSSemanticHybridSearchs":               AnsysGPTACSSemanticHybridSearchs,
+	"AnsysGPTBuildFinalQuery":                        AnsysGPTBuildFinalQuery,
 	"AnsysGPTCheckProhibitedWords":                   AnsysGPTCheckProhibitedWords,
 	"AnsysGPTExtractFieldsFromQuery":                 AnsysGPTExtractFieldsFromQuery,
+	"AnsysGPTGetSystemPrompt":                        AnsysGPTGetSystemPrompt,
 	"AnsysGPTPerformLLMRephraseRequest":              AnsysGPTPerformLLMRephraseRequest,
 	"AnsysGPTPerformLLMRephraseRequestNew":           AnsysGPTPerformLLMRephraseRequestNew,
-	"AnsysGPTBuildFinalQuery":                        AnsysGPTBuildFinalQuery,
 	"AnsysGPTPerformLLMRequest":                      AnsysGPTPerformLLMRequest,
-	"AnsysGPTReturnIndexList":                        AnsysGPTReturnIndexList,
-	"AnsysGPTACSSemanticHybridSearchs":               AnsysGPTACSSemanticHybridSearchs,
 	"AnsysGPTRemoveNoneCitationsFromSearchResponse":  AnsysGPTRemoveNoneCitationsFromSearchResponse,
 	"AnsysGPTReorderSearchResponseAndReturnOnlyTopK": AnsysGPTReorderSearchResponseAndReturnOnlyTopK,
-	"AnsysGPTGetSystemPrompt":                        AnsysGPTGetSystemPrompt,
-	"AisPerformLLMRephraseRequest":                   AisPerformLLMRephraseRequest,
-	"AisReturnIndexList":                             AisReturnIndexList,
-	"AisAcsSemanticHybridSearchs":                    AisAcsSemanticHybridSearchs,
-	"AisChangeAcsResponsesByFactor":                  AisChangeAcsResponsesByFactor,
-	"AecGetContextFromRetrieverModule":               AecGetContextFromRetrieverModule,
-	"AecPerformLLMFinalRequest":                      AecPerformLLMFinalRequest,
+	"AnsysGPTReturnIndexList":                        AnsysGPTReturnIndexList,
 
-	// data extraction
-	"GetGithubFilesToExtract":                    GetGithubFilesToExtract,
-	"GetLocalFilesToExtract":                     GetLocalFilesToExtract,
+	// data_extraction
 	"AppendStringSlices":                         AppendStringSlices,
+	"CreateGeneralDataExtractionDocumentObjects": CreateGeneralDataExtractionDocumentObjects,
 	"DownloadGithubFileContent":                  DownloadGithubFileContent,
-	"GetLocalFileContent":                        GetLocalFileContent,
+	"DownloadGithubFilesContent":                 DownloadGithubFilesContent,
+	"GenerateDocumentTree":                       GenerateDocumentTree,
 	"GetDocumentType":                            GetDocumentType,
+	"GetGithubFilesToExtract":                    GetGithubFilesToExtract,
+	"GetLocalFileContent":                        GetLocalFileContent,
+	"GetLocalFilesContent":                       GetLocalFilesContent,
+	"GetLocalFilesToExtract":                     GetLocalFilesToExtract,
 	"LangchainSplitter":                          LangchainSplitter,
-	"GenerateDocumentTree":                       GenerateDocumentTree,
-	"AddDataRequest":                             AddDataRequest,
-	"CreateCollectionRequest":                    CreateCollectionRequest,
-	"CreateGeneralDataExtractionDocumentObjects": CreateGeneralDataExtractionDocumentObjects,
+	"LoadAndCheckExampleDependencies":            LoadAndCheckExampleDependencies,
+	"LoadCodeGenerationElements":                 LoadCodeGenerationElements,
+	"LoadCodeGenerationExamples":                 LoadCodeGenerationExamples,
+	"LoadUserGuideSections":                      LoadUserGuideSections,
+	"StoreElementsInGraphDatabase":               StoreElementsInGraphDatabase,
+	"StoreElementsInVectorDatabase":              StoreElementsInVectorDatabase,
+	"StoreExamplesInGraphDatabase":               StoreExamplesInGraphDatabase,
+	"StoreExamplesInVectorDatabase":              StoreExamplesInVectorDatabase,
+	"StoreUserGuideSectionsInGraphDatabase":      StoreUserGuideSectionsInGraphDatabase,
+	"StoreUserGuideSectionsInVectorDatabase":     StoreUserGuideSectionsInVectorDatabase,
 
 	// generic
 	"AssignStringToString":   AssignStringToString,
-	"SendRestAPICall":        SendRestAPICall,
-	"PrintFeedback":          PrintFeedback,
 	"ExtractJSONStringField": ExtractJSONStringField,
 	"GenerateUUID":           GenerateUUID,
 	"JsonPath":               JsonPath,
+	"PrintFeedback":          PrintFeedback,
+	"SendRestAPICall":        SendRestAPICall,
 	"StringConcat":           StringConcat,
 	"StringFormat":           StringFormat,
 
-	// code generation
-	"LoadCodeGenerationElements":      LoadCodeGenerationElements,
-	"LoadCodeGenerationExamples":      LoadCodeGenerationExamples,
-	"LoadAndCheckExampleDependencies": LoadAndCheckExampleDependencies,
-	"LoadUserGuideSections":           LoadUserGuideSections,
+	// cast
+	"CastAnyToBool":              CastAnyToBool,
+	"CastAnyToByte":              CastAnyToByte,
+	"CastAnyToComplex128":        CastAnyToComplex128,
+	"CastAnyToComplex64":         CastAnyToComplex64,
+	"CastAnyToFloat32":           CastAnyToFloat32,
+	"CastAnyToFloat64":           CastAnyToFloat64,
+	"CastAnyToInt":               CastAnyToInt,
+	"CastAnyToInt16":             CastAnyToInt16,
+	"CastAnyToInt32":             CastAnyToInt32,
+	"CastAnyToInt64":             CastAnyToInt64,
+	"CastAnyToInt8":              CastAnyToInt8,
+	"CastAnyToInterface":         CastAnyToInterface,
+	"CastAnyToRune":              CastAnyToRune,
+	"CastAnyToString":            CastAnyToString,
+	"CastAnyToUint":              CastAnyToUint,
+	"CastAnyToUint16":            CastAnyToUint16,
+	"CastAnyToUint32":            CastAnyToUint32,
+	"CastAnyToUint64":            CastAnyToUint64,
+	"CastAnyToUint8":             CastAnyToUint8,
+	"CastArrayMapStringAnyToAny": CastArrayMapStringAnyToAny,
+	"CastBoolToAny":              CastBoolToAny,
+	"CastByteToAny":              CastByteToAny,
+	"CastComplex128ToAny":        CastComplex128ToAny,
+	"CastComplex64ToAny":         CastComplex64ToAny,
+	"CastFloat32ToAny":           CastFloat32ToAny,
+	"CastFloat64ToAny":           CastFloat64ToAny,
+	"CastInt16ToAny":             CastInt16ToAny,
+	"CastInt32ToAny":             CastInt32ToAny,
+	"CastInt64ToAny":             CastInt64ToAny,
+	"CastInt8ToAny":              CastInt8ToAny,
+	"CastIntToAny":               CastIntToAny,
+	"CastInterfaceToAny":         CastInterfaceToAny,
+	"CastRuneToAny":              CastRuneToAny,
+	"CastStringToAny":            CastStringToAny,
+	"CastUint16ToAny":            CastUint16ToAny,
+	"CastUint32ToAny":            CastUint32ToAny,
+	"CastUint64ToAny":            CastUint64ToAny,
+	"CastUint8ToAny":             CastUint8ToAny,
+	"CastUintToAny":              CastUintToAny,
 
-	"StoreElementsInVectorDatabase":          StoreElementsInVectorDatabase,
-	"StoreElementsInGraphDatabase":           StoreElementsInGraphDatabase,
-	"StoreExamplesInVectorDatabase":          StoreExamplesInVectorDatabase,
-	"StoreExamplesInGraphDatabase":           StoreExamplesInGraphDatabase,
-	"StoreUserGuideSectionsInVectorDatabase": StoreUserGuideSectionsInVectorDatabase,
-	"StoreUserGuideSectionsInGraphDatabase":  StoreUserGuideSectionsInGraphDatabase,
-
-	// ansys mesh pilot
-	"SimilartitySearchOnPathDescriptions":       SimilartitySearchOnPathDescriptions,
-	"FindRelevantPathDescriptionByPrompt":       FindRelevantPathDescriptionByPrompt,
-	"FetchPropertiesFromPathDescription":        FetchPropertiesFromPathDescription,
-	"FetchNodeDescriptionsFromPathDescription":  FetchNodeDescriptionsFromPathDescription,
+	// ansys_mesh_pilot
+	"AppendMeshPilotHistory":                    AppendMeshPilotHistory,
+	"AppendToolHistory":                         AppendToolHistory,
 	"FetchActionsPathFromPathDescription":       FetchActionsPathFromPathDescription,
-	"SynthesizeActions":                         SynthesizeActions,
+	"FetchNodeDescriptionsFromPathDescription":  FetchNodeDescriptionsFromPathDescription,
+	"FetchPropertiesFromPathDescription":        FetchPropertiesFromPathDescription,
+	"FinalizeMessage":                           FinalizeMessage,
 	"FinalizeResult":                            FinalizeResult,
-	"GetSolutionsToFixProblem":                  GetSolutionsToFixProblem,
-	"GetSelectedSolution":                       GetSelectedSolution,
-	"AppendToolHistory":                         AppendToolHistory,
-	"AppendMeshPilotHistory":                    AppendMeshPilotHistory,
+	"FindRelevantPathDescriptionByPrompt":       FindRelevantPathDescriptionByPrompt,
+	"GenerateSubWorkflowPrompt":                 GenerateSubWorkflowPrompt,
+	"GenerateUserPrompt":                        GenerateUserPrompt,
+	"GenerateUserPromptWithList":                GenerateUserPromptWithList,
 	"GetActionsFromConfig":                      GetActionsFromConfig,
+	"GetSelectedSolution":                       GetSelectedSolution,
+	"GetSolutionsToFixProblem":                  GetSolutionsToFixProblem,
+	"MarkdownToHTML":                            MarkdownToHTML,
 	"ParseHistory":                              ParseHistory,
-	"SynthesizeActionsTool4":                    SynthesizeActionsTool4,
+	"ParseHistoryToHistoricMessages":            ParseHistoryToHistoricMessages,
+	"ProcessSubworkflowIdentificationOutput":    ProcessSubworkflowIdentificationOutput,
+	"SimilartitySearchOnPathDescriptions":       SimilartitySearchOnPathDescriptions,
+	"SimilartitySearchOnPathDescriptionsQdrant": SimilartitySearchOnPathDescriptionsQdrant,
+	"SynthesizeActions":                         SynthesizeActions,
 	"SynthesizeActionsTool13":                   SynthesizeActionsTool13,
 	"SynthesizeActionsTool14":                   SynthesizeActionsTool14,
-	"SimilartitySearchOnPathDescriptionsQdrant": SimilartitySearchOnPathDescriptionsQdrant,
-	"GenerateSubWorkflowPrompt":                 GenerateSubWorkflowPrompt,
-	"ProcessSubworkflowIdentificationOutput":    ProcessSubworkflowIdentificationOutput,
-	"MarkdownToHTML":                            MarkdownToHTML,
-	"ParseHistoryToHistoricMessages":            ParseHistoryToHistoricMessages,
-	"FinalizeMessage":                           FinalizeMessage,
-	"GenerateUserPrompt":                        GenerateUserPrompt,
-	"GenerateUserPromptWithList":                GenerateUserPromptWithList,
+	"SynthesizeActionsTool4":                    SynthesizeActionsTool4,
 
 	// qdrant
 	"QdrantCreateCollection": QdrantCreateCollection,
+	"QdrantCreateIndex":      QdrantCreateIndex,
 	"QdrantInsertData":       QdrantInsertData,
 
 	// auth
 	"CheckApiKeyAuthMongoDb":                        CheckApiKeyAuthMongoDb,
 	"CheckCreateUserIdMongoDb":                      CheckCreateUserIdMongoDb,
-	"UpdateTotalTokenCountForCustomerMongoDb":       UpdateTotalTokenCountForCustomerMongoDb,
-	"UpdateTotalTokenCountForUserIdMongoDb":         UpdateTotalTokenCountForUserIdMongoDb,
+	"CreateMessageWithVariable":                     CreateMessageWithVariable,
 	"DenyCustomerAccessAndSendWarningMongoDb":       DenyCustomerAccessAndSendWarningMongoDb,
 	"DenyCustomerAccessAndSendWarningMongoDbUserId": DenyCustomerAccessAndSendWarningMongoDbUserId,
 	"SendLogicAppNotificationEmail":                 SendLogicAppNotificationEmail,
-	"CreateMessageWithVariable":                     CreateMessageWithVariable,
+	"UpdateTotalTokenCountForCustomerMongoDb":       UpdateTotalTokenCountForCustomerMongoDb,
+	"UpdateTotalTokenCountForUserIdMongoDb":         UpdateTotalTokenCountForUserIdMongoDb,
 
 	// mcp
-	"ListAll":         ListAll,
 	"ExecuteTool":     ExecuteTool,
 	"GetResource":     GetResource,
 	"GetSystemPrompt": GetSystemPrompt,
+	"ListAll":         ListAll,
 
-	// materials
-	"SerializeResponse":                SerializeResponse,
+	// ansys_materials
 	"AddGuidsToAttributes":             AddGuidsToAttributes,
-	"FilterOutNonExistingAttributes":   FilterOutNonExistingAttributes,
-	"FilterOutDuplicateAttributes":     FilterOutDuplicateAttributes,
 	"ExtractCriteriaSuggestions":       ExtractCriteriaSuggestions,
-	"LogRequestSuccess":                LogRequestSuccess,
+	"ExtractJson":                      ExtractJson,
+	"FilterOutDuplicateAttributes":     FilterOutDuplicateAttributes,
+	"FilterOutNonExistingAttributes":   FilterOutNonExistingAttributes,
 	"LogRequestFailed":                 LogRequestFailed,
 	"LogRequestFailedDebugWithMessage": LogRequestFailedDebugWithMessage,
+	"LogRequestSuccess":                LogRequestSuccess,
 	"PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput": PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput,
+	"SerializeResponse": SerializeResponse,
 
 	// rhsc
 	"SetCopilotGenerateRequestJsonBody": SetCopilotGenerateRequestJsonBody,
 }
+
+// FunctionDefinitions holds the definitions of the functions of ExternalFunctionsMap
+var FunctionDefinitions = []*aaliflowkitgrpc.FunctionDefinition{
+	{
+		Name:        "AppendMessageHistory",
+		DisplayName: "Append Message History",
+		Description: "AppendMessageHistory appends a new message to the conversation history\n\nTags:\n  - @displayName: Append Message History\n\nParameters:\n  - newMessage: the new message\n  - role: the role of the message\n  - history: the conversation history\n\nReturns:\n  - updatedHistory: the updated conversation history\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "newMessage", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "role", Type: "json", GoType: "string", Options: []string{"user", "assistant", "system"}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedHistory", Type: "json", GoType: "[]HistoricMessage"},
+		},
+	},
+	{
+		Name:        "BuildFinalQueryForCodeLLMRequest",
+		DisplayName: "Final Query (Code LLM Request)",
+		Description: "BuildFinalQueryForCodeLLMRequest builds the final query for a code generation\nrequest to LLM. The final query is a markdown string that contains the\noriginal request and the code examples from the KnowledgeDB.\n\nTags:\n  - @displayName: Final Query (Code LLM Request)\n\nParameters:\n  - request: the original request\n  - knowledgedbResponse: the KnowledgeDB response\n\nReturns:\n  - finalQuery: the final query\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "request", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "knowledgedbResponse", Type: "json", GoType: "[]DbResponse", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "BuildFinalQueryForGeneralLLMRequest",
+		DisplayName: "Final Query (General LLM Request)",
+		Description: "BuildFinalQueryForGeneralLLMRequest builds the final query for a general\nrequest to LLM. The final query is a markdown string that contains the\noriginal request and the examples from the KnowledgeDB.\n\nTags:\n  - @displayName: Final Query (General LLM Request)\n\nParameters:\n  - request: the original request\n  - knowledgedbResponse: the KnowledgeDB response\n\nReturns:\n  - finalQuery: the final query\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "request", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "knowledgedbResponse", Type: "json", GoType: "[]DbResponse", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "BuildLibraryContext",
+		DisplayName: "Library Context",
+		Description: "BuildLibraryContext builds the context string for the query\n\nTags:\n  - @displayName: Library Context\n\nParameters:\n  - message: the message string\n  - libraryContext: the library context string\n\nReturns:\n  - messageWithContext: the message with context\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "libraryContext", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "messageWithContext", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "CheckTokenLimitReached",
+		DisplayName: "Check Token Limit Reached",
+		Description: "CheckTokenLimitReached checks if the query exceeds the token limit for the specified model\n\nTags:\n  - @displayName: Check Token Limit Reached\n\nParameters:\n  - query: the query string\n  - tokenLimit: the token limit\n  - modelName: the name of the model to check against\n\nReturns:\n  - tokenLimitReached: true if the token limit is reached, false otherwise\n  - error: an error if the tokens cannot be counted with the model\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "tokenLimit", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "modelName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "tokenLimitMessage", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+			{Name: "responseMessage", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "PerformBatchEmbeddingRequest",
+		DisplayName: "Batch Embeddings",
+		Description: "PerformBatchEmbeddingRequest performs a batch vector embedding request to LLM\n\nTags:\n  - @displayName: Batch Embeddings\n\nParameters:\n  - ctx: the context of the request\n  - input: the input strings\n\nReturns:\n  - embeddedVectors: the embedded vectors in float32 format\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "embeddedVectors", Type: "json", GoType: "[][]float32"},
+		},
+	},
+	{
+		Name:        "PerformBatchHybridEmbeddingRequest",
+		DisplayName: "Batch Hybrid Embeddings",
+		Description: "PerformBatchHybridEmbeddingRequest performs a batch hybrid embedding request to LLM\nreturning the sparse and dense embeddings\n\nTags:\n  - @displayName: Batch Hybrid Embeddings\n\nParameters:\n  - ctx: the context of the request\n  - input: the input strings\n\nReturns:\n  - denseEmbeddings: the dense embeddings in float32 format\n  - sparseEmbeddings: the sparse embeddings in map format\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "maxBatchSize", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "denseEmbeddings", Type: "json", GoType: "[][]float32"},
+			{Name: "sparseEmbeddings", Type: "json", GoType: "[]map[uint]float32"},
+		},
+	},
+	{
+		Name:        "PerformCodeLLMRequest",
+		DisplayName: "Code LLM Request",
+		Description: "PerformCodeLLMRequest performs a code generation request to LLM\n\nTags:\n  - @displayName: Code LLM Request\n\nParameters:\n  - ctx: the context of the request\n  - input: the input string\n  - history: the conversation history\n  - isStream: the stream flag\n\nReturns:\n  - message: the generated code\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "validateCode", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "PerformGeneralModelSpecificationRequest",
+		DisplayName: "General LLM Request (Specified System Prompt)",
+		Description: "PerformGeneralModelSpecificationRequest performs a specified request to LLM with a configured model and Systemprompt.\n\nTags:\n  - @displayName: General LLM Request (Specified System Prompt)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs, tried in order as fallback chain; \"chain:<name>\" stands for a configured chain\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "systemPrompt", Type: "json", GoType: "map[string]string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequest",
+		DisplayName: "General LLM Request",
+		Description: "PerformGeneralRequest performs a general chat completion request to LLM\n\nTags:\n  - @displayName: General LLM Request\n\nParameters:\n  - ctx: the context of the request\n  - input: the input string\n  - history: the conversation history\n  - isStream: the stream flag\n  - systemPrompt: the system prompt\n\nReturns:\n  - message: the generated message\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequestNoStreaming",
+		DisplayName: "General LLM Request (no streaming)",
+		Description: "PerformGeneralRequestNoStreaming performs a general chat completion request to LLM without streaming\n\nTags:\n  - @displayName: General LLM Request (no streaming)\n\nParameters:\n  - ctx: the context of the request\n  - input: the input string\n  - history: the conversation history\n  - systemPrompt: the system prompt\n\nReturns:\n  - message: the generated message\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequestSpecificModel",
+		DisplayName: "General LLM Request (Specific Models)",
+		Description: "PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model\n\nTags:\n  - @displayName: General LLM Request (Specific Models)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs, tried in order as fallback chain; \"chain:<name>\" stands for a configured chain\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequestSpecificModelAndModelOptions",
+		DisplayName: "General LLM Request (Specific Models & Model Options)",
+		Description: "PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model\n\nTags:\n  - @displayName: General LLM Request (Specific Models & Model Options)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs, tried in order as fallback chain; \"chain:<name>\" stands for a configured chain\n  - modelOptions: the model options\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "modelOptions", Type: "json", GoType: "ModelOptions", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput",
+		DisplayName: "General LLM Request (Specific Models, Model Options, No Stream, OpenAI Token Output)",
+		Description: "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model\nand model options, and returns the token count using OpenAI token count model. Does not stream the response.\n\nTags:\n  - @displayName: General LLM Request (Specific Models, Model Options, No Stream, OpenAI Token Output)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs of the AI models to use, tried in order as fallback chain\n  - modelOptions: the model options\n  - tokenCountModelName: the model name to use for token count, unless the answering model sets its own\n\nReturns:\n  - message: the response message\n  - tokenCount: the token count\n  - error: an error if the request to aali-llm fails or the tokens cannot be counted\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "modelOptions", Type: "json", GoType: "ModelOptions", Options: []string{}},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "tokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequestSpecificModelModelOptionsAndImages",
+		DisplayName: "General LLM Request (Specific Models, Model Options & Images)",
+		Description: "PerformGeneralRequestSpecificModelModelOptionsAndImages performs a general request to LLM with a specific model including model options and images\n\nTags:\n  - @displayName: General LLM Request (Specific Models, Model Options & Images)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs, tried in order as fallback chain; \"chain:<name>\" stands for a configured chain\n  - modelOptions: the model options\n  - images: the images to include in the request\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "modelOptions", Type: "json", GoType: "ModelOptions", Options: []string{}},
+			{Name: "images", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput",
+		DisplayName: "General LLM Request (Specific Models, No Stream, OpenAI Token Output)",
+		Description: "PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model\nand returns the token count using OpenAI token count model. Does not stream the response.\n\nTags:\n  - @displayName: General LLM Request (Specific Models, No Stream, OpenAI Token Output)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs of the AI models to use, tried in order as fallback chain\n  - tokenCountModelName: the model name to use for token count, unless the answering model sets its own\n\nReturns:\n  - message: the response message\n  - tokenCount: the token count\n  - error: an error if the request to aali-llm fails or the tokens cannot be counted\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "tokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	{
+		Name:        "PerformGeneralRequestWithImages",
+		DisplayName: "General LLM Request (with Images)",
+		Description: "PerformGeneralRequestWithImages performs a general request to LLM with images\n\nTags:\n  - @displayName: General LLM Request (with Images)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - images: the images\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "images", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "PerformKeywordExtractionRequest",
+		DisplayName: "Keyword Extraction",
+		Description: "PerformKeywordExtractionRequest performs a keywords extraction request to LLM\n\nTags:\n  - @displayName: Keyword Extraction\n\nParameters:\n  - ctx: the context of the request\n  - input: the input string\n  - maxKeywordsSearch: the maximum number of keywords to search for\n\nReturns:\n  - keywords: the keywords extracted from the input string as a slice of strings\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "maxKeywordsSearch", Type: "number", GoType: "uint32", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "keywords", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "PerformStructuredRequest",
+		DisplayName: "Structured LLM Request",
+		Description: "PerformStructuredRequest performs a general request to LLM whose answer is JSON matching a JSON Schema\nThe schema is passed to the models supporting a response format (FLOWKIT_LLM_RESPONSE_FORMAT_MODELS) and\ndescribed in the system prompt for all others. An answer that is no JSON or does not match the schema is\nsent back to the model with the validation errors, at most maxRepairs times.\n\nTags:\n  - @displayName: Structured LLM Request\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input\n  - history: the conversation history\n  - systemPrompt: the system prompt, the instructions of the JSON answer are appended to it\n  - schema: the JSON Schema of the answer\n  - modelIds: the model IDs, tried in order as fallback chain; \"chain:<name>\" stands for a configured chain\n  - @optional\n  - maxRepairs: the number of repair requests for invalid answers\n  - @default: 2\n  - @min: 0\n  - @max: 5\n\nReturns:\n  - result: the parsed JSON answer; the last answer, which may be nil, if no valid answer was received\n  - valid: true if the result matches the schema\n  - attempts: the number of requests sent, including the repair requests\n  - validationErrors: the validation errors of the last answer, empty if it is valid\n  - error: an error if the schema is invalid or a request fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "schema", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "maxRepairs", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "json", GoType: "interface{}"},
+			{Name: "valid", Type: "boolean", GoType: "bool"},
+			{Name: "attempts", Type: "number", GoType: "int"},
+			{Name: "validationErrors", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "PerformSummaryRequest",
+		DisplayName: "Summary",
+		Description: "PerformSummaryRequest performs a summary request to LLM\n\nTags:\n  - @displayName: Summary\n\nParameters:\n  - ctx: the context of the request\n  - input: the input string\n\nReturns:\n  - summary: the summary extracted from the input string\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "summary", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "PerformVectorEmbeddingRequest",
+		DisplayName: "Embeddings",
+		Description: "PerformVectorEmbeddingRequest performs a vector embedding request to LLM\n\nTags:\n  - @displayName: Embeddings\n  - @cache: 1h\n\nParameters:\n  - ctx: the context of the request\n  - input: the input string\n\nReturns:\n  - embeddedVector: the embedded vector in float32 format\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "embeddedVector", Type: "json", GoType: "[]float32"},
+		},
+	},
+	{
+		Name:        "PerformVectorEmbeddingRequestWithTokenLimitCatch",
+		DisplayName: "Embeddings with Token Limit Catch",
+		Description: "PerformVectorEmbeddingRequestWithTokenLimitCatch performs a vector embedding request to LLM\nand catches the token limit error message\n\nTags:\n  - @displayName: Embeddings with Token Limit Catch\n\nParameters:\n  - ctx: the context of the request\n  - input: the input string\n\nReturns:\n  - embeddedVector: the embedded vector in float32 format\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "tokenLimitMessage", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "embeddedVector", Type: "json", GoType: "[]float32"},
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+			{Name: "responseMessage", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "ShortenMessageHistory",
+		DisplayName: "Shorten History",
+		Description: "ShortenMessageHistory shortens the conversation history to a maximum length.\nIt will retain only the most recent messages and older messages will be\nremoved.\n\nTags:\n  - @displayName: Shorten History\n\nParameters:\n  - history: the conversation history\n  - maxLength: the maximum length of the conversation history\n\nReturns:\n  - updatedHistory: the updated conversation history\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "maxLength", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedHistory", Type: "json", GoType: "[]HistoricMessage"},
+		},
+	},
+	{
+		Name:        "AddDataRequest",
+		DisplayName: "Add Data",
+		Description: "AddDataRequest sends a request to the add_data endpoint.\n\nTags:\n  - @displayName: Add Data\n\nParameters:\n  - ctx: the context of the request\n  - collectionName: name of the collection the request is sent to.\n  - data: the data to add.\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "documentData", Type: "json", GoType: "[]DbData", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "AddGraphDbParameter",
+		DisplayName: "Add Graph DB Parameter",
+		Description: "AddGraphDbParameter adds a new GraphDbParameter to a map[string]GraphDbParameter\n\nTags:\n  - @displayName: Add Graph DB Parameter\n\nParameters:\n  - parameters: the existing collection of parameters\n  - name: the name of the new parameter\n  - value: the value of the new parameter\n  - paramType: the type of the new parameter\n\nReturns:\n  - The original parameters with the new one added\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "parameters", Type: "json", GoType: "ParameterMap", Options: []string{}},
+			{Name: "name", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "value", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "paramType", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "ParameterMap", Type: "json", GoType: "ParameterMap"},
+		},
+	},
+	{
+		Name:        "CreateCollectionRequest",
+		DisplayName: "Create Collection",
+		Description: "CreateCollectionRequest sends a request to the collection endpoint.\n\nTags:\n  - @displayName: Create Collection\n\nParameters:\n  - ctx: the context of the request\n  - collectionName: the name of the collection to create.\n  - vectorSize: the length of the vector embeddings\n  - vectorDistance: the vector similarity distance algorithm to use for the vector index (cosine, dot, euclid, manhattan)\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "vectorSize", Type: "number", GoType: "uint64", Options: []string{}},
+			{Name: "vectorDistance", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "CreateDbFilter",
+		DisplayName: "Create Filter",
+		Description: "CreateDbFilter creates a filter for the KnowledgeDB.\n\nThe function returns the filter.\n\nTags:\n  - @displayName: Create Filter\n\nParameters:\n  - guid: the guid filter\n  - documentId: the document ID filter\n  - documentName: the document name filter\n  - level: the level filter\n  - tags: the tags filter\n  - keywords: the keywords filter\n  - metadata: the metadata filter\n\nReturns:\n  - databaseFilter: the filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "guid", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "documentId", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "documentName", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "level", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "tags", Type: "json", GoType: "DbArrayFilter", Options: []string{}},
+			{Name: "keywords", Type: "json", GoType: "DbArrayFilter", Options: []string{}},
+			{Name: "metadata", Type: "json", GoType: "[]DbJsonFilter", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbFilters"},
+		},
+	},
+	{
+		Name:        "CreateKeywordsDbFilter",
+		DisplayName: "Keywords Filter",
+		Description: "CreateKeywordsDbFilter creates a keywords filter for the KnowledgeDB.\n\nThe function returns the keywords filter.\n\nTags:\n  - @displayName: Keywords Filter\n\nParameters:\n  - keywords: the keywords to be used for the filter\n  - needAll: flag to indicate whether all keywords are needed\n\nReturns:\n  - databaseFilter: the keywords filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "keywords", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "needAll", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbArrayFilter"},
+		},
+	},
+	{
+		Name:        "CreateMetadataDbFilter",
+		DisplayName: "Metadata Filter",
+		Description: "CreateMetadataDbFilter creates a metadata filter for the KnowledgeDB.\n\nThe function returns the metadata filter.\n\nTags:\n  - @displayName: Metadata Filter\n\nParameters:\n  - fieldName: the name of the field\n  - fieldType: the type of the field\n  - filterData: the filter data\n  - needAll: flag to indicate whether all data is needed\n\nReturns:\n  - databaseFilter: the metadata filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "fieldName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "fieldType", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "filterData", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "needAll", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbJsonFilter"},
+		},
+	},
+	{
+		Name:        "CreateTagsDbFilter",
+		DisplayName: "Tags Filter",
+		Description: "CreateTagsDbFilter creates a tags filter for the KnowledgeDB.\n\nThe function returns the tags filter.\n\nTags:\n  - @displayName: Tags Filter\n\nParameters:\n  - tags: the tags to be used for the filter\n  - needAll: flag to indicate whether all tags are needed\n\nReturns:\n  - databaseFilter: the tags filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "tags", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "needAll", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbArrayFilter"},
+		},
+	},
+	{
+		Name:        "GeneralGraphDbQuery",
+		DisplayName: "General Graph DB Query",
+		Description: "GeneralGraphDbQuery executes the given Cypher query and returns the response.\n\nThe function returns the graph db response.\n\nTags:\n  - @displayName: General Graph DB Query\n\nParameters:\n  - query: the Cypher query to be executed.\n  - parameters: parameters to pass to the query during execution\n\nReturns:\n  - databaseResponse: the graph db response\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "parameters", Type: "json", GoType: "ParameterMap", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "[]map[string]any", Type: "json", GoType: "[]map[string]any"},
+		},
+	},
+	{
+		Name:        "GeneralQuery",
+		DisplayName: "Query",
+		Description: "GeneralQuery performs a general query in the KnowledgeDB.\n\nThe function returns the query results.\n\nTags:\n  - @displayName: Query\n\nParameters:\n  - ctx: the context of the request\n  - collectionName: the name of the collection to which the data objects will be added.\n  - maxRetrievalCount: the maximum number of results to be retrieved.\n  - outputFields: the fields to be included in the output.\n  - filters: the filter for the query.\n\nReturns:\n  - databaseResponse: the query results\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "maxRetrievalCount", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "outputFields", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "filters", Type: "json", GoType: "DbFilters", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+	},
+	{
+		Name:        "GetListCollections",
+		DisplayName: "List Collections",
+		Description: "GetListCollections retrieves the list of collections from the KnowledgeDB.\n\nTags:\n  - @displayName: List Collections\n\nThe function returns the list of collections.\n\nParameters:\n  - ctx: the context of the request\n  - knowledgeDbEndpoint: the KnowledgeDB endpoint\n\nReturns:\n  - collectionsList: the list of collections\n",
+		Category:    "knowledge_db",
+		Input:       []*aaliflowkitgrpc.FunctionInputDefinition{},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "collectionsList", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "RetrieveDependencies",
+		DisplayName: "Retrieve Dependencies",
+		Description: "RetrieveDependencies retrieves the dependencies of the specified source node.\n\nThe function returns the list of dependencies.\n\nTags:\n  - @displayName: Retrieve Dependencies\n\nParameters:\n  - relationshipName: the name of the relationship to retrieve dependencies for.\n  - relationshipDirection: the direction of the relationship to retrieve dependencies for.\n  - sourceDocumentId: the document ID of the source node.\n  - nodeTypesFilter: filter based on node types.\n  - maxHopsNumber: maximum number of hops to traverse.\n\nReturns:\n  - dependenciesIds: the list of dependencies\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "relationshipName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "relationshipDirection", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "sourceDocumentId", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "nodeTypesFilter", Type: "json", GoType: "DbArrayFilter", Options: []string{}},
+			{Name: "maxHopsNumber", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "dependenciesIds", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "SendVectorsToKnowledgeDB",
+		DisplayName: "Similarity Search",
+		Description: "SendVectorsToKnowledgeDB sends the given vector to the KnowledgeDB and\nreturns the most relevant data. The number of results is specified in the\nconfig file. The keywords are used to filter the results. The min score\nfilter is also specified in the config file. If it is not specified, the\ndefault value is used.\n\nThe function returns the most relevant data.\n\nTags:\n  - @displayName: Similarity Search\n\nParameters:\n  - ctx: the context of the request\n  - vector: the vector to be sent to the KnowledgeDB\n  - keywords: the keywords to be used to filter the results\n  - keywordsSearch: the flag to enable the keywords search\n  - collection: the collection name\n  - similaritySearchResults: the number of results to be returned\n  - similaritySearchMinScore: the minimum score for the results\n\nReturns:\n  - databaseResponse: an array of the most relevant data\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "vector", Type: "json", GoType: "[]float32", Options: []string{}},
+			{Name: "keywords", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "keywordsSearch", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "collection", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "similaritySearchResults", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "similaritySearchMinScore", Type: "number", GoType: "float64", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+	},
+	{
+		Name:        "SimilaritySearch",
+		DisplayName: "Similarity Search (Filtered)",
+		Description: "SimilaritySearch performs a similarity search in the KnowledgeDB.\n\nThe function returns the similarity search results.\n\nTags:\n  - @displayName: Similarity Search (Filtered)\n  - @cache: 5m\n\nParameters:\n  - ctx: the context of the request\n  - collectionName: the name of the collection to which the data objects will be added.\n  - embeddedVector: the embedded vector used for searching.\n  - maxRetrievalCount: the maximum number of results to be retrieved.\n  - outputFields: the fields to be included in the output.\n  - filters: the filter for the query.\n  - minScore: the minimum score filter.\n  - getLeafNodes: flag to indicate whether to retrieve all the leaf nodes in the result node branch.\n  - getSiblings: flag to indicate whether to retrieve the previous and next node to the result nodes.\n  - getParent: flag to indicate whether to retrieve the parent object.\n  - getChildren: flag to indicate whether to retrieve the children objects.\n\nReturns:\n  - databaseResponse: the similarity search results\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "embeddedVector", Type: "json", GoType: "[]float32", Options: []string{}},
+			{Name: "maxRetrievalCount", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "filters", Type: "json", GoType: "DbFilters", Options: []string{}},
+			{Name: "minScore", Type: "number", GoType: "float64", Options: []string{}},
+			{Name: "getLeafNodes", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "getSiblings", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "getParent", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "getChildren", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+	},
+	{
+		Name:        "AecGetContextFromRetrieverModule",
+		DisplayName: "AEC Get Context from Retriever Module",
+		Description: "AecGetContextFromRetrieverModule retrieves context from the Ansys GPT Retriever Module\n\nTags:\n  - @displayName: AEC Get Context from Retriever Module\n\nParameters:\n  - ctx: the context of the request\n  - retrieverModuleEndpoint: the endpoint of the retriever module\n  - userQuery: the user query\n  - dataSources: the data sources\n  - physics: the physics\n  - topK: the number of results to be returned\n  - plattform: the platform\n  - retrieverModuleKey: the key for the retriever module\n\nReturns:\n  - context: the context retrieved from the retriever module\n  - error: an error if the request to the retriever module fails\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "retrieverModuleEndpoint", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "userQuery", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "dataSources", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "physics", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "topK", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "plattform", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "retrieverModuleKey", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "context", Type: "json", GoType: "[]AnsysGPTRetrieverModuleChunk"},
+		},
+	},
+	{
+		Name:        "AecPerformLLMFinalRequest",
+		DisplayName: "AEC Final Request",
+		Description: "AecPerformLLMFinalRequest performs a final request to LLM\n\nTags:\n  - @displayName: AEC Final Request\n\nParameters:\n  - ctx: the context of the request\n  - systemTemplate: the system template for the final request\n  - userTemplate: the user template for the final request\n  - query: the user query\n  - history: the conversation history\n  - prohibitedWords: the list of prohibited words\n  - errorList1: the list of error words\n  - errorList2: the list of error words\n\nReturns:\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails or the tokens cannot be counted\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "systemTemplate", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "userTemplate", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "context", Type: "json", GoType: "[]AnsysGPTRetrieverModuleChunk", Options: []string{}},
+			{Name: "prohibitedWords", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "errorList1", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "errorList2", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "tokenCountEndpoint", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "previousInputTokenCount", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "previousOutputTokenCount", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "userEmail", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "jwtToken", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "AisAcsSemanticHybridSearchs",
+		DisplayName: "AIS ACS Semantic Hybrid Search",
+		Description: "AisAcsSemanticHybridSearchs performs a semantic hybrid search in ACS\n\nTags:\n  - @displayName: AIS ACS Semantic Hybrid Search\n\nParameters:\n  - ctx: the context of the request\n  - acsEndpoint: the ACS endpoint\n  - acsApiKey: the ACS API key\n  - @secret\n  - acsApiVersion: the ACS API version\n  - query: the query string\n  - embeddedQuery: the embedded query\n  - indexList: the index list\n  - physics: the physics\n  - topK: the number of results to be returned\n\nReturns:\n  - output: the search results\n  - error: an error if the search fails on every index\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "acsEndpoint", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "acsApiKey", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "acsApiVersion", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "embeddedQuery", Type: "json", GoType: "[]float32", Options: []string{}},
+			{Name: "indexList", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "physics", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "topK", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "[]ACSSearchResponse", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	{
+		Name:        "AisChangeAcsResponsesByFactor",
+		DisplayName: "Change ACS Responses By Factor",
+		Description: "AisChangeAcsResponsesByFactor changes the ACS responses by a factor\n\nTags:\n  - @displayName: Change ACS Responses By Factor\n\nParameters:\n  - factors: the factors\n  - semanticSearchOutput: the search response\n\nReturns:\n  - changedSemanticSearchOutput: the changed search response\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "factors", Type: "json", GoType: "map[string]float64", Options: []string{}},
+			{Name: "semanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "changedSemanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	{
+		Name:        "AisPerformLLMRephraseRequest",
+		DisplayName: "AIS Rephrase Request",
+		Description: "AisPerformLLMRephraseRequest performs a rephrase request to LLM\n\nTags:\n  - @displayName: AIS Rephrase Request\n\nParameters:\n  - ctx: the context of the request\n  - systemTemplate: the system template for the rephrase request\n  - userTemplate: the user template for the rephrase request\n  - query: the user query\n  - history: the conversation history\n\nReturns:\n  - rephrasedQuery: the rephrased query\n  - error: an error if the request to aali-llm fails or the tokens cannot be counted\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "systemTemplate", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "userTemplate", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rephrasedQuery", Type: "string", GoType: "string"},
+			{Name: "inputTokenCount", Type: "number", GoType: "int"},
+			{Name: "outputTokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	{
+		Name:        "AisReturnIndexList",
+		DisplayName: "Get AIS Index List",
+		Description: "AisReturnIndexList returns the index list for AIS\n\nTags:\n  - @displayName: Get AIS Index List\n\nParameters:\n  - accessPoint: the access point\n\nReturns:\n  - indexList: the index list\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "accessPoint", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "physics", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "version", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "indexList", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "AnsysGPTACSSemanticHybridSearchs",
+		DisplayName: "ACS Semantic Hybrid Search",
+		Description: "AnsysGPTACSSemanticHybridSearchs performs a semantic hybrid search in ACS\n\nTags:\n  - @displayName: ACS Semantic Hybrid Search\n\nParameters:\n  - ctx: the context of the request\n  - query: the query string\n  - embeddedQuery: the embedded query\n  - indexList: the index list\n  - typeOfAsset: the type of asset\n  - physics: the physics\n  - product: the product\n  - productMain: the main product\n  - filter: the filter\n  - filterAfterVectorSearch: the flag to define the filter order\n  - returnedProperties: the properties to be returned\n  - topK: the number of results to be returned from vector search\n  - searchedEmbeddedFields: the ACS fields to be searched\n\nReturns:\n  - output: the search results\n  - error: an error if the search fails\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "acsEndpoint", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "acsApiKey", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "acsApiVersion", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "embeddedQuery", Type: "json", GoType: "[]float32", Options: []string{}},
+			{Name: "indexList", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "filter", Type: "json", GoType: "map[string]string", Options: []string{}},
+			{Name: "topK", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "output", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	{
+		Name:        "AnsysGPTBuildFinalQuery",
+		DisplayName: "Build Final Query",
+		Description: "AnsysGPTBuildFinalQuery builds the final query for Ansys GPT\n\nTags:\n  - @displayName: Build Final Query\n\nParameters:\n  - refrasedQuery: the refrased query\n  - context: the context\n\nReturns:\n  - finalQuery: the final query\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "refrasedQuery", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "context", Type: "json", GoType: "[]ACSSearchResponse", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string"},
+			{Name: "errorResponse", Type: "string", GoType: "string"},
+			{Name: "displayFixedMessageToUser", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "AnsysGPTCheckProhibitedWords",
+		DisplayName: "Check Prohibited Words",
+		Description: "AnsysGPTCheckProhibitedWords checks the user query for prohibited words\n\nTags:\n  - @displayName: Check Prohibited Words\n\nParameters:\n  - query: the user query\n  - prohibitedWords: the list of prohibited words\n  - errorResponseMessage: the error response message\n\nReturns:\n  - foundProhibited: the flag indicating whether prohibited words were found\n  - responseMessage: the response message\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "prohibitedWords", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "errorResponseMessage", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "foundProhibited", Type: "boolean", GoType: "bool"},
+			{Name: "responseMessage", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "AnsysGPTExtractFieldsFromQuery",
+		DisplayName: "Extract Fields",
+		Description: "AnsysGPTExtractFieldsFromQuery extracts the fields from the user query\n\nTags:\n  - @displayName: Extract Fields\n\nParameters:\n  - query: the user query\n  - fieldValues: the field values that the user query can contain\n  - defaultFields: the default fields that the user query can contain\n\nReturns:\n  - fields: the extracted fields\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "fieldValues", Type: "json", GoType: "map[string][]string", Options: []string{}},
+			{Name: "defaultFields", Type: "json", GoType: "[]AnsysGPTDefaultFields", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "fields", Type: "json", GoType: "map[string]string"},
+		},
+	},
+	{
+		Name:        "AnsysGPTGetSystemPrompt",
+		DisplayName: "Get System Prompt",
+		Description: "AnsysGPTGetSystemPrompt returns the system prompt for Ansys GPT\n\nTags:\n  - @displayName: Get System Prompt\n\nParameters:\n  - rephrasedQuery: the rephrased query\n\nReturns:\n  - systemPrompt: the system prompt\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "prohibitedWords", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "template", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "AnsysGPTPerformLLMRephraseRequest",
+		DisplayName: "Rephrase Request",
+		Description: "AnsysGPTPerformLLMRephraseRequest performs a rephrase request to LLM\n\nTags:\n  - @displayName: Rephrase Request\n  - @deprecated: superseded by AnsysGPTPerformLLMRephraseRequestNew\n\nParameters:\n  - ctx: the context of the request\n  - template: the template for the rephrase request\n  - query: the user query\n  - history: the conversation history\n\nReturns:\n  - rephrasedQuery: the rephrased query\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userTemplate", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rephrasedQuery", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "AnsysGPTPerformLLMRephraseRequestNew",
+		DisplayName: "Rephrase Request New",
+		Description: "AnsysGPTPerformLLMRephraseRequestNew performs a rephrase request to LLM\n\nTags:\n  - @displayName: Rephrase Request New\n\nParameters:\n  - ctx: the context of the request\n  - template: the template for the rephrase request\n  - query: the user query\n  - history: the conversation history\n\nReturns:\n  - rephrasedQuery: the rephrased query\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "template", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rephrasedQuery", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "AnsysGPTPerformLLMRequest",
+		DisplayName: "LLM Request",
+		Description: "AnsysGPTPerformLLMRequest performs a request to Ansys GPT\n\nTags:\n  - @displayName: LLM Request\n\nParameters:\n  - ctx: the context of the request\n  - finalQuery: the final query\n  - history: the conversation history\n  - systemPrompt: the system prompt\n\nReturns:\n  - stream: the stream channel\n  - error: an error if the request to aali-llm fails\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "isStream", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	{
+		Name:        "AnsysGPTRemoveNoneCitationsFromSearchResponse",
+		DisplayName: "Remove None Citations",
+		Description: "AnsysGPTRemoveNoneCitationsFromSearchResponse removes none citations from search response\n\nTags:\n  - @displayName: Remove None Citations\n\nParameters:\n  - semanticSearchOutput: the search response\n  - citations: the citations\n\nReturns:\n  - reducedSemanticSearchOutput: the reduced search response\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "semanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse", Options: []string{}},
+			{Name: "citations", Type: "json", GoType: "[]AnsysGPTCitation", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "reducedSemanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	{
+		Name:        "AnsysGPTReorderSearchResponseAndReturnOnlyTopK",
+		DisplayName: "Reorder Search Response",
+		Description: "AnsysGPTReorderSearchResponseAndReturnOnlyTopK reorders the search response\n\nTags:\n  - @displayName: Reorder Search Response\n\nParameters:\n  - semanticSearchOutput: the search response\n  - topK: the number of results to be returned\n\nReturns:\n  - reorderedSemanticSearchOutput: the reordered search response\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "semanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse", Options: []string{}},
+			{Name: "topK", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "reorderedSemanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	{
+		Name:        "AnsysGPTReturnIndexList",
+		DisplayName: "List Indexes",
+		Description: "AnsysGPTReturnIndexList returns the index list for Ansys GPT\n\nTags:\n  - @displayName: List Indexes\n\nParameters:\n  - indexGroups: the index groups\n\nReturns:\n  - indexList: the index list\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "indexGroups", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "indexList", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "AppendStringSlices",
+		DisplayName: "Append String Slices",
+		Description: "AppendStringSlices creates a new slice by appending all elements of the provided slices.\n\nTags:\n  - @displayName: Append String Slices\n\nParameters:\n  - slice1, slice2, slice3, slice4, slice5: slices to append.\n\nReturns:\n  - result: a new slice with all elements appended.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "slice1", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "slice2", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "slice3", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "slice4", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "slice5", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "[]string", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "CreateGeneralDataExtractionDocumentObjects",
+		DisplayName: "Create General Data Extraction Document Objects",
+		Description: "CreateGeneralDataExtractionDocumentObjects creates general data extraction document objects from\nthe provided document chunks, dense embeddings, and sparse embeddings.\n\nTags:\n  - @displayName: Create General Data Extraction Document Objects\n\nParameters:\n  - documentName: name of the document.\n  - documentChunks: chunks of the document.\n  - denseEmbeddings: dense embeddings of the document.\n  - sparseEmbeddings: sparse embeddings of the document.\n\nReturns:\n  - extractionData: general data extraction document objects in interface format.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "documentName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "documentChunks", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "denseEmbeddings", Type: "json", GoType: "[][]float32", Options: []string{}},
+			{Name: "sparseEmbeddings", Type: "json", GoType: "[]map[uint]float32", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "extractionData", Type: "json", GoType: "[]interface{}"},
+		},
+	},
+	{
+		Name:        "DownloadGithubFileContent",
+		DisplayName: "Download Github File Content",
+		Description: "DownloadGithubFileContent downloads file content from github and returns checksum and content.\n\nTags:\n  - @displayName: Download Github File Content\n\nParameters:\n  - ctx: the context of the request\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - gihubFilePath: path to file in the github repository.\n  - githubAccessToken: access token for github.\n  - @secret\n\nReturns:\n  - checksum: checksum of file.\n  - content: content of file.\n  - error: an error if the files cannot be fetched from GitHub\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "githubRepoName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "gihubFilePath", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubAccessToken", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "checksum", Type: "string", GoType: "string"},
+			{Name: "content", Type: "json", GoType: "[]byte"},
+		},
+	},
+	{
+		Name:        "DownloadGithubFilesContent",
+		DisplayName: "Download Github Files Content",
+		Description: "DownloadGithubFilesContent downloads file content from github and returns checksum and content.\n\nTags:\n  - @displayName: Download Github Files Content\n\nParameters:\n  - ctx: the context of the request\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - gihubFilePath: path to file in the github repository.\n  - githubAccessToken: access token for github.\n\nReturns:\n  - filesMap: map of file paths to file content.\n  - error: an error if the files cannot be fetched from GitHub\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "githubRepoName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "gihubFilePaths", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "githubAccessToken", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filesMap", Type: "json", GoType: "map[string][]byte"},
+		},
+	},
+	{
+		Name:        "GenerateDocumentTree",
+		DisplayName: "Document Tree",
+		Description: "GenerateDocumentTree generates a tree structure from the document chunks.\n\nTags:\n  - @displayName: Document Tree\n\nParameters:\n  - ctx: the context of the request\n  - documentName: name of the document.\n  - documentId: id of the document.\n  - documentChunks: chunks of the document.\n  - embeddingsDimensions: dimensions of the embeddings.\n  - getSummary: whether to get summary.\n  - getKeywords: whether to get keywords.\n  - numKeywords: number of keywords.\n  - chunkSize: size of the chunks.\n  - numLlmWorkers: number of llm workers.\n\nReturns:\n  - documentData: tree structure of the document.\n  - error: an error if the summaries, keywords or embeddings cannot be created\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "documentName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "documentId", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "documentChunks", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "embeddingsDimensions", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "getSummary", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "getKeywords", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "numKeywords", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "chunkSize", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "numLlmWorkers", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "returnedDocumentData", Type: "json", GoType: "[]DbData"},
+		},
+	},
+	{
+		Name:        "GetDocumentType",
+		DisplayName: "Get Document Type",
+		Description: "GetDocumentType returns the document type of a file.\n\nTags:\n  - @displayName: Get Document Type\n\nParameters:\n  - filePath: path to file.\n\nReturns:\n  - documentType: file extension.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "filePath", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "documentType", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GetGithubFilesToExtract",
+		DisplayName: "List Github Files",
+		Description: "GetGithubFilesToExtract gets all files from github that need to be extracted.\n\nTags:\n  - @displayName: List Github Files\n\nParameters:\n  - ctx: the context of the request\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - githubAccessToken: access token for github.\n  - githubFileExtensions: github file extensions.\n  - githubFilteredDirectories: github filtered directories.\n  - githubExcludedDirectories: github excluded directories.\n\nReturns:\n  - githubFilesToExtract: github files to extract.\n  - error: an error if the files cannot be fetched from GitHub\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "githubRepoName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubAccessToken", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubFileExtensions", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "githubFilteredDirectories", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "githubExcludedDirectories", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "githubFilesToExtract", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "GetLocalFileContent",
+		DisplayName: "Get Local File Content",
+		Description: "GetLocalFileContent reads local file and returns checksum and content.\n\nTags:\n  - @displayName: Get Local File Content\n\nParameters:\n  - localFilePath: path to file.\n\nReturns:\n  - checksum: checksum of file.\n  - content: content of file.\n  - error: an error if the files cannot be read\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "localFilePath", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "checksum", Type: "string", GoType: "string"},
+			{Name: "content", Type: "json", GoType: "[]byte"},
+		},
+	},
+	{
+		Name:        "GetLocalFilesContent",
+		DisplayName: "Get Local Files Content",
+		Description: "GetLocalFilesContent reads local files and returns content.\n\nTags:\n  - @displayName: Get Local Files Content\n\nParameters:\n  - localFilePaths: paths to files.\n\nReturns:\n  - filesMap: map of file paths to file content.\n  - error: an error if the files cannot be read\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "localFilePaths", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filesMap", Type: "json", GoType: "map[string][]byte"},
+		},
+	},
+	{
+		Name:        "GetLocalFilesToExtract",
+		DisplayName: "List Local Files",
+		Description: "GetLocalFilesToExtract gets all files from local that need to be extracted.\n\nTags:\n  - @displayName: List Local Files\n\nParameters:\n  - localPath: path to the local directory.\n  - localFileExtensions: local file extensions.\n  - localFilteredDirectories: local filtered directories.\n  - localExcludedDirectories: local excluded directories.\n\nReturns:\n  - localFilesToExtract: local files to extract.\n  - error: an error if the files cannot be read\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "localPath", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "localFileExtensions", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "localFilteredDirectories", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "localExcludedDirectories", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "localFilesToExtract", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "LangchainSplitter",
+		DisplayName: "Split Content",
+		Description: "LangchainSplitter splits content into chunks using langchain.\n\nTags:\n  - @displayName: Split Content\n\nParameters:\n  - ctx: the context of the request\n  - content: content to split.\n  - documentType: type of document.\n  - chunkSize: size of the chunks.\n  - @min: 1\n  - chunkOverlap: overlap of the chunks.\n  - @min: 0\n\nReturns:\n  - output: chunks as an slice of strings.\n  - error: an error if the content cannot be split\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "bytesContent", Type: "json", GoType: "[]byte", Options: []string{}},
+			{Name: "documentType", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "chunkSize", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "chunkOverlap", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "output", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "LoadAndCheckExampleDependencies",
+		DisplayName: "Load and Check Example Dependencies",
+		Description: "LoadAndCheckExampleDependencies loads and checks the dependencies of the examples.\n\nTags:\n  - @displayName: Load and Check Example Dependencies\n\nParameters:\n  - dependenciesContent: content of the dependencies file in []byte format.\n  - elements: code generation elements.\n  - instancesReplacementDict: dictionary of instances replacements.\n  - InstancesReplacementPriorityList: list of instances replacement priority.\n\nReturns:\n  - checkedDependenciesMap: checked dependencies.\n  - equivalencesMap: equivalences.\n  - error: an error if the dependencies cannot be loaded\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dependenciesContent", Type: "json", GoType: "[]byte", Options: []string{}},
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement", Options: []string{}},
+			{Name: "instancesReplacementDict", Type: "json", GoType: "map[string]string", Options: []string{}},
+			{Name: "InstancesReplacementPriorityList", Type: "json", GoType: "[]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "checkedDependenciesMap", Type: "json", GoType: "map[string][]string"},
+			{Name: "equivalencesMap", Type: "json", GoType: "map[string]map[string]string"},
+		},
+	},
+	{
+		Name:        "LoadCodeGenerationElements",
+		DisplayName: "Load Code Generation Elements",
+		Description: "LoadCodeGenerationElements loads code generation elements from an xml or json file.\n\nTags:\n  - @displayName: Load Code Generation Elements\n\nParameters:\n  - content: content of the file in []byte format.\n  - elementsFilePath: path to the file.\n\nReturns:\n  - elements: code generation elements.\n  - error: an error if the files cannot be loaded or parsed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "content", Type: "json", GoType: "[]byte", Options: []string{}},
+			{Name: "elementsFilePath", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement"},
+		},
+	},
+	{
+		Name:        "LoadCodeGenerationExamples",
+		DisplayName: "Load Code Generation Examples",
+		Description: "LoadCodeGenerationExamples loads code generation examples from the provided paths.\n\nTags:\n  - @displayName: Load Code Generation Examples\n\nParameters:\n  - ctx: the context of the request\n  - source: source of the examples (local or github).\n  - examplesToExtract: paths to the examples.\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - githubAccessToken: access token for the github repository.\n  - dependencies: dependencies of the examples.\n  - equivalencesMap: equivalences of the examples.\n  - chunkSize: size of the chunks.\n  - chunkOverlap: overlap of the chunks.\n\nReturns:\n  - examples: code generation examples.\n  - error: an error if the files cannot be loaded or parsed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "source", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "examplesToExtract", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "githubRepoName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubAccessToken", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "dependencies", Type: "json", GoType: "map[string][]string", Options: []string{}},
+			{Name: "equivalencesMap", Type: "json", GoType: "map[string]map[string]string", Options: []string{}},
+			{Name: "chunkSize", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "chunkOverlap", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "examples", Type: "json", GoType: "[]CodeGenerationExample"},
+		},
+	},
+	{
+		Name:        "LoadUserGuideSections",
+		DisplayName: "Load User Guide Sections",
+		Description: "LoadUserGuideSections loads user guide sections from the provided paths.\n\nTags:\n  - @displayName: Load User Guide Sections\n\nParameters:\n  - ctx: the context of the request\n  - source: source of the sections (local or github).\n  - sectionFilePaths: paths to the sections.\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - githubAccessToken: access token for the github repository.\n\nReturns:\n  - sections: user guide sections.\n  - error: an error if the files cannot be loaded or parsed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "source", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "sectionFilePaths", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "githubRepoName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "githubAccessToken", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "sections", Type: "json", GoType: "[]CodeGenerationUserGuideSection"},
+		},
+	},
+	{
+		Name:        "StoreElementsInGraphDatabase",
+		DisplayName: "Store Elements in Graph Database",
+		Description: "StoreElementsInGraphDatabase stores elements in the graph database.\n\nTags:\n  - @displayName: Store Elements in Graph Database\n\nParameters:\n  - elements: code generation elements.\n\nReturns:\n  - error: an error if the graph database cannot be accessed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "StoreElementsInVectorDatabase",
+		DisplayName: "Store Elements in Vector Database",
+		Description: "StoreElementsInVectorDatabase stores elements in the vector database.\n\nTags:\n  - @displayName: Store Elements in Vector Database\n\nParameters:\n  - ctx: the context of the request\n  - elements: code generation elements.\n  - elementsCollectionName: name of the collection.\n  - batchSize: batch size for embeddings.\n  - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)\n\nReturns:\n  - error: an error if the embeddings cannot be created or the vector database cannot be accessed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement", Options: []string{}},
+			{Name: "elementsCollectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "batchSize", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "vectorDistance", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "StoreExamplesInGraphDatabase",
+		DisplayName: "Store Examples in Graph Database",
+		Description: "StoreExamplesInGraphDatabase stores examples in the graph database.\n\nTags:\n  - @displayName: Store Examples in Graph Database\n\nParameters:\n  - examples: code generation examples.\n\nReturns:\n  - error: an error if the graph database cannot be accessed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "examples", Type: "json", GoType: "[]CodeGenerationExample", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "StoreExamplesInVectorDatabase",
+		DisplayName: "Store Examples in Vector Database",
+		Description: "StoreExamplesInVectorDatabase stores examples in the vector database.\n\nTags:\n  - @displayName: Store Examples in Vector Database\n\nParameters:\n  - ctx: the context of the request\n  - examples: code generation examples.\n  - examplesCollectionName: name of the collection.\n  - batchSize: batch size for embeddings.\n  - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)\n\nReturns:\n  - error: an error if the embeddings cannot be created or the vector database cannot be accessed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "examples", Type: "json", GoType: "[]CodeGenerationExample", Options: []string{}},
+			{Name: "examplesCollectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "batchSize", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "vectorDistance", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "StoreUserGuideSectionsInGraphDatabase",
+		DisplayName: "Store User Guide Sections in Graph Database",
+		Description: "StoreUserGuideSectionsInGraphDatabase stores user guide sections in the graph database.\n\nTags:\n  - @displayName: Store User Guide Sections in Graph Database\n\nParameters:\n  - elements: user guide sections.\n  - label: label for the sections (UserGuide by default).\n\nReturns:\n  - error: an error if the graph database cannot be accessed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "sections", Type: "json", GoType: "[]CodeGenerationUserGuideSection", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "StoreUserGuideSectionsInVectorDatabase",
+		DisplayName: "Store User Guide Sections in Vector Database",
+		Description: "StoreUserGuideSectionsInVectorDatabase stores user guide sections in the vector database.\n\nTags:\n  - @displayName: Store User Guide Sections in Vector Database\n\nParameters:\n  - ctx: the context of the request\n  - sections: user guide sections.\n  - userGuideCollectionName: name of the collection.\n  - batchSize: batch size for embeddings.\n  - chunkSize: size of the chunks.\n  - chunkOverlap: overlap of the chunks.\n  - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)\n\nReturns:\n  - error: an error if the embeddings cannot be created or the vector database cannot be accessed\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "sections", Type: "json", GoType: "[]CodeGenerationUserGuideSection", Options: []string{}},
+			{Name: "userGuideCollectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "batchSize", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "chunkSize", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "chunkOverlap", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "vectorDistance", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "AssignStringToString",
+		DisplayName: "Assign String to String",
+		Description: "AssignStringToString assigns a string to another string\n\nTags:\n  - @displayName: Assign String to String\n\nParameters:\n  - inputString: the input string\n\nReturns:\n  - outputString: the output string\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "inputString", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "outputString", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "ExtractJSONStringField",
+		DisplayName: "Extract JSON String Field",
+		Description: "ExtractJSONStringField extracts a string field from a JSON string using a key path.\nThe key path is a dot-separated string that specifies the path to the field in the JSON object.\n\nTags:\n  - @displayName: Extract JSON String Field\n\nParameters:\n  - jsonStr: the JSON string to extract the field from\n  - keyPath: the dot-separated path to the field in the JSON object\n\nReturns:\n  - the value of the field as a string\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "jsonStr", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "keyPath", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GenerateUUID",
+		DisplayName: "Generate UUID",
+		Description: "GenerateUUID generates a new UUID (Universally Unique Identifier).\n\nTags:\n  - @displayName: Generate UUID\n\nReturns:\n  - a string representation of the generated UUID\n",
+		Category:    "generic",
+		Input:       []*aaliflowkitgrpc.FunctionInputDefinition{},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "JsonPath",
+		DisplayName: "JSON Path",
+		Description: "JsonPath extracts some data from an arbitrary data structure using a JSONPath pattern\n\nTags:\n  - @displayName: JSON Path\n\nParameters:\n  - pat (string): The JSON Path pattern\n  - data (any): The data to extract from\n  - oneResult (bool): Whether you are expecting to extract 1 result or an array of results\n    If you set oneResult=true but there are not exactle 1 result in the output, you will\n    receive an error. This should only be set if the result is guaranteed to have length 1.\n\nReturns\n  - The extracted data. If oneResult=false, this will be an array of any.\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "pat", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+			{Name: "oneResult", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "PrintFeedback",
+		DisplayName: "Print Feedback",
+		Description: "PrintFeedback prints the feedback to the console in JSON format\n\nTags:\n  - @displayName: Print Feedback\n\nParameters:\n  - feedback: the feedback to print\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "feedback", Type: "json", GoType: "Feedback", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "SendRestAPICall",
+		DisplayName: "REST Call",
+		Description: "SendAPICall sends an API call to the specified URL with the specified headers and query parameters.\n\nTags:\n  - @displayName: REST Call\n\nParameters:\n  - ctx: the context of the request\n  - requestType: the type of the request (GET, POST, PUT, PATCH, DELETE)\n  - @enum: GET, POST, PUT, PATCH, DELETE\n  - urlString: the URL to send the request to\n  - headers: the headers to include in the request\n  - query: the query parameters to include in the request\n  - jsonBody: the body of the request as a JSON string\n\nReturns:\n  - success: a boolean indicating whether the request was successful\n  - returnJsonBody: the JSON body of the response as a string\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "requestType", Type: "string", GoType: "string", Options: []string{"GET", "POST", "PUT", "PATCH", "DELETE"}},
+			{Name: "endpoint", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "header", Type: "json", GoType: "map[string]string", Options: []string{}},
+			{Name: "query", Type: "json", GoType: "map[string]string", Options: []string{}},
+			{Name: "jsonBody", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "success", Type: "boolean", GoType: "bool"},
+			{Name: "returnJsonBody", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "StringConcat",
+		DisplayName: "Concatenate Strings",
+		Description: "StringConcat concatenates 2 strings together, with an optional separator.\n\nTags:\n  - @displayName: Concatenate Strings\n\nParameters\n  - a (string) the first string\n  - b (string) the second string\n  - separator (string) the separator string. If not provided, will be an empty string.\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "a", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "b", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "separator", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "StringFormat",
+		DisplayName: "Format data as string",
+		Description: "StringFormat formats any data as a string.\n\nUse this to turn non-string data into a string representation. This uses go's `fmt.Sprintf` under the hood.\n\nTags:\n  - @displayName: Format data as string\n\nParameters\n  - data (any): the data to format as a string\n  - format (string): the format specifier to use. If not provided will default to \"%v\".\n    See the [go fmt docs](https://pkg.go.dev/fmt) for details.\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+			{Name: "format", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "CastAnyToBool",
+		DisplayName: "Cast Any to Bool",
+		Description: "CastAnyToBool casts data of type any to bool\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Bool\n\nParameters:\n  - data (any)\n\nReturns\n  - bool\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "bool", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "CastAnyToByte",
+		DisplayName: "Cast Any to Byte",
+		Description: "CastAnyToByte casts data of type any to byte\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Byte\n\nParameters:\n  - data (any)\n\nReturns\n  - byte\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "byte", Type: "json", GoType: "byte"},
+		},
+	},
+	{
+		Name:        "CastAnyToComplex128",
+		DisplayName: "Cast Any to Complex128",
+		Description: "CastAnyToComplex128 casts data of type any to complex128\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Complex128\n\nParameters:\n  - data (any)\n\nReturns\n  - complex128\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "complex128", Type: "json", GoType: "complex128"},
+		},
+	},
+	{
+		Name:        "CastAnyToComplex64",
+		DisplayName: "Cast Any to Complex64",
+		Description: "CastAnyToComplex64 casts data of type any to complex64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Complex64\n\nParameters:\n  - data (any)\n\nReturns\n  - complex64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "complex64", Type: "json", GoType: "complex64"},
+		},
+	},
+	{
+		Name:        "CastAnyToFloat32",
+		DisplayName: "Cast Any to Float32",
+		Description: "CastAnyToFloat32 casts data of type any to float32\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Float32\n\nParameters:\n  - data (any)\n\nReturns\n  - float32\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "float32", Type: "number", GoType: "float32"},
+		},
+	},
+	{
+		Name:        "CastAnyToFloat64",
+		DisplayName: "Cast Any to Float64",
+		Description: "CastAnyToFloat64 casts data of type any to float64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Float64\n\nParameters:\n  - data (any)\n\nReturns\n  - float64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "float64", Type: "number", GoType: "float64"},
+		},
+	},
+	{
+		Name:        "CastAnyToInt",
+		DisplayName: "Cast Any to Int",
+		Description: "CastAnyToInt casts data of type any to int\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int\n\nParameters:\n  - data (any)\n\nReturns\n  - int\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int", Type: "number", GoType: "int"},
+		},
+	},
+	{
+		Name:        "CastAnyToInt16",
+		DisplayName: "Cast Any to Int16",
+		Description: "CastAnyToInt16 casts data of type any to int16\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int16\n\nParameters:\n  - data (any)\n\nReturns\n  - int16\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int16", Type: "number", GoType: "int16"},
+		},
+	},
+	{
+		Name:        "CastAnyToInt32",
+		DisplayName: "Cast Any to Int32",
+		Description: "CastAnyToInt32 casts data of type any to int32\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int32\n\nParameters:\n  - data (any)\n\nReturns\n  - int32\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int32", Type: "number", GoType: "int32"},
+		},
+	},
+	{
+		Name:        "CastAnyToInt64",
+		DisplayName: "Cast Any to Int64",
+		Description: "CastAnyToInt64 casts data of type any to int64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int64\n\nParameters:\n  - data (any)\n\nReturns\n  - int64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int64", Type: "number", GoType: "int64"},
+		},
+	},
+	{
+		Name:        "CastAnyToInt8",
+		DisplayName: "Cast Any to Int8",
+		Description: "CastAnyToInt8 casts data of type any to int8\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int8\n\nParameters:\n  - data (any)\n\nReturns\n  - int8\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int8", Type: "number", GoType: "int8"},
+		},
+	},
+	{
+		Name:        "CastAnyToInterface",
+		DisplayName: "Cast any to interface{}",
+		Description: "CastAnyToInterface casts data of type any to interface {}\n\nTags:\n  - @displayName: Cast any to interface{}\n\nParameters:\n  - data (any)\n\nReturns\n  - interface {}\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "interface{}", Type: "json", GoType: "interface{}"},
+		},
+	},
+	{
+		Name:        "CastAnyToRune",
+		DisplayName: "Cast Any to Rune",
+		Description: "CastAnyToRune casts data of type any to rune\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Rune\n\nParameters:\n  - data (any)\n\nReturns\n  - rune\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rune", Type: "json", GoType: "rune"},
+		},
+	},
+	{
+		Name:        "CastAnyToString",
+		DisplayName: "Cast Any to String",
+		Description: "CastAnyToString casts data of type any to string\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to String\n\nParameters:\n  - data (any)\n\nReturns\n  - string\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "CastAnyToUint",
+		DisplayName: "Cast Any to Uint",
+		Description: "CastAnyToUint casts data of type any to uint\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint\n\nParameters:\n  - data (any)\n\nReturns\n  - uint\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint", Type: "number", GoType: "uint"},
+		},
+	},
+	{
+		Name:        "CastAnyToUint16",
+		DisplayName: "Cast Any to Uint16",
+		Description: "CastAnyToUint16 casts data of type any to uint16\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint16\n\nParameters:\n  - data (any)\n\nReturns\n  - uint16\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint16", Type: "number", GoType: "uint16"},
+		},
+	},
+	{
+		Name:        "CastAnyToUint32",
+		DisplayName: "Cast Any to Uint32",
+		Description: "CastAnyToUint32 casts data of type any to uint32\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint32\n\nParameters:\n  - data (any)\n\nReturns\n  - uint32\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint32", Type: "number", GoType: "uint32"},
+		},
+	},
+	{
+		Name:        "CastAnyToUint64",
+		DisplayName: "Cast Any to Uint64",
+		Description: "CastAnyToUint64 casts data of type any to uint64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint64\n\nParameters:\n  - data (any)\n\nReturns\n  - uint64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint64", Type: "number", GoType: "uint64"},
+		},
+	},
+	{
+		Name:        "CastAnyToUint8",
+		DisplayName: "Cast Any to Uint8",
+		Description: "CastAnyToUint8 casts data of type any to uint8\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint8\n\nParameters:\n  - data (any)\n\nReturns\n  - uint8\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint8", Type: "number", GoType: "uint8"},
+		},
+	},
+	{
+		Name:        "CastArrayMapStringAnyToAny",
+		DisplayName: "Cast []map[string]any to any",
+		Description: "CastArrayMapStringAnyToAny casts data of type []map[string]any to any\n\nTags:\n  - @displayName: Cast []map[string]any to any\n\nParameters:\n  - data ([]map[string]any)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "[]map[string]any", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastBoolToAny",
+		DisplayName: "Cast Bool to Any",
+		Description: "CastBoolToAny casts data of type bool to any\n\nTags:\n  - @displayName: Cast Bool to Any\n\nParameters:\n  - data (bool)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastByteToAny",
+		DisplayName: "Cast Byte to Any",
+		Description: "CastByteToAny casts data of type byte to any\n\nTags:\n  - @displayName: Cast Byte to Any\n\nParameters:\n  - data (byte)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "byte", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastComplex128ToAny",
+		DisplayName: "Cast Complex128 to Any",
+		Description: "CastComplex128ToAny casts data of type complex128 to any\n\nTags:\n  - @displayName: Cast Complex128 to Any\n\nParameters:\n  - data (complex128)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "complex128", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastComplex64ToAny",
+		DisplayName: "Cast Complex64 to Any",
+		Description: "CastComplex64ToAny casts data of type complex64 to any\n\nTags:\n  - @displayName: Cast Complex64 to Any\n\nParameters:\n  - data (complex64)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "complex64", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastFloat32ToAny",
+		DisplayName: "Cast Float32 to Any",
+		Description: "CastFloat32ToAny casts data of type float32 to any\n\nTags:\n  - @displayName: Cast Float32 to Any\n\nParameters:\n  - data (float32)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "float32", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastFloat64ToAny",
+		DisplayName: "Cast Float64 to Any",
+		Description: "CastFloat64ToAny casts data of type float64 to any\n\nTags:\n  - @displayName: Cast Float64 to Any\n\nParameters:\n  - data (float64)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "float64", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastInt16ToAny",
+		DisplayName: "Cast Int16 to Any",
+		Description: "CastInt16ToAny casts data of type int16 to any\n\nTags:\n  - @displayName: Cast Int16 to Any\n\nParameters:\n  - data (int16)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int16", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastInt32ToAny",
+		DisplayName: "Cast Int32 to Any",
+		Description: "CastInt32ToAny casts data of type int32 to any\n\nTags:\n  - @displayName: Cast Int32 to Any\n\nParameters:\n  - data (int32)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int32", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastInt64ToAny",
+		DisplayName: "Cast Int64 to Any",
+		Description: "CastInt64ToAny casts data of type int64 to any\n\nTags:\n  - @displayName: Cast Int64 to Any\n\nParameters:\n  - data (int64)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int64", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastInt8ToAny",
+		DisplayName: "Cast Int8 to Any",
+		Description: "CastInt8ToAny casts data of type int8 to any\n\nTags:\n  - @displayName: Cast Int8 to Any\n\nParameters:\n  - data (int8)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int8", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastIntToAny",
+		DisplayName: "Cast Int to Any",
+		Description: "CastIntToAny casts data of type int to any\n\nTags:\n  - @displayName: Cast Int to Any\n\nParameters:\n  - data (int)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastInterfaceToAny",
+		DisplayName: "Cast interface{} to any",
+		Description: "CastInterfaceToAny casts data of type interface {} to any\n\nTags:\n  - @displayName: Cast interface{} to any\n\nParameters:\n  - data (interface {})\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "interface{}", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastRuneToAny",
+		DisplayName: "Cast Rune to Any",
+		Description: "CastRuneToAny casts data of type rune to any\n\nTags:\n  - @displayName: Cast Rune to Any\n\nParameters:\n  - data (rune)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "rune", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastStringToAny",
+		DisplayName: "Cast String to Any",
+		Description: "CastStringToAny casts data of type string to any\n\nTags:\n  - @displayName: Cast String to Any\n\nParameters:\n  - data (string)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastUint16ToAny",
+		DisplayName: "Cast Uint16 to Any",
+		Description: "CastUint16ToAny casts data of type uint16 to any\n\nTags:\n  - @displayName: Cast Uint16 to Any\n\nParameters:\n  - data (uint16)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint16", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastUint32ToAny",
+		DisplayName: "Cast Uint32 to Any",
+		Description: "CastUint32ToAny casts data of type uint32 to any\n\nTags:\n  - @displayName: Cast Uint32 to Any\n\nParameters:\n  - data (uint32)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint32", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastUint64ToAny",
+		DisplayName: "Cast Uint64 to Any",
+		Description: "CastUint64ToAny casts data of type uint64 to any\n\nTags:\n  - @displayName: Cast Uint64 to Any\n\nParameters:\n  - data (uint64)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint64", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastUint8ToAny",
+		DisplayName: "Cast Uint8 to Any",
+		Description: "CastUint8ToAny casts data of type uint8 to any\n\nTags:\n  - @displayName: Cast Uint8 to Any\n\nParameters:\n  - data (uint8)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint8", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "CastUintToAny",
+		DisplayName: "Cast Uint to Any",
+		Description: "CastUintToAny casts data of type uint to any\n\nTags:\n  - @displayName: Cast Uint to Any\n\nParameters:\n  - data (uint)\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	{
+		Name:        "AppendMeshPilotHistory",
+		DisplayName: "AppendMeshPilotHistory",
+		Description: "AppendMeshPilotHistory this function append mesh pilot history\n\nTags:\n  - @displayName: AppendMeshPilotHistory\n\nParameters:\n  - history: the tool history\n  - role: the tool id\n  - content: the tool name\n\nReturns:\n  - updatedHistory: the updated mesh pilot history\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "history", Type: "json", GoType: "[]map[string]string", Options: []string{}},
+			{Name: "role", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "content", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedHistory", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	{
+		Name:        "AppendToolHistory",
+		DisplayName: "AppendToolHistory",
+		Description: "AppendToolHistory this function append tool history\n\nTags:\n  - @displayName: AppendToolHistory\n\nParameters:\n  - toolHistory: the tool history\n  - toolId: the tool id\n  - toolName: the tool name\n  - toolArguments: the tool arguments\n  - toolResponse: the tool response\n\nReturns:\n  - updatedToolHistory: the updated tool history\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "toolHistory", Type: "json", GoType: "[]map[string]string", Options: []string{}},
+			{Name: "toolId", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "toolName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "toolArguments", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "toolResponse", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedToolHistory", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	{
+		Name:        "FetchActionsPathFromPathDescription",
+		DisplayName: "FetchActionsPathFromPathDescription",
+		Description: "FetchActionsPathFromPathDescription fetch actions from path description\n\nTags:\n  - @displayName: FetchActionsPathFromPathDescription\n\nParameters:\n  - description: the desctiption of path\n  - nodeLabel: the label of the node\n\nReturns:\n  - actions: the list of actions to execute\n  - error: an error if the graph database cannot be queried\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "description", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "nodeLabel", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "actions", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	{
+		Name:        "FetchNodeDescriptionsFromPathDescription",
+		DisplayName: "FetchNodeDescriptionsFromPathDescription",
+		Description: "FetchNodeDescriptionsFromPathDescription get node descriptions from path description\n\nTags:\n  - @displayName: FetchNodeDescriptionsFromPathDescription\n\nParameters:\n  - description: the desctiption of path\n\nReturns:\n  - actionDescriptions: action descriptions\n  - error: an error if the graph database cannot be queried\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "description", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "actionDescriptions", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "FetchPropertiesFromPathDescription",
+		DisplayName: "FetchPropertiesFromPathDescription",
+		Description: "FetchPropertiesFromPathDescription get properties from path description\n\nTags:\n  - @displayName: FetchPropertiesFromPathDescription\n\nParameters:\n  - description: the desctiption of path\n\nReturns:\n  - properties: the list of descriptions\n  - error: an error if the graph database cannot be queried\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "description", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "properties", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "FinalizeMessage",
+		DisplayName: "FinalizeMessage",
+		Description: "FinalizeMessage this function takes message and generate response schema\n\nTags:\n  - @displayName: FinalizeMessage\n\nParameters:\n  - message: final message\n\nReturns:\n  - result: response schema sent to chat interface\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "FinalizeResult",
+		DisplayName: "FinalizeResult",
+		Description: "FinalizeResult converts actions to json string to send back data\n\nTags:\n  - @displayName: FinalizeResult\n\nParameters:\n  - actions: the executable actions\n  - toolName: tool name to create customize messages\n\nReturns:\n  - result: the actions in json format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "actions", Type: "json", GoType: "[]map[string]string", Options: []string{}},
+			{Name: "toolName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "FindRelevantPathDescriptionByPrompt",
+		DisplayName: "FindRelevantPathDescriptionByPrompt",
+		Description: "FindRelevantPathDescriptionByPrompt finds the relevant description by prompting\n\nTags:\n  - @displayName: FindRelevantPathDescriptionByPrompt\n\nParameters:\n  - descriptions: the list of descriptions\n  - message: the message from llm\n\nReturns:\n  - relevantDescription: the relevant desctiption\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "descriptions", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "message", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "relevantDescription", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GenerateSubWorkflowPrompt",
+		DisplayName: "GenerateSubWorkflowPrompt",
+		Description: "GenerateSubWorkflowPrompt generates system and user prompts for subworkflow identification.\n\nTags:\n  - @displayName: GenerateSubWorkflowPrompt\n\nParameters:\n  - userInstruction: user instruction\n\nReturns:\n  - systemPrompt: the system prompt\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GenerateUserPrompt",
+		DisplayName: "GenerateUserPrompt",
+		Description: "GenerateUserPrompt generates user instruction prompt based on the provided template.\n\nTags:\n  - @displayName: GenerateUserPrompt\n\nParameters:\n  - userInstruction: user instruction\n  - userPromptTemplate: user prompt template\n\nReturns:\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "userPromptTemplate", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GenerateUserPromptWithList",
+		DisplayName: "GenerateUserPromptWithList",
+		Description: "GenerateUserPrompt generates user instruction prompt based on the provided template, instruction, list.\n\nTags:\n  - @displayName: GenerateUserPromptWithList\n\nParameters:\n  - userInstruction: user instruction\n  - userList: list of items to include in the prompt\n  - userPromptTemplate: user prompt template\n\nReturns:\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "userList", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "userPromptTemplate", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GetActionsFromConfig",
+		DisplayName: "GetActionsFromConfig",
+		Description: "FinalizeResult converts actions to json string to send back data\n\nTags:\n  - @displayName: GetActionsFromConfig\n\nParameters:\n  - toolName: tool name to create customize messages\n\nReturns:\n  - result: the actions in json format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "toolName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GetSelectedSolution",
+		DisplayName: "GetSelectedSolution",
+		Description: "GetSelectedSolution get user selected solutions from the options provided\n\nTags:\n  - @displayName: GetSelectedSolution\n\nParameters:\n  - arguments: these are the arguments ReAct found based on user choice\n\nReturns:\n  - solution: the selected solution\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "arguments", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "solution", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "GetSolutionsToFixProblem",
+		DisplayName: "GetSolutionsToFixProblem",
+		Description: "GetSolutionsToFixProblem do similarity search on path description\n\nTags:\n  - @displayName: GetSolutionsToFixProblem\n\nParameters:\n  - fmFailureCode: FM failure Code\n  - primeMeshFailureCode: Prime Mesh Failure Code\n\nReturns:\n  - solutions: the list of solutions in json\n  - error: an error if the graph database cannot be queried\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "fmFailureCode", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "primeMeshFailureCode", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "solutions", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "MarkdownToHTML",
+		DisplayName: "MarkdownToHTML",
+		Description: "MarkdownToHTML this function converts markdown to html\n\nTags:\n  - @displayName: MarkdownToHTML\n\nParameters:\n  - markdown: content in markdown format\n\nReturns:\n  - html: content in html format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "markdown", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "html", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "ParseHistory",
+		DisplayName: "ParseHistory",
+		Description: "ParseHistory this function parses history from json to map\n\nTags:\n  - @displayName: ParseHistory\n\nParameters:\n  - historyJson: history in json format\n\nReturns:\n  - history: the parsed history\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "historyJson", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "history", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	{
+		Name:        "ParseHistoryToHistoricMessages",
+		DisplayName: "ParseHistoryToHistoricMessages",
+		Description: "ParseHistoryToHistoricMessages this function to convert chat history to historic messages\n\nTags:\n  - @displayName: ParseHistoryToHistoricMessages\n\nParameters:\n  - historyJson: chat history in json format\n\nReturns:\n  - history: the history in sharedtypes.HistoricMessage format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "historyJson", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+		},
+	},
+	{
+		Name:        "ProcessSubworkflowIdentificationOutput",
+		DisplayName: "ProcessSubworkflowIdentificationOutput",
+		Description: "ProcessSubworkflowIdentificationOutput this function process output of llm\n\nTags:\n  - @displayName: ProcessSubworkflowIdentificationOutput\n\nParameters:\n  - llmOutput: the llm output for subworkflow identification\n\nReturns:\n  - status: status of processing\n  - workflowName: the identified subworkflow name\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "llmOutput", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "status", Type: "string", GoType: "string"},
+			{Name: "workflowName", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "SimilartitySearchOnPathDescriptions",
+		DisplayName: "SimilartitySearchOnPathDescriptions",
+		Description: "SimilartitySearchOnPathDescriptions do similarity search on path description\n\nTags:\n  - @displayName: SimilartitySearchOnPathDescriptions\n\nParameters:\n  - ctx: the context of the request\n  - instruction: the user query\n  - toolName: the tool name\n\nReturns:\n  - descriptions: the list of descriptions\n  - error: an error if the tool name is invalid or the similarity search fails\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "instruction", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "toolName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "descriptions", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "SimilartitySearchOnPathDescriptionsQdrant",
+		DisplayName: "SimilartitySearchOnPathDescriptions (Qdrant)",
+		Description: "SimilartitySearchOnPathDescriptions (Qdrant) do similarity search on path description\n\nTags:\n  - @displayName: SimilartitySearchOnPathDescriptions (Qdrant)\n\nParameters:\n  - ctx: the context of the request\n  - instruction: the user query\n  - toolName: the tool name\n\nReturns:\n  - descriptions: the list of descriptions\n  - error: an error if the vector database cannot be queried\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "vector", Type: "json", GoType: "[]float32", Options: []string{}},
+			{Name: "collection", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "similaritySearchResults", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "similaritySearchMinScore", Type: "number", GoType: "float64", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "descriptions", Type: "json", GoType: "[]string"},
+		},
+	},
+	{
+		Name:        "SynthesizeActions",
+		DisplayName: "SynthesizeActions",
+		Description: "SynthesizeActions update action as per user instruction\n\nTags:\n  - @displayName: SynthesizeActions\n\nParameters:\n  - message: the message from the llm\n  - properties: the list of properties\n  - actions: the list of actions\n\nReturns:\n  - updatedActions: the list of synthesized actions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "properties", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "actions", Type: "json", GoType: "[]map[string]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedActions", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	{
+		Name:        "SynthesizeActionsTool13",
+		DisplayName: "SynthesizeActionsTool13",
+		Description: "SynthesizeActionsTool13 synthesize actions based on user instruction\n\nTags:\n  - @displayName: SynthesizeActionsTool13\n\nParameters:\n  - content: the llm content\n\nReturns:\n  - result: the synthesized string\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "content", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "SynthesizeActionsTool14",
+		DisplayName: "SynthesizeActionsTool14",
+		Description: "SynthesizeActionsTool14 synthesize actions based on user instruction\n\nTags:\n  - @displayName: SynthesizeActionsTool14\n\nParameters:\n  - content: the llm content\n\nReturns:\n  - result: the synthesized string\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "content", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "SynthesizeActionsTool4",
+		DisplayName: "SynthesizeActionsTool4",
+		Description: "SynthesizeActionsTool4 update action as per user instruction\n\nTags:\n  - @displayName: SynthesizeActionsTool4\n\nParameters:\n  - message: the message from the llm\n  - actions: the list of actions\n\nReturns:\n  - updatedActions: the list of synthesized actions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "actions", Type: "json", GoType: "[]map[string]string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedActions", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	{
+		Name:        "QdrantCreateCollection",
+		DisplayName: "Create Qdrant Collection",
+		Description: "QdrantCreateCollection creates a collection in qdrant\n\nTags:\n  - @displayName: Create Qdrant Collection\n\nParams:\n  - collectionName (string): The name of the collection\n  - vectorSize (uint64): The size of the vectors stored in this collection\n  - vectorDistance (string): The distance metric to use of vector similarity search (cosine, dot, euclid, manhattan)\n\nReturns:\n  - error: an error if the collection could not be created\n",
+		Category:    "qdrant",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "vectorSize", Type: "number", GoType: "uint64", Options: []string{}},
+			{Name: "vectorDistance", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "QdrantCreateIndex",
+		DisplayName: "Create Qdrant Index",
+		Description: "QdrantCreateIndex creates a field index on a qdrant collection\n\nTags:\n  - @displayName: Create Qdrant Index\n\nParams:\n  - collectionName (string): The name of the collection\n  - fieldName (string): The name of the payload field to create an index on\n  - fieldType (string): The qdrant type that the payload field is expected to be\n  - wait (bool): Whether to wait for the index to be created or return immediately & continue indexing in background\n\nReturns:\n  - error: an error if the index could not be created\n",
+		Category:    "qdrant",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "fieldName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "fieldType", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "wait", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "QdrantInsertData",
+		DisplayName: "Insert Data into Qdrant",
+		Description: "QdrantInsertData inserts data into a collection in qdrant\n\nTags:\n  - @displayName: Insert Data into Qdrant\n\nParams:\n  - collectionName (string): The name of the collection\n  - data ([]interface{}): The data points to insert (func will fail if elements are not `map[string]any`)\n  - idFieldName (string): The name of the field to use as the ID\n  - vectorFieldName (string): The name of the field to use as the vector\n\nReturns:\n  - error: an error if the data could not be inserted\n",
+		Category:    "qdrant",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "data", Type: "json", GoType: "[]interface{}", Options: []string{}},
+			{Name: "idFieldName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "vectorFieldName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "CheckApiKeyAuthMongoDb",
+		DisplayName: "Verify API Key",
+		Description: "CheckApiKeyAuthMongoDb checks if the given API key is valid and has access to the service.\n\nTags:\n  - @displayName: Verify API Key\n\nParameters:\n  - ctx: the context of the request\n  - apiKey: The API key to check.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - @secret\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - isAuthenticated: A boolean indicating whether the API key is authenticated.\n  - error: an error if the MongoDB database cannot be accessed\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "apiKey", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "isAuthenticated", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "CheckCreateUserIdMongoDb",
+		DisplayName: "Check and Create User ID",
+		Description: "CheckCreateUserIdMongoDb checks if a user ID exists in the MongoDB database and creates it if it doesn't.\n\nTags:\n  - @displayName: Check and Create User ID\n\nParameters:\n  - ctx: the context of the request\n  - userId: The user ID to check.\n  - tokenLimitForNewUsers: The token limit for new users.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - @secret\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - existingUser: A boolean indicating whether the user ID already exists.\n  - error: an error if the MongoDB database cannot be accessed\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userId", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "tokenLimitForNewUsers", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "existingUser", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "CreateMessageWithVariable",
+		DisplayName: "Create Message with Variable",
+		Description: "CreateMessageWithVariable creates a message with a variable.\n\nTags:\n  - @displayName: Create Message with Variable\n\nParameters:\n  - message: The message to create.\n  - variable: The variable to insert into the message.\n\nReturns:\n  - updatedMessage: The updated message with the variable inserted.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "variable", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedMessage", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "DenyCustomerAccessAndSendWarningMongoDb",
+		DisplayName: "Deny Customer Access",
+		Description: "DenyCustomerAccessAndSendWarningMongoDb denies access to the customer and sends a warning if necessary.\n\nTags:\n  - @displayName: Deny Customer Access\n\nParameters:\n  - ctx: the context of the request\n  - apiKey: The API key of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - @secret\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - customerName: The name of the customer.\n  - sendWarning: A boolean indicating whether a warning should be sent to the customer.\n  - error: an error if the MongoDB database cannot be accessed\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "apiKey", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "customerName", Type: "string", GoType: "string"},
+			{Name: "sendWarning", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "DenyCustomerAccessAndSendWarningMongoDbUserId",
+		DisplayName: "Deny Customer Access by User ID",
+		Description: "DenyCustomerAccessAndSendWarningMongoDbUserId denies access to the customer by user ID and sends a warning if necessary.\n\nTags:\n  - @displayName: Deny Customer Access by User ID\n\nParameters:\n  - ctx: the context of the request\n  - userId: The user ID of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - @secret\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - sendWarning: A boolean indicating whether a warning should be sent to the customer.\n  - error: an error if the MongoDB database cannot be accessed\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userId", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "sendWarning", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "SendLogicAppNotificationEmail",
+		DisplayName: "Send Email Notification",
+		Description: "SendLogicAppNotificationEmail sends a POST request to the email service.\n\nTags:\n  - @displayName: Send Email Notification\n\nParameters:\n  - ctx: the context of the request\n  - logicAppEndpoint: The email service endpoint, including its access signature.\n  - @secret\n  - email: The email address.\n  - subject: The email subject.\n  - content: The email content.\n\nReturns:\n  - error: an error if the notification cannot be sent\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "logicAppEndpoint", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "email", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "subject", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "content", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "UpdateTotalTokenCountForCustomerMongoDb",
+		DisplayName: "Update Total Token Count",
+		Description: "UpdateTotalTokenCountForCustomerMongoDb updates the total token count for the given customer in the MongoDB database.\n\nTags:\n  - @displayName: Update Total Token Count\n\nParameters:\n  - ctx: the context of the request\n  - apiKey: The API key of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - @secret\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n  - additionalTokenCount: The number of additional tokens to add to the total token count.\n\nReturns:\n  - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.\n  - error: an error if the MongoDB database cannot be accessed\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "apiKey", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "additionalTokenCount", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "UpdateTotalTokenCountForUserIdMongoDb",
+		DisplayName: "Update Total Token Count by User ID",
+		Description: "UpdateTotalTokenCountForUserIdMongoDb updates the total token count for the given user ID in the MongoDB database.\n\nTags:\n  - @displayName: Update Total Token Count by User ID\n\nParameters:\n  - ctx: the context of the request\n  - userId: The user ID of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - @secret\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n  - additionalTokenCount: The number of additional tokens to add to the total token count.\n\nReturns:\n  - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.\n  - error: an error if the MongoDB database cannot be accessed\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userId", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "additionalTokenCount", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+		},
+	},
+	{
+		Name:        "ExecuteTool",
+		DisplayName: "Execute MCP Tool",
+		Description: "ExecuteTool executes a specific tool via the MCP server with provided arguments.\n\nTags:\n  - @displayName: Execute MCP Tool\n\nParameters:\n  - ctx: the context of the request\n  - serverURL: the WebSocket URL of the MCP server\n  - toolName: the name of the tool to execute\n  - args: a map of arguments to pass to the tool\n\nReturns:\n  - result: the response from the tool execution\n  - error: any error that occurred during execution\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "toolName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "args", Type: "json", GoType: "map[string]interface{}", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "map[string]interface{}", Type: "json", GoType: "map[string]interface{}"},
+		},
+	},
+	{
+		Name:        "GetResource",
+		DisplayName: "Get MCP Resource",
+		Description: "GetResource retrieves a named resource from the MCP server.\n\nTags:\n  - @displayName: Get MCP Resource\n\nParameters:\n  - ctx: the context of the request\n  - serverURL: the WebSocket URL of the MCP server\n  - resourceName: the name of the resource to retrieve\n\nReturns:\n  - result: the retrieved resource as a map\n  - error: any error that occurred during the request\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "resourceName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "map[string]interface{}", Type: "json", GoType: "map[string]interface{}"},
+		},
+	},
+	{
+		Name:        "GetSystemPrompt",
+		DisplayName: "Get MCP Prompt",
+		Description: "GetSystemPrompt retrieves a system prompt by name from the MCP server.\n\nTags:\n  - @displayName: Get MCP Prompt\n\nParameters:\n  - ctx: the context of the request\n  - serverURL: the WebSocket URL of the MCP server\n  - promptName: the name of the system prompt to retrieve\n\nReturns:\n  - promptStr: the text of the retrieved prompt\n  - error: any error that occurred during the request\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "promptName", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "ListAll",
+		DisplayName: "List MCP Items",
+		Description: "ListAll retrieves all tools, resources, and prompts from the MCP server.\n\nTags:\n  - @displayName: List MCP Items\n\nParameters:\n  - ctx: the context of the request\n  - serverURL: the WebSocket URL of the MCP server\n\nReturns:\n  - result: a map with lists of tool/resource/prompt names categorized by type\n  - error: any error that occurred during the process\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "map[string][]string", Type: "json", GoType: "map[string][]string"},
+		},
+	},
+	{
+		Name:        "AddGuidsToAttributes",
+		DisplayName: "Add GUIDs to criteria suggestions",
+		Description: "AddGuidsToAttributes adds GUIDs to the attributes in the criteria\n\nTags:\n  - @displayName: Add GUIDs to criteria suggestions\n\nParameters:\n  - criteriaSuggestions: the list of criteria without identities\n  - availableAttributes: the list of available attributes with their identities\n\nReturns:\n  - criteriaWithGuids: the list of criteria with their identities\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialLlmCriterion", Options: []string{}},
+			{Name: "availableAttributes", Type: "json", GoType: "[]MaterialAttribute", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "criteriaWithGuids", Type: "json", GoType: "[]MaterialCriterionWithGuid"},
+		},
+	},
+	{
+		Name:        "ExtractCriteriaSuggestions",
+		DisplayName: "Extract criteria suggestions from LLM response",
+		Description: "ExtractCriteriaSuggestions extracts criteria suggestions from the LLM response text\n\nTags:\n  - @displayName: Extract criteria suggestions from LLM response\n\nParameters:\n  - llmResponse: the text response from the LLM containing JSON with criteria suggestions\n\nReturns:\n  - criteriaSuggestions: the list of criteria suggestions extracted from the LLM response\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "llmResponse", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialLlmCriterion"},
+		},
+	},
+	{
+		Name:        "ExtractJson",
+		DisplayName: "ExtractJson",
+		Description: "",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "text", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "json", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "FilterOutDuplicateAttributes",
+		DisplayName: "Filter out duplicate attributes",
+		Description: "FilterOutDuplicateAttributes filters out duplicate attributes from the criteria suggestions based on their names\n\nTags:\n  - @displayName: Filter out duplicate attributes\n\nParameters:\n  - criteriaSuggestions: current list of criteria suggestions\n\nReturns:\n  - filtered: the list of criteria suggestions excluding duplicates based on attribute names\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialLlmCriterion", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filtered", Type: "json", GoType: "[]MaterialLlmCriterion"},
+		},
+	},
+	{
+		Name:        "FilterOutNonExistingAttributes",
+		DisplayName: "Filter out non-existing attributes",
+		Description: "FilterOutNonExistingAttributes filters out criteria suggestions that do not match any of the available attributes based on their names\n\nTags:\n  - @displayName: Filter out non-existing attributes\n\nParameters:\n  - criteriaSuggestions: current list of criteria suggestions\n  - availableAttributes: the list of available attributes\n\nReturns:\n  - filtered: the list of criteria suggestions excluding those that do not match any of the available attributes\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialLlmCriterion", Options: []string{}},
+			{Name: "availableAttributes", Type: "json", GoType: "[]MaterialAttribute", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filtered", Type: "json", GoType: "[]MaterialLlmCriterion"},
+		},
+	},
+	{
+		Name:        "LogRequestFailed",
+		DisplayName: "Log request failed",
+		Description: "LogRequestFailed writes a .Info log entry indicating that a request was not completed successfully.\n\nTags:\n  - @displayName: Log request failed\n\nParameters:\n  - none\n\nReturns:\n  - none\n",
+		Category:    "ansys_materials",
+		Input:       []*aaliflowkitgrpc.FunctionInputDefinition{},
+		Output:      []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "LogRequestFailedDebugWithMessage",
+		DisplayName: "Log request failed with message",
+		Description: "LogRequestFailedDebugWithMessage writes a .Debug log entry indicating that a request was not completed successfully with additional message.\n\nTags:\n  - @displayName: Log request failed with message\n\nParameters:\n  - msg1: the first part of the debug message\n  - msg2: the second part of the debug message\n\nReturns:\n  - none\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "msg1", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "msg2", Type: "string", GoType: "string", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "LogRequestSuccess",
+		DisplayName: "Log request success",
+		Description: "LogRequestSuccess writes a .Info log entry indicating that a request was completed successfully.\n\nTags:\n  - @displayName: Log request success\n\nParameters:\n  - none\n\nReturns:\n  - none\n",
+		Category:    "ansys_materials",
+		Input:       []*aaliflowkitgrpc.FunctionInputDefinition{},
+		Output:      []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	{
+		Name:        "PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput",
+		DisplayName: "Multiple General LLM Requests (Specific Models, No Stream, Attribute Extraction, OpenAI Token Output)",
+		Description: "PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput performs multiple general LLM requests\nusing specific models, extracts structured attributes (criteria) from the responses, and returns the total token count\nusing the specified OpenAI token counting model. This version does not stream responses.\n\nTags:\n  - @displayName: Multiple General LLM Requests (Specific Models, No Stream, Attribute Extraction, OpenAI Token Output)\n\nParameters:\n  - ctx: the context of the request\n  - input: the user input string\n  - history: the conversation history for context\n  - systemPrompt: the system prompt to guide the LLM\n  - modelIds: the model IDs of the LLMs to query\n  - tokenCountModelName: the model name used for token count calculation\n  - n: number of parallel requests to perform\n\nReturns:\n  - uniqueCriterion: a deduplicated list of extracted attributes (criteria) from all responses\n  - tokenCount: the total token count (input tokens × n + combined output tokens)\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage", Options: []string{}},
+			{Name: "systemPrompt", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "modelIds", Type: "json", GoType: "[]string", Options: []string{}},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "n", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uniqueCriterion", Type: "json", GoType: "[]MaterialLlmCriterion"},
+			{Name: "tokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	{
+		Name:        "SerializeResponse",
+		DisplayName: "Serialize response for clients",
+		Description: "SerializeResponse formats the criteria to a response suitable for the UI clients in string format\n\nTags:\n  - @displayName: Serialize response for clients\n\nParameters:\n  - criteriaSuggestions: the list of criteria with their identities\n  - tokens: tokens consumed by the request\n\nReturns:\n  - result: string representation of the response in JSON format\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialCriterionWithGuid", Options: []string{}},
+			{Name: "tokens", Type: "number", GoType: "int", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	{
+		Name:        "SetCopilotGenerateRequestJsonBody",
+		DisplayName: "Copilot Generate Request JSON Body",
+		Description: "SetGenerateRequestJsonBody creates a JSON body for the generate request to RHSC Copilot.\nIt takes various parameters to configure the request and returns the JSON string.\n\nTags:\n  - @displayName: Copilot Generate Request JSON Body\n\nParameters:\n  - query: the query string for the request.\n  - sessionID: the session ID for the request.\n  - mode: the mode of operation for the request.\n  - timeout: the timeout for the request in seconds.\n  - priority: the priority of the request.\n  - agentPreference: the preferred agent for the request.\n  - saveIntermediate: whether to save intermediate results.\n  - similarityTopK: the number of top similar results to consider.\n  - noCritique: whether to disable critique.\n  - maxIterations: the maximum number of iterations for the request.\n  - forceAzure: whether to force the use of Azure for the request.\n",
+		Category:    "rhsc",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "sessionID", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "mode", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "timeout", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "priority", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "agentPreference", Type: "string", GoType: "string", Options: []string{}},
+			{Name: "saveIntermediate", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "similarityTopK", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "noCritique", Type: "boolean", GoType: "bool", Options: []string{}},
+			{Name: "maxIterations", Type: "number", GoType: "int", Options: []string{}},
+			{Name: "forceAzure", Type: "boolean", GoType: "bool", Options: []string{}},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "jsonBody", Type: "string", GoType: "string"},
+		},
+	},
+}
+
+// FunctionsMetadata holds the registry metadata of the functions of ExternalFunctionsMap
+var FunctionsMetadata = map[string]*internalstates.FunctionMetadata{
+	"AppendMessageHistory": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"role": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: []string{"user", "assistant", "system"}, Secret: false},
+		},
+	},
+	"BuildFinalQueryForCodeLLMRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"BuildFinalQueryForGeneralLLMRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"BuildLibraryContext": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CheckTokenLimitReached": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformBatchEmbeddingRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformBatchHybridEmbeddingRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformCodeLLMRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralModelSpecificationRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequestNoStreaming": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequestSpecificModel": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptions": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequestSpecificModelModelOptionsAndImages": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformGeneralRequestWithImages": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformKeywordExtractionRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformStructuredRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"maxRepairs": {Required: false, Default: registryString("2"), Min: registryFloat(0), Max: registryFloat(5), Pattern: "", Enum: nil, Secret: false},
+			"modelIds":   {Required: false, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: false},
+		},
+	},
+	"PerformSummaryRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformVectorEmbeddingRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		CacheTTL:           registryDuration("1h0m0s"),
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformVectorEmbeddingRequestWithTokenLimitCatch": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ShortenMessageHistory": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AddDataRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AddGraphDbParameter": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CreateCollectionRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CreateDbFilter": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CreateKeywordsDbFilter": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CreateMetadataDbFilter": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CreateTagsDbFilter": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GeneralGraphDbQuery": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GeneralQuery": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetListCollections": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"RetrieveDependencies": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SendVectorsToKnowledgeDB": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SimilaritySearch": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		CacheTTL:           registryDuration("5m0s"),
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AecGetContextFromRetrieverModule": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AecPerformLLMFinalRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AisAcsSemanticHybridSearchs": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"acsApiKey": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"AisChangeAcsResponsesByFactor": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AisPerformLLMRephraseRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AisReturnIndexList": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTACSSemanticHybridSearchs": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTBuildFinalQuery": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTCheckProhibitedWords": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTExtractFieldsFromQuery": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTGetSystemPrompt": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTPerformLLMRephraseRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         true,
+		DeprecationMessage: "superseded by AnsysGPTPerformLLMRephraseRequestNew",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTPerformLLMRephraseRequestNew": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTPerformLLMRequest": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTRemoveNoneCitationsFromSearchResponse": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTReorderSearchResponseAndReturnOnlyTopK": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AnsysGPTReturnIndexList": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AppendStringSlices": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CreateGeneralDataExtractionDocumentObjects": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"DownloadGithubFileContent": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"githubAccessToken": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"DownloadGithubFilesContent": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GenerateDocumentTree": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetDocumentType": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetGithubFilesToExtract": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetLocalFileContent": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetLocalFilesContent": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetLocalFilesToExtract": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"LangchainSplitter": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"chunkOverlap": {Required: true, Default: nil, Min: registryFloat(0), Max: nil, Pattern: "", Enum: nil, Secret: false},
+			"chunkSize":    {Required: true, Default: nil, Min: registryFloat(1), Max: nil, Pattern: "", Enum: nil, Secret: false},
+		},
+	},
+	"LoadAndCheckExampleDependencies": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"LoadCodeGenerationElements": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"LoadCodeGenerationExamples": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"LoadUserGuideSections": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"StoreElementsInGraphDatabase": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"StoreElementsInVectorDatabase": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"StoreExamplesInGraphDatabase": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"StoreExamplesInVectorDatabase": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"StoreUserGuideSectionsInGraphDatabase": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"StoreUserGuideSectionsInVectorDatabase": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AssignStringToString": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ExtractJSONStringField": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GenerateUUID": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"JsonPath": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PrintFeedback": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SendRestAPICall": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"requestType": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: []string{"GET", "POST", "PUT", "PATCH", "DELETE"}, Secret: false},
+		},
+	},
+	"StringConcat": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"StringFormat": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToBool": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToByte": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToComplex128": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToComplex64": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToFloat32": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToFloat64": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToInt": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToInt16": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToInt32": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToInt64": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToInt8": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToInterface": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToRune": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToString": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToUint": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToUint16": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToUint32": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToUint64": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastAnyToUint8": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastArrayMapStringAnyToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastBoolToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastByteToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastComplex128ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastComplex64ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastFloat32ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastFloat64ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastInt16ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastInt32ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastInt64ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastInt8ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastIntToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastInterfaceToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastRuneToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastStringToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastUint16ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastUint32ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastUint64ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastUint8ToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CastUintToAny": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AppendMeshPilotHistory": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AppendToolHistory": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FetchActionsPathFromPathDescription": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FetchNodeDescriptionsFromPathDescription": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FetchPropertiesFromPathDescription": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FinalizeMessage": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FinalizeResult": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FindRelevantPathDescriptionByPrompt": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GenerateSubWorkflowPrompt": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GenerateUserPrompt": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GenerateUserPromptWithList": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetActionsFromConfig": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetSelectedSolution": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetSolutionsToFixProblem": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"MarkdownToHTML": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ParseHistory": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ParseHistoryToHistoricMessages": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ProcessSubworkflowIdentificationOutput": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SimilartitySearchOnPathDescriptions": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SimilartitySearchOnPathDescriptionsQdrant": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SynthesizeActions": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SynthesizeActionsTool13": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SynthesizeActionsTool14": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SynthesizeActionsTool4": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"QdrantCreateCollection": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"QdrantCreateIndex": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"QdrantInsertData": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"CheckApiKeyAuthMongoDb": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"mongoDbUrl": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"CheckCreateUserIdMongoDb": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"mongoDbUrl": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"CreateMessageWithVariable": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDb": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"mongoDbUrl": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDbUserId": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"mongoDbUrl": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"SendLogicAppNotificationEmail": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"logicAppEndpoint": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"UpdateTotalTokenCountForCustomerMongoDb": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"mongoDbUrl": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"UpdateTotalTokenCountForUserIdMongoDb": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs: map[string]*internalstates.InputConstraints{
+			"mongoDbUrl": {Required: true, Default: nil, Min: nil, Max: nil, Pattern: "", Enum: nil, Secret: true},
+		},
+	},
+	"ExecuteTool": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetResource": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"GetSystemPrompt": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ListAll": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"AddGuidsToAttributes": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ExtractCriteriaSuggestions": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"ExtractJson": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FilterOutDuplicateAttributes": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"FilterOutNonExistingAttributes": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"LogRequestFailed": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"LogRequestFailedDebugWithMessage": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"LogRequestSuccess": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SerializeResponse": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+	"SetCopilotGenerateRequestJsonBody": {
+		Version:            "",
+		Since:              "",
+		Deprecated:         false,
+		DeprecationMessage: "",
+		Tags:               []string{},
+		Examples:           []string{},
+		Inputs:             map[string]*internalstates.InputConstraints{},
+	},
+}
+
+func registryString(value string) *string {
+	return &value
+}
+
+func registryFloat(value float64) *float64 {
+	return &value
+}
+
+func registryDuration(value string) time.Duration {
+	duration, _ := time.ParseDuration(value)
+	return duration
+}
@@ -23,9 +23,14 @@
 package externalfunctions
 
 import (
+	"os"
 	"testing"
 
+	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExtractFieldsFromQuery(t *testing.T) {
@@ -305,3 +310,29 @@ func TestAnsysGPTCheckProhibitedWords(t *testing.T) {
 		})
 	}
 }
+
+func TestFunctionRegistry(t *testing.T) {
+	require := require.New(t)
+
+	// the dispatch map agrees with the generated definitions
+	internalstates.InitializeInternalStates()
+	require.NoError(functiondefinitions.LoadRegistry(FunctionDefinitions, FunctionsMetadata))
+	require.NoError(functiondefinitions.CheckConsistency(ExternalFunctionsMap))
+	for name := range ExternalFunctionsMap {
+		assert.Contains(t, FunctionAdapters, name, "adapter of %s is missing", name)
+	}
+	assert.Len(t, FunctionAdapters, len(ExternalFunctionsMap))
+
+	// the generated definitions agree with the source files, otherwise run go generate
+	internalstates.InitializeInternalStates()
+	for category, file := range FunctionSourceFiles {
+		content, err := os.ReadFile(file)
+		require.NoError(err)
+		require.NoError(functiondefinitions.ExtractFunctionDefinitionsFromPackage(string(content), category))
+	}
+	require.Len(FunctionDefinitions, len(internalstates.AvailableFunctions))
+	for _, definition := range FunctionDefinitions {
+		assert.Equal(t, internalstates.AvailableFunctions[definition.Name], definition, "definition of %s is out of date", definition.Name)
+		assert.Equal(t, internalstates.AvailableFunctionsMetadata[definition.Name], FunctionsMetadata[definition.Name], "metadata of %s is out of date", definition.Name)
+	}
+}
@@ -0,0 +1,3344 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by internal/gen/registry/gen.go; DO NOT EDIT.
+
+package externalfunctions
+
+import (
+	"context"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aali_graphdb"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+)
+
+// FunctionAdapters maps the function names to the adapters calling the functions without reflection
+var FunctionAdapters = map[string]FunctionAdapter{
+	// llm_handler
+	"AppendMessageHistory":                              adaptAppendMessageHistory,
+	"BuildFinalQueryForCodeLLMRequest":                  adaptBuildFinalQueryForCodeLLMRequest,
+	"BuildFinalQueryForGeneralLLMRequest":               adaptBuildFinalQueryForGeneralLLMRequest,
+	"BuildLibraryContext":                               adaptBuildLibraryContext,
+	"CheckTokenLimitReached":                            adaptCheckTokenLimitReached,
+	"PerformBatchEmbeddingRequest":                      adaptPerformBatchEmbeddingRequest,
+	"PerformBatchHybridEmbeddingRequest":                adaptPerformBatchHybridEmbeddingRequest,
+	"PerformCodeLLMRequest":                             adaptPerformCodeLLMRequest,
+	"PerformGeneralModelSpecificationRequest":           adaptPerformGeneralModelSpecificationRequest,
+	"PerformGeneralRequest":                             adaptPerformGeneralRequest,
+	"PerformGeneralRequestNoStreaming":                  adaptPerformGeneralRequestNoStreaming,
+	"PerformGeneralRequestSpecificModel":                adaptPerformGeneralRequestSpecificModel,
+	"PerformGeneralRequestSpecificModelAndModelOptions": adaptPerformGeneralRequestSpecificModelAndModelOptions,
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput": adaptPerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput,
+	"PerformGeneralRequestSpecificModelModelOptionsAndImages":                        adaptPerformGeneralRequestSpecificModelModelOptionsAndImages,
+	"PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput":                adaptPerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput,
+	"PerformGeneralRequestWithImages":                                                adaptPerformGeneralRequestWithImages,
+	"PerformKeywordExtractionRequest":                                                adaptPerformKeywordExtractionRequest,
+	"PerformStructuredRequest":                                                       adaptPerformStructuredRequest,
+	"PerformSummaryRequest":                                                          adaptPerformSummaryRequest,
+	"PerformVectorEmbeddingRequest":                                                  adaptPerformVectorEmbeddingRequest,
+	"PerformVectorEmbeddingRequestWithTokenLimitCatch":                               adaptPerformVectorEmbeddingRequestWithTokenLimitCatch,
+	"ShortenMessageHistory":                                                          adaptShortenMessageHistory,
+
+	// knowledge_db
+	"AddDataRequest":           adaptAddDataRequest,
+	"AddGraphDbParameter":      adaptAddGraphDbParameter,
+	"CreateCollectionRequest":  adaptCreateCollectionRequest,
+	"CreateDbFilter":           adaptCreateDbFilter,
+	"CreateKeywordsDbFilter":   adaptCreateKeywordsDbFilter,
+	"CreateMetadataDbFilter":   adaptCreateMetadataDbFilter,
+	"CreateTagsDbFilter":       adaptCreateTagsDbFilter,
+	"GeneralGraphDbQuery":      adaptGeneralGraphDbQuery,
+	"GeneralQuery":             adaptGeneralQuery,
+	"GetListCollections":       adaptGetListCollections,
+	"RetrieveDependencies":     adaptRetrieveDependencies,
+	"SendVectorsToKnowledgeDB": adaptSendVectorsToKnowledgeDB,
+	"SimilaritySearch":         adaptSimilaritySearch,
+
+	// ansys_gpt
+	"AecGetContextFromRetrieverModule":               adaptAecGetContextFromRetrieverModule,
+	"AecPerformLLMFinalRequest":                      adaptAecPerformLLMFinalRequest,
+	"AisAcsSemanticHybridSearchs":                    adaptAisAcsSemanticHybridSearchs,
+	"AisChangeAcsResponsesByFactor":                  adaptAisChangeAcsResponsesByFactor,
+	"AisPerformLLMRephraseRequest":                   adaptAisPerformLLMRephraseRequest,
+	"AisReturnIndexList":                             adaptAisReturnIndexList,
+	"AnsysGPTACSSemanticHybridSearchs":               adaptAnsysGPTACSSemanticHybridSearchs,
+	"AnsysGPTBuildFinalQuery":                        adaptAnsysGPTBuildFinalQuery,
+	"AnsysGPTCheckProhibitedWords":                   adaptAnsysGPTCheckProhibitedWords,
+	"AnsysGPTExtractFieldsFromQuery":                 adaptAnsysGPTExtractFieldsFromQuery,
+	"AnsysGPTGetSystemPrompt":                        adaptAnsysGPTGetSystemPrompt,
+	"AnsysGPTPerformLLMRephraseRequest":              adaptAnsysGPTPerformLLMRephraseRequest,
+	"AnsysGPTPerformLLMRephraseRequestNew":           adaptAnsysGPTPerformLLMRephraseRequestNew,
+	"AnsysGPTPerformLLMRequest":                      adaptAnsysGPTPerformLLMRequest,
+	"AnsysGPTRemoveNoneCitationsFromSearchResponse":  adaptAnsysGPTRemoveNoneCitationsFromSearchResponse,
+	"AnsysGPTReorderSearchResponseAndReturnOnlyTopK": adaptAnsysGPTReorderSearchResponseAndReturnOnlyTopK,
+	"AnsysGPTReturnIndexList":                        adaptAnsysGPTReturnIndexList,
+
+	// data_extraction
+	"AppendStringSlices":                         adaptAppendStringSlices,
+	"CreateGeneralDataExtractionDocumentObjects": adaptCreateGeneralDataExtractionDocumentObjects,
+	"DownloadGithubFileContent":                  adaptDownloadGithubFileContent,
+	"DownloadGithubFilesContent":                 adaptDownloadGithubFilesContent,
+	"GenerateDocumentTree":                       adaptGenerateDocumentTree,
+	"GetDocumentType":                            adaptGetDocumentType,
+	"GetGithubFilesToExtract":                    adaptGetGithubFilesToExtract,
+	"GetLocalFileContent":                        adaptGetLocalFileContent,
+	"GetLocalFilesContent":                       adaptGetLocalFilesContent,
+	"GetLocalFilesToExtract":                     adaptGetLocalFilesToExtract,
+	"LangchainSplitter":                          adaptLangchainSplitter,
+	"LoadAndCheckExampleDependencies":            adaptLoadAndCheckExampleDependencies,
+	"LoadCodeGenerationElements":                 adaptLoadCodeGenerationElements,
+	"LoadCodeGenerationExamples":                 adaptLoadCodeGenerationExamples,
+	"LoadUserGuideSections":                      adaptLoadUserGuideSections,
+	"StoreElementsInGraphDatabase":               adaptStoreElementsInGraphDatabase,
+	"StoreElementsInVectorDatabase":              adaptStoreElementsInVectorDatabase,
+	"StoreExamplesInGraphDatabase":               adaptStoreExamplesInGraphDatabase,
+	"StoreExamplesInVectorDatabase":              adaptStoreExamplesInVectorDatabase,
+	"StoreUserGuideSectionsInGraphDatabase":      adaptStoreUserGuideSectionsInGraphDatabase,
+	"StoreUserGuideSectionsInVectorDatabase":     adaptStoreUserGuideSectionsInVectorDatabase,
+
+	// generic
+	"AssignStringToString":   adaptAssignStringToString,
+	"ExtractJSONStringField": adaptExtractJSONStringField,
+	"GenerateUUID":           adaptGenerateUUID,
+	"JsonPath":               adaptJsonPath,
+	"PrintFeedback":          adaptPrintFeedback,
+	"SendRestAPICall":        adaptSendRestAPICall,
+	"StringConcat":           adaptStringConcat,
+	"StringFormat":           adaptStringFormat,
+
+	// cast
+	"CastAnyToBool":              adaptCastAnyToBool,
+	"CastAnyToByte":              adaptCastAnyToByte,
+	"CastAnyToComplex128":        adaptCastAnyToComplex128,
+	"CastAnyToComplex64":         adaptCastAnyToComplex64,
+	"CastAnyToFloat32":           adaptCastAnyToFloat32,
+	"CastAnyToFloat64":           adaptCastAnyToFloat64,
+	"CastAnyToInt":               adaptCastAnyToInt,
+	"CastAnyToInt16":             adaptCastAnyToInt16,
+	"CastAnyToInt32":             adaptCastAnyToInt32,
+	"CastAnyToInt64":             adaptCastAnyToInt64,
+	"CastAnyToInt8":              adaptCastAnyToInt8,
+	"CastAnyToInterface":         adaptCastAnyToInterface,
+	"CastAnyToRune":              adaptCastAnyToRune,
+	"CastAnyToString":            adaptCastAnyToString,
+	"CastAnyToUint":              adaptCastAnyToUint,
+	"CastAnyToUint16":            adaptCastAnyToUint16,
+	"CastAnyToUint32":            adaptCastAnyToUint32,
+	"CastAnyToUint64":            adaptCastAnyToUint64,
+	"CastAnyToUint8":             adaptCastAnyToUint8,
+	"CastArrayMapStringAnyToAny": adaptCastArrayMapStringAnyToAny,
+	"CastBoolToAny":              adaptCastBoolToAny,
+	"CastByteToAny":              adaptCastByteToAny,
+	"CastComplex128ToAny":        adaptCastComplex128ToAny,
+	"CastComplex64ToAny":         adaptCastComplex64ToAny,
+	"CastFloat32ToAny":           adaptCastFloat32ToAny,
+	"CastFloat64ToAny":           adaptCastFloat64ToAny,
+	"CastInt16ToAny":             adaptCastInt16ToAny,
+	"CastInt32ToAny":             adaptCastInt32ToAny,
+	"CastInt64ToAny":             adaptCastInt64ToAny,
+	"CastInt8ToAny":              adaptCastInt8ToAny,
+	"CastIntToAny":               adaptCastIntToAny,
+	"CastInterfaceToAny":         adaptCastInterfaceToAny,
+	"CastRuneToAny":              adaptCastRuneToAny,
+	"CastStringToAny":            adaptCastStringToAny,
+	"CastUint16ToAny":            adaptCastUint16ToAny,
+	"CastUint32ToAny":            adaptCastUint32ToAny,
+	"CastUint64ToAny":            adaptCastUint64ToAny,
+	"CastUint8ToAny":             adaptCastUint8ToAny,
+	"CastUintToAny":              adaptCastUintToAny,
+
+	// ansys_mesh_pilot
+	"AppendMeshPilotHistory":                    adaptAppendMeshPilotHistory,
+	"AppendToolHistory":                         adaptAppendToolHistory,
+	"FetchActionsPathFromPathDescription":       adaptFetchActionsPathFromPathDescription,
+	"FetchNodeDescriptionsFromPathDescription":  adaptFetchNodeDescriptionsFromPathDescription,
+	"FetchPropertiesFromPathDescription":        adaptFetchPropertiesFromPathDescription,
+	"FinalizeMessage":                           adaptFinalizeMessage,
+	"FinalizeResult":                            adaptFinalizeResult,
+	"FindRelevantPathDescriptionByPrompt":       adaptFindRelevantPathDescriptionByPrompt,
+	"GenerateSubWorkflowPrompt":                 adaptGenerateSubWorkflowPrompt,
+	"GenerateUserPrompt":                        adaptGenerateUserPrompt,
+	"GenerateUserPromptWithList":                adaptGenerateUserPromptWithList,
+	"GetActionsFromConfig":                      adaptGetActionsFromConfig,
+	"GetSelectedSolution":                       adaptGetSelectedSolution,
+	"GetSolutionsToFixProblem":                  adaptGetSolutionsToFixProblem,
+	"MarkdownToHTML":                            adaptMarkdownToHTML,
+	"ParseHistory":                              adaptParseHistory,
+	"ParseHistoryToHistoricMessages":            adaptParseHistoryToHistoricMessages,
+	"ProcessSubworkflowIdentificationOutput":    adaptProcessSubworkflowIdentificationOutput,
+	"SimilartitySearchOnPathDescriptions":       adaptSimilartitySearchOnPathDescriptions,
+	"SimilartitySearchOnPathDescriptionsQdrant": adaptSimilartitySearchOnPathDescriptionsQdrant,
+	"SynthesizeActions":                         adaptSynthesizeActions,
+	"SynthesizeActionsTool13":                   adaptSynthesizeActionsTool13,
+	"SynthesizeActionsTool14":                   adaptSynthesizeActionsTool14,
+	"SynthesizeActionsTool4":                    adaptSynthesizeActionsTool4,
+
+	// qdrant
+	"QdrantCreateCollection": adaptQdrantCreateCollection,
+	"QdrantCreateIndex":      adaptQdrantCreateIndex,
+	"QdrantInsertData":       adaptQdrantInsertData,
+
+	// auth
+	"CheckApiKeyAuthMongoDb":                        adaptCheckApiKeyAuthMongoDb,
+	"CheckCreateUserIdMongoDb":                      adaptCheckCreateUserIdMongoDb,
+	"CreateMessageWithVariable":                     adaptCreateMessageWithVariable,
+	"DenyCustomerAccessAndSendWarningMongoDb":       adaptDenyCustomerAccessAndSendWarningMongoDb,
+	"DenyCustomerAccessAndSendWarningMongoDbUserId": adaptDenyCustomerAccessAndSendWarningMongoDbUserId,
+	"SendLogicAppNotificationEmail":                 adaptSendLogicAppNotificationEmail,
+	"UpdateTotalTokenCountForCustomerMongoDb":       adaptUpdateTotalTokenCountForCustomerMongoDb,
+	"UpdateTotalTokenCountForUserIdMongoDb":         adaptUpdateTotalTokenCountForUserIdMongoDb,
+
+	// mcp
+	"ExecuteTool":     adaptExecuteTool,
+	"GetResource":     adaptGetResource,
+	"GetSystemPrompt": adaptGetSystemPrompt,
+	"ListAll":         adaptListAll,
+
+	// ansys_materials
+	"AddGuidsToAttributes":             adaptAddGuidsToAttributes,
+	"ExtractCriteriaSuggestions":       adaptExtractCriteriaSuggestions,
+	"ExtractJson":                      adaptExtractJson,
+	"FilterOutDuplicateAttributes":     adaptFilterOutDuplicateAttributes,
+	"FilterOutNonExistingAttributes":   adaptFilterOutNonExistingAttributes,
+	"LogRequestFailed":                 adaptLogRequestFailed,
+	"LogRequestFailedDebugWithMessage": adaptLogRequestFailedDebugWithMessage,
+	"LogRequestSuccess":                adaptLogRequestSuccess,
+	"PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput": adaptPerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput,
+	"SerializeResponse": adaptSerializeResponse,
+
+	// rhsc
+	"SetCopilotGenerateRequestJsonBody": adaptSetCopilotGenerateRequestJsonBody,
+}
+
+// adaptAppendMessageHistory calls AppendMessageHistory with the decoded inputs
+func adaptAppendMessageHistory(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[AppendMessageHistoryRole](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AppendMessageHistory(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptBuildFinalQueryForCodeLLMRequest calls BuildFinalQueryForCodeLLMRequest with the decoded inputs
+func adaptBuildFinalQueryForCodeLLMRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.DbResponse](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := BuildFinalQueryForCodeLLMRequest(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptBuildFinalQueryForGeneralLLMRequest calls BuildFinalQueryForGeneralLLMRequest with the decoded inputs
+func adaptBuildFinalQueryForGeneralLLMRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.DbResponse](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := BuildFinalQueryForGeneralLLMRequest(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptBuildLibraryContext calls BuildLibraryContext with the decoded inputs
+func adaptBuildLibraryContext(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := BuildLibraryContext(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptCheckTokenLimitReached calls CheckTokenLimitReached with the decoded inputs
+func adaptCheckTokenLimitReached(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeInt[int](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := CheckTokenLimitReached(in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformBatchEmbeddingRequest calls PerformBatchEmbeddingRequest with the decoded inputs
+func adaptPerformBatchEmbeddingRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := PerformBatchEmbeddingRequest(ctx, in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptPerformBatchHybridEmbeddingRequest calls PerformBatchHybridEmbeddingRequest with the decoded inputs
+func adaptPerformBatchHybridEmbeddingRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeInt[int](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformBatchHybridEmbeddingRequest(ctx, in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformCodeLLMRequest calls PerformCodeLLMRequest with the decoded inputs
+func adaptPerformCodeLLMRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeBool[bool](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformCodeLLMRequest(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralModelSpecificationRequest calls PerformGeneralModelSpecificationRequest with the decoded inputs
+func adaptPerformGeneralModelSpecificationRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[map[string]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralModelSpecificationRequest(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralRequest calls PerformGeneralRequest with the decoded inputs
+func adaptPerformGeneralRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralRequest(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralRequestNoStreaming calls PerformGeneralRequestNoStreaming with the decoded inputs
+func adaptPerformGeneralRequestNoStreaming(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := PerformGeneralRequestNoStreaming(ctx, in0, in1, in2)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptPerformGeneralRequestSpecificModel calls PerformGeneralRequestSpecificModel with the decoded inputs
+func adaptPerformGeneralRequestSpecificModel(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralRequestSpecificModel(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralRequestSpecificModelAndModelOptions calls PerformGeneralRequestSpecificModelAndModelOptions with the decoded inputs
+func adaptPerformGeneralRequestSpecificModelAndModelOptions(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeJSON[sharedtypes.ModelOptions](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralRequestSpecificModelAndModelOptions(ctx, in0, in1, in2, in3, in4, in5)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput calls PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput with the decoded inputs
+func adaptPerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[sharedtypes.ModelOptions](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeText[string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(ctx, in0, in1, in2, in3, in4, in5)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralRequestSpecificModelModelOptionsAndImages calls PerformGeneralRequestSpecificModelModelOptionsAndImages with the decoded inputs
+func adaptPerformGeneralRequestSpecificModelModelOptionsAndImages(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeJSON[sharedtypes.ModelOptions](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeJSON[[]string](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralRequestSpecificModelModelOptionsAndImages(ctx, in0, in1, in2, in3, in4, in5, in6)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput calls PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput with the decoded inputs
+func adaptPerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformGeneralRequestWithImages calls PerformGeneralRequestWithImages with the decoded inputs
+func adaptPerformGeneralRequestWithImages(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := PerformGeneralRequestWithImages(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptPerformKeywordExtractionRequest calls PerformKeywordExtractionRequest with the decoded inputs
+func adaptPerformKeywordExtractionRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeUint[uint32](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := PerformKeywordExtractionRequest(ctx, in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptPerformStructuredRequest calls PerformStructuredRequest with the decoded inputs
+func adaptPerformStructuredRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeInt[int](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, out2, out3, err := PerformStructuredRequest(ctx, in0, in1, in2, in3, in4, in5)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1, out2, out3}, nil
+}
+
+// adaptPerformSummaryRequest calls PerformSummaryRequest with the decoded inputs
+func adaptPerformSummaryRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := PerformSummaryRequest(ctx, in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptPerformVectorEmbeddingRequest calls PerformVectorEmbeddingRequest with the decoded inputs
+func adaptPerformVectorEmbeddingRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := PerformVectorEmbeddingRequest(ctx, in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptPerformVectorEmbeddingRequestWithTokenLimitCatch calls PerformVectorEmbeddingRequestWithTokenLimitCatch with the decoded inputs
+func adaptPerformVectorEmbeddingRequestWithTokenLimitCatch(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, out2, err := PerformVectorEmbeddingRequestWithTokenLimitCatch(ctx, in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1, out2}, nil
+}
+
+// adaptShortenMessageHistory calls ShortenMessageHistory with the decoded inputs
+func adaptShortenMessageHistory(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeInt[int](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := ShortenMessageHistory(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptAddDataRequest calls AddDataRequest with the decoded inputs
+func adaptAddDataRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.DbData](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	AddDataRequest(ctx, in0, in1)
+	return []interface{}{}, nil
+}
+
+// adaptAddGraphDbParameter calls AddGraphDbParameter with the decoded inputs
+func adaptAddGraphDbParameter(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[aali_graphdb.ParameterMap](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AddGraphDbParameter(in0, in1, in2, in3)
+	return []interface{}{out0}, nil
+}
+
+// adaptCreateCollectionRequest calls CreateCollectionRequest with the decoded inputs
+func adaptCreateCollectionRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeUint[uint64](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	CreateCollectionRequest(ctx, in0, in1, in2)
+	return []interface{}{}, nil
+}
+
+// adaptCreateDbFilter calls CreateDbFilter with the decoded inputs
+func adaptCreateDbFilter(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[sharedtypes.DbArrayFilter](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeJSON[sharedtypes.DbArrayFilter](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeJSON[[]sharedtypes.DbJsonFilter](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CreateDbFilter(in0, in1, in2, in3, in4, in5, in6)
+	return []interface{}{out0}, nil
+}
+
+// adaptCreateKeywordsDbFilter calls CreateKeywordsDbFilter with the decoded inputs
+func adaptCreateKeywordsDbFilter(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeBool[bool](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CreateKeywordsDbFilter(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptCreateMetadataDbFilter calls CreateMetadataDbFilter with the decoded inputs
+func adaptCreateMetadataDbFilter(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeBool[bool](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CreateMetadataDbFilter(in0, in1, in2, in3)
+	return []interface{}{out0}, nil
+}
+
+// adaptCreateTagsDbFilter calls CreateTagsDbFilter with the decoded inputs
+func adaptCreateTagsDbFilter(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeBool[bool](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CreateTagsDbFilter(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptGeneralGraphDbQuery calls GeneralGraphDbQuery with the decoded inputs
+func adaptGeneralGraphDbQuery(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[aali_graphdb.ParameterMap](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := GeneralGraphDbQuery(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptGeneralQuery calls GeneralQuery with the decoded inputs
+func adaptGeneralQuery(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeInt[int](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[sharedtypes.DbFilters](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0 := GeneralQuery(ctx, in0, in1, in2, in3)
+	return []interface{}{out0}, nil
+}
+
+// adaptGetListCollections calls GetListCollections with the decoded inputs
+func adaptGetListCollections(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	out0 := GetListCollections(ctx)
+	return []interface{}{out0}, nil
+}
+
+// adaptRetrieveDependencies calls RetrieveDependencies with the decoded inputs
+func adaptRetrieveDependencies(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[sharedtypes.DbArrayFilter](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeInt[int](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0 := RetrieveDependencies(in0, in1, in2, in3, in4)
+	return []interface{}{out0}, nil
+}
+
+// adaptSendVectorsToKnowledgeDB calls SendVectorsToKnowledgeDB with the decoded inputs
+func adaptSendVectorsToKnowledgeDB(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]float32](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeInt[int](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeFloat[float64](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SendVectorsToKnowledgeDB(ctx, in0, in1, in2, in3, in4, in5)
+	return []interface{}{out0}, nil
+}
+
+// adaptSimilaritySearch calls SimilaritySearch with the decoded inputs
+func adaptSimilaritySearch(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]float32](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeInt[int](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[sharedtypes.DbFilters](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeFloat[float64](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeBool[bool](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeBool[bool](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	in7, err := decodeBool[bool](decode, 7)
+	if err != nil {
+		return nil, err
+	}
+	in8, err := decodeBool[bool](decode, 8)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SimilaritySearch(ctx, in0, in1, in2, in3, in4, in5, in6, in7, in8)
+	return []interface{}{out0}, nil
+}
+
+// adaptAecGetContextFromRetrieverModule calls AecGetContextFromRetrieverModule with the decoded inputs
+func adaptAecGetContextFromRetrieverModule(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeInt[int](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeText[string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeText[string](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := AecGetContextFromRetrieverModule(ctx, in0, in1, in2, in3, in4, in5, in6)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptAecPerformLLMFinalRequest calls AecPerformLLMFinalRequest with the decoded inputs
+func adaptAecPerformLLMFinalRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]sharedtypes.AnsysGPTRetrieverModuleChunk](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeJSON[[]string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeJSON[[]string](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	in7, err := decodeJSON[[]string](decode, 7)
+	if err != nil {
+		return nil, err
+	}
+	in8, err := decodeText[string](decode, 8)
+	if err != nil {
+		return nil, err
+	}
+	in9, err := decodeInt[int](decode, 9)
+	if err != nil {
+		return nil, err
+	}
+	in10, err := decodeInt[int](decode, 10)
+	if err != nil {
+		return nil, err
+	}
+	in11, err := decodeText[string](decode, 11)
+	if err != nil {
+		return nil, err
+	}
+	in12, err := decodeBool[bool](decode, 12)
+	if err != nil {
+		return nil, err
+	}
+	in13, err := decodeText[string](decode, 13)
+	if err != nil {
+		return nil, err
+	}
+	in14, err := decodeText[string](decode, 14)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := AecPerformLLMFinalRequest(ctx, in0, in1, in2, in3, in4, in5, in6, in7, in8, in9, in10, in11, in12, in13, in14)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptAisAcsSemanticHybridSearchs calls AisAcsSemanticHybridSearchs with the decoded inputs
+func adaptAisAcsSemanticHybridSearchs(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]float32](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeJSON[[]string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeJSON[[]string](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	in7, err := decodeInt[int](decode, 7)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := AisAcsSemanticHybridSearchs(ctx, in0, in1, in2, in3, in4, in5, in6, in7)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptAisChangeAcsResponsesByFactor calls AisChangeAcsResponsesByFactor with the decoded inputs
+func adaptAisChangeAcsResponsesByFactor(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[map[string]float64](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.ACSSearchResponse](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AisChangeAcsResponsesByFactor(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptAisPerformLLMRephraseRequest calls AisPerformLLMRephraseRequest with the decoded inputs
+func adaptAisPerformLLMRephraseRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, out2, err := AisPerformLLMRephraseRequest(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1, out2}, nil
+}
+
+// adaptAisReturnIndexList calls AisReturnIndexList with the decoded inputs
+func adaptAisReturnIndexList(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AisReturnIndexList(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTACSSemanticHybridSearchs calls AnsysGPTACSSemanticHybridSearchs with the decoded inputs
+func adaptAnsysGPTACSSemanticHybridSearchs(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]float32](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeJSON[[]string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeJSON[map[string]string](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	in7, err := decodeInt[int](decode, 7)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := AnsysGPTACSSemanticHybridSearchs(ctx, in0, in1, in2, in3, in4, in5, in6, in7)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTBuildFinalQuery calls AnsysGPTBuildFinalQuery with the decoded inputs
+func adaptAnsysGPTBuildFinalQuery(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.ACSSearchResponse](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, out2 := AnsysGPTBuildFinalQuery(in0, in1)
+	return []interface{}{out0, out1, out2}, nil
+}
+
+// adaptAnsysGPTCheckProhibitedWords calls AnsysGPTCheckProhibitedWords with the decoded inputs
+func adaptAnsysGPTCheckProhibitedWords(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1 := AnsysGPTCheckProhibitedWords(in0, in1, in2)
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptAnsysGPTExtractFieldsFromQuery calls AnsysGPTExtractFieldsFromQuery with the decoded inputs
+func adaptAnsysGPTExtractFieldsFromQuery(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[map[string][]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]sharedtypes.AnsysGPTDefaultFields](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AnsysGPTExtractFieldsFromQuery(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTGetSystemPrompt calls AnsysGPTGetSystemPrompt with the decoded inputs
+func adaptAnsysGPTGetSystemPrompt(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AnsysGPTGetSystemPrompt(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTPerformLLMRephraseRequest calls AnsysGPTPerformLLMRephraseRequest with the decoded inputs
+func adaptAnsysGPTPerformLLMRephraseRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := AnsysGPTPerformLLMRephraseRequest(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTPerformLLMRephraseRequestNew calls AnsysGPTPerformLLMRephraseRequestNew with the decoded inputs
+func adaptAnsysGPTPerformLLMRephraseRequestNew(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := AnsysGPTPerformLLMRephraseRequestNew(ctx, in0, in1, in2)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTPerformLLMRequest calls AnsysGPTPerformLLMRequest with the decoded inputs
+func adaptAnsysGPTPerformLLMRequest(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeBool[bool](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := AnsysGPTPerformLLMRequest(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptAnsysGPTRemoveNoneCitationsFromSearchResponse calls AnsysGPTRemoveNoneCitationsFromSearchResponse with the decoded inputs
+func adaptAnsysGPTRemoveNoneCitationsFromSearchResponse(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.ACSSearchResponse](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.AnsysGPTCitation](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AnsysGPTRemoveNoneCitationsFromSearchResponse(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTReorderSearchResponseAndReturnOnlyTopK calls AnsysGPTReorderSearchResponseAndReturnOnlyTopK with the decoded inputs
+func adaptAnsysGPTReorderSearchResponseAndReturnOnlyTopK(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.ACSSearchResponse](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeInt[int](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AnsysGPTReorderSearchResponseAndReturnOnlyTopK(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptAnsysGPTReturnIndexList calls AnsysGPTReturnIndexList with the decoded inputs
+func adaptAnsysGPTReturnIndexList(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AnsysGPTReturnIndexList(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptAppendStringSlices calls AppendStringSlices with the decoded inputs
+func adaptAppendStringSlices(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AppendStringSlices(in0, in1, in2, in3, in4)
+	return []interface{}{out0}, nil
+}
+
+// adaptCreateGeneralDataExtractionDocumentObjects calls CreateGeneralDataExtractionDocumentObjects with the decoded inputs
+func adaptCreateGeneralDataExtractionDocumentObjects(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[][]float32](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]map[uint]float32](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CreateGeneralDataExtractionDocumentObjects(in0, in1, in2, in3)
+	return []interface{}{out0}, nil
+}
+
+// adaptDownloadGithubFileContent calls DownloadGithubFileContent with the decoded inputs
+func adaptDownloadGithubFileContent(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := DownloadGithubFileContent(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptDownloadGithubFilesContent calls DownloadGithubFilesContent with the decoded inputs
+func adaptDownloadGithubFilesContent(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := DownloadGithubFilesContent(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptGenerateDocumentTree calls GenerateDocumentTree with the decoded inputs
+func adaptGenerateDocumentTree(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeInt[int](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeBool[bool](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeBool[bool](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeInt[int](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	in7, err := decodeInt[int](decode, 7)
+	if err != nil {
+		return nil, err
+	}
+	in8, err := decodeInt[int](decode, 8)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := GenerateDocumentTree(ctx, in0, in1, in2, in3, in4, in5, in6, in7, in8)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptGetDocumentType calls GetDocumentType with the decoded inputs
+func adaptGetDocumentType(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := GetDocumentType(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptGetGithubFilesToExtract calls GetGithubFilesToExtract with the decoded inputs
+func adaptGetGithubFilesToExtract(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeJSON[[]string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeJSON[[]string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeJSON[[]string](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := GetGithubFilesToExtract(ctx, in0, in1, in2, in3, in4, in5, in6)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptGetLocalFileContent calls GetLocalFileContent with the decoded inputs
+func adaptGetLocalFileContent(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := GetLocalFileContent(in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptGetLocalFilesContent calls GetLocalFilesContent with the decoded inputs
+func adaptGetLocalFilesContent(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := GetLocalFilesContent(in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptGetLocalFilesToExtract calls GetLocalFilesToExtract with the decoded inputs
+func adaptGetLocalFilesToExtract(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := GetLocalFilesToExtract(in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptLangchainSplitter calls LangchainSplitter with the decoded inputs
+func adaptLangchainSplitter(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]byte](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeInt[int](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeInt[int](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := LangchainSplitter(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptLoadAndCheckExampleDependencies calls LoadAndCheckExampleDependencies with the decoded inputs
+func adaptLoadAndCheckExampleDependencies(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]byte](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.CodeGenerationElement](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[map[string]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := LoadAndCheckExampleDependencies(in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptLoadCodeGenerationElements calls LoadCodeGenerationElements with the decoded inputs
+func adaptLoadCodeGenerationElements(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]byte](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := LoadCodeGenerationElements(in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptLoadCodeGenerationExamples calls LoadCodeGenerationExamples with the decoded inputs
+func adaptLoadCodeGenerationExamples(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeText[string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeJSON[map[string][]string](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	in7, err := decodeJSON[map[string]map[string]string](decode, 7)
+	if err != nil {
+		return nil, err
+	}
+	in8, err := decodeInt[int](decode, 8)
+	if err != nil {
+		return nil, err
+	}
+	in9, err := decodeInt[int](decode, 9)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := LoadCodeGenerationExamples(ctx, in0, in1, in2, in3, in4, in5, in6, in7, in8, in9)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptLoadUserGuideSections calls LoadUserGuideSections with the decoded inputs
+func adaptLoadUserGuideSections(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeText[string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := LoadUserGuideSections(ctx, in0, in1, in2, in3, in4, in5)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptStoreElementsInGraphDatabase calls StoreElementsInGraphDatabase with the decoded inputs
+func adaptStoreElementsInGraphDatabase(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.CodeGenerationElement](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	err = StoreElementsInGraphDatabase(in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptStoreElementsInVectorDatabase calls StoreElementsInVectorDatabase with the decoded inputs
+func adaptStoreElementsInVectorDatabase(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.CodeGenerationElement](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeInt[int](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	err = StoreElementsInVectorDatabase(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptStoreExamplesInGraphDatabase calls StoreExamplesInGraphDatabase with the decoded inputs
+func adaptStoreExamplesInGraphDatabase(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.CodeGenerationExample](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	err = StoreExamplesInGraphDatabase(in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptStoreExamplesInVectorDatabase calls StoreExamplesInVectorDatabase with the decoded inputs
+func adaptStoreExamplesInVectorDatabase(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.CodeGenerationExample](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeInt[int](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	err = StoreExamplesInVectorDatabase(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptStoreUserGuideSectionsInGraphDatabase calls StoreUserGuideSectionsInGraphDatabase with the decoded inputs
+func adaptStoreUserGuideSectionsInGraphDatabase(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.CodeGenerationUserGuideSection](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	err = StoreUserGuideSectionsInGraphDatabase(in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptStoreUserGuideSectionsInVectorDatabase calls StoreUserGuideSectionsInVectorDatabase with the decoded inputs
+func adaptStoreUserGuideSectionsInVectorDatabase(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.CodeGenerationUserGuideSection](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeInt[int](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeInt[int](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeInt[int](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeText[string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	err = StoreUserGuideSectionsInVectorDatabase(ctx, in0, in1, in2, in3, in4, in5)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptAssignStringToString calls AssignStringToString with the decoded inputs
+func adaptAssignStringToString(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AssignStringToString(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptExtractJSONStringField calls ExtractJSONStringField with the decoded inputs
+func adaptExtractJSONStringField(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := ExtractJSONStringField(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptGenerateUUID calls GenerateUUID with the decoded inputs
+func adaptGenerateUUID(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	out0 := GenerateUUID()
+	return []interface{}{out0}, nil
+}
+
+// adaptJsonPath calls JsonPath with the decoded inputs
+func adaptJsonPath(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[any](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeBool[bool](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := JsonPath(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptPrintFeedback calls PrintFeedback with the decoded inputs
+func adaptPrintFeedback(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[sharedtypes.Feedback](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	PrintFeedback(in0)
+	return []interface{}{}, nil
+}
+
+// adaptSendRestAPICall calls SendRestAPICall with the decoded inputs
+func adaptSendRestAPICall(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[map[string]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[map[string]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1 := SendRestAPICall(ctx, in0, in1, in2, in3, in4)
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptStringConcat calls StringConcat with the decoded inputs
+func adaptStringConcat(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := StringConcat(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptStringFormat calls StringFormat with the decoded inputs
+func adaptStringFormat(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := StringFormat(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToBool calls CastAnyToBool with the decoded inputs
+func adaptCastAnyToBool(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToBool(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToByte calls CastAnyToByte with the decoded inputs
+func adaptCastAnyToByte(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToByte(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToComplex128 calls CastAnyToComplex128 with the decoded inputs
+func adaptCastAnyToComplex128(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToComplex128(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToComplex64 calls CastAnyToComplex64 with the decoded inputs
+func adaptCastAnyToComplex64(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToComplex64(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToFloat32 calls CastAnyToFloat32 with the decoded inputs
+func adaptCastAnyToFloat32(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToFloat32(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToFloat64 calls CastAnyToFloat64 with the decoded inputs
+func adaptCastAnyToFloat64(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToFloat64(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToInt calls CastAnyToInt with the decoded inputs
+func adaptCastAnyToInt(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToInt(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToInt16 calls CastAnyToInt16 with the decoded inputs
+func adaptCastAnyToInt16(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToInt16(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToInt32 calls CastAnyToInt32 with the decoded inputs
+func adaptCastAnyToInt32(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToInt32(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToInt64 calls CastAnyToInt64 with the decoded inputs
+func adaptCastAnyToInt64(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToInt64(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToInt8 calls CastAnyToInt8 with the decoded inputs
+func adaptCastAnyToInt8(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToInt8(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToInterface calls CastAnyToInterface with the decoded inputs
+func adaptCastAnyToInterface(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToInterface(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToRune calls CastAnyToRune with the decoded inputs
+func adaptCastAnyToRune(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToRune(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToString calls CastAnyToString with the decoded inputs
+func adaptCastAnyToString(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToString(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToUint calls CastAnyToUint with the decoded inputs
+func adaptCastAnyToUint(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToUint(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToUint16 calls CastAnyToUint16 with the decoded inputs
+func adaptCastAnyToUint16(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToUint16(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToUint32 calls CastAnyToUint32 with the decoded inputs
+func adaptCastAnyToUint32(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToUint32(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToUint64 calls CastAnyToUint64 with the decoded inputs
+func adaptCastAnyToUint64(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToUint64(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastAnyToUint8 calls CastAnyToUint8 with the decoded inputs
+func adaptCastAnyToUint8(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastAnyToUint8(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastArrayMapStringAnyToAny calls CastArrayMapStringAnyToAny with the decoded inputs
+func adaptCastArrayMapStringAnyToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]map[string]any](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastArrayMapStringAnyToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastBoolToAny calls CastBoolToAny with the decoded inputs
+func adaptCastBoolToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeBool[bool](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastBoolToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastByteToAny calls CastByteToAny with the decoded inputs
+func adaptCastByteToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeUint[byte](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastByteToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastComplex128ToAny calls CastComplex128ToAny with the decoded inputs
+func adaptCastComplex128ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeComplex[complex128](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastComplex128ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastComplex64ToAny calls CastComplex64ToAny with the decoded inputs
+func adaptCastComplex64ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeComplex[complex64](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastComplex64ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastFloat32ToAny calls CastFloat32ToAny with the decoded inputs
+func adaptCastFloat32ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeFloat[float32](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastFloat32ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastFloat64ToAny calls CastFloat64ToAny with the decoded inputs
+func adaptCastFloat64ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeFloat[float64](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastFloat64ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastInt16ToAny calls CastInt16ToAny with the decoded inputs
+func adaptCastInt16ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeInt[int16](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastInt16ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastInt32ToAny calls CastInt32ToAny with the decoded inputs
+func adaptCastInt32ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeInt[int32](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastInt32ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastInt64ToAny calls CastInt64ToAny with the decoded inputs
+func adaptCastInt64ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeInt[int64](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastInt64ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastInt8ToAny calls CastInt8ToAny with the decoded inputs
+func adaptCastInt8ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeInt[int8](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastInt8ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastIntToAny calls CastIntToAny with the decoded inputs
+func adaptCastIntToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeInt[int](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastIntToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastInterfaceToAny calls CastInterfaceToAny with the decoded inputs
+func adaptCastInterfaceToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[interface{}](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastInterfaceToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastRuneToAny calls CastRuneToAny with the decoded inputs
+func adaptCastRuneToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeInt[rune](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastRuneToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastStringToAny calls CastStringToAny with the decoded inputs
+func adaptCastStringToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastStringToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastUint16ToAny calls CastUint16ToAny with the decoded inputs
+func adaptCastUint16ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeUint[uint16](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastUint16ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastUint32ToAny calls CastUint32ToAny with the decoded inputs
+func adaptCastUint32ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeUint[uint32](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastUint32ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastUint64ToAny calls CastUint64ToAny with the decoded inputs
+func adaptCastUint64ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeUint[uint64](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastUint64ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastUint8ToAny calls CastUint8ToAny with the decoded inputs
+func adaptCastUint8ToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeUint[uint8](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastUint8ToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptCastUintToAny calls CastUintToAny with the decoded inputs
+func adaptCastUintToAny(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeUint[uint](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CastUintToAny(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptAppendMeshPilotHistory calls AppendMeshPilotHistory with the decoded inputs
+func adaptAppendMeshPilotHistory(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]map[string]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AppendMeshPilotHistory(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptAppendToolHistory calls AppendToolHistory with the decoded inputs
+func adaptAppendToolHistory(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]map[string]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AppendToolHistory(in0, in1, in2, in3, in4)
+	return []interface{}{out0}, nil
+}
+
+// adaptFetchActionsPathFromPathDescription calls FetchActionsPathFromPathDescription with the decoded inputs
+func adaptFetchActionsPathFromPathDescription(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := FetchActionsPathFromPathDescription(in0, in1, in2)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptFetchNodeDescriptionsFromPathDescription calls FetchNodeDescriptionsFromPathDescription with the decoded inputs
+func adaptFetchNodeDescriptionsFromPathDescription(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := FetchNodeDescriptionsFromPathDescription(in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptFetchPropertiesFromPathDescription calls FetchPropertiesFromPathDescription with the decoded inputs
+func adaptFetchPropertiesFromPathDescription(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := FetchPropertiesFromPathDescription(in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptFinalizeMessage calls FinalizeMessage with the decoded inputs
+func adaptFinalizeMessage(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := FinalizeMessage(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptFinalizeResult calls FinalizeResult with the decoded inputs
+func adaptFinalizeResult(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]map[string]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := FinalizeResult(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptFindRelevantPathDescriptionByPrompt calls FindRelevantPathDescriptionByPrompt with the decoded inputs
+func adaptFindRelevantPathDescriptionByPrompt(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := FindRelevantPathDescriptionByPrompt(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptGenerateSubWorkflowPrompt calls GenerateSubWorkflowPrompt with the decoded inputs
+func adaptGenerateSubWorkflowPrompt(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1 := GenerateSubWorkflowPrompt(in0)
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptGenerateUserPrompt calls GenerateUserPrompt with the decoded inputs
+func adaptGenerateUserPrompt(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := GenerateUserPrompt(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptGenerateUserPromptWithList calls GenerateUserPromptWithList with the decoded inputs
+func adaptGenerateUserPromptWithList(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := GenerateUserPromptWithList(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptGetActionsFromConfig calls GetActionsFromConfig with the decoded inputs
+func adaptGetActionsFromConfig(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := GetActionsFromConfig(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptGetSelectedSolution calls GetSelectedSolution with the decoded inputs
+func adaptGetSelectedSolution(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := GetSelectedSolution(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptGetSolutionsToFixProblem calls GetSolutionsToFixProblem with the decoded inputs
+func adaptGetSolutionsToFixProblem(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := GetSolutionsToFixProblem(in0, in1, in2)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptMarkdownToHTML calls MarkdownToHTML with the decoded inputs
+func adaptMarkdownToHTML(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := MarkdownToHTML(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptParseHistory calls ParseHistory with the decoded inputs
+func adaptParseHistory(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := ParseHistory(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptParseHistoryToHistoricMessages calls ParseHistoryToHistoricMessages with the decoded inputs
+func adaptParseHistoryToHistoricMessages(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := ParseHistoryToHistoricMessages(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptProcessSubworkflowIdentificationOutput calls ProcessSubworkflowIdentificationOutput with the decoded inputs
+func adaptProcessSubworkflowIdentificationOutput(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1 := ProcessSubworkflowIdentificationOutput(in0)
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptSimilartitySearchOnPathDescriptions calls SimilartitySearchOnPathDescriptions with the decoded inputs
+func adaptSimilartitySearchOnPathDescriptions(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := SimilartitySearchOnPathDescriptions(ctx, in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptSimilartitySearchOnPathDescriptionsQdrant calls SimilartitySearchOnPathDescriptionsQdrant with the decoded inputs
+func adaptSimilartitySearchOnPathDescriptionsQdrant(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]float32](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeInt[int](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeFloat[float64](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := SimilartitySearchOnPathDescriptionsQdrant(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptSynthesizeActions calls SynthesizeActions with the decoded inputs
+func adaptSynthesizeActions(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[[]map[string]string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SynthesizeActions(in0, in1, in2)
+	return []interface{}{out0}, nil
+}
+
+// adaptSynthesizeActionsTool13 calls SynthesizeActionsTool13 with the decoded inputs
+func adaptSynthesizeActionsTool13(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SynthesizeActionsTool13(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptSynthesizeActionsTool14 calls SynthesizeActionsTool14 with the decoded inputs
+func adaptSynthesizeActionsTool14(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SynthesizeActionsTool14(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptSynthesizeActionsTool4 calls SynthesizeActionsTool4 with the decoded inputs
+func adaptSynthesizeActionsTool4(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]map[string]string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SynthesizeActionsTool4(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptQdrantCreateCollection calls QdrantCreateCollection with the decoded inputs
+func adaptQdrantCreateCollection(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeUint[uint64](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	err = QdrantCreateCollection(ctx, in0, in1, in2)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptQdrantCreateIndex calls QdrantCreateIndex with the decoded inputs
+func adaptQdrantCreateIndex(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeBool[bool](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	err = QdrantCreateIndex(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptQdrantInsertData calls QdrantInsertData with the decoded inputs
+func adaptQdrantInsertData(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]interface{}](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	err = QdrantInsertData(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptCheckApiKeyAuthMongoDb calls CheckApiKeyAuthMongoDb with the decoded inputs
+func adaptCheckApiKeyAuthMongoDb(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := CheckApiKeyAuthMongoDb(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptCheckCreateUserIdMongoDb calls CheckCreateUserIdMongoDb with the decoded inputs
+func adaptCheckCreateUserIdMongoDb(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeInt[int](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := CheckCreateUserIdMongoDb(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptCreateMessageWithVariable calls CreateMessageWithVariable with the decoded inputs
+func adaptCreateMessageWithVariable(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := CreateMessageWithVariable(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptDenyCustomerAccessAndSendWarningMongoDb calls DenyCustomerAccessAndSendWarningMongoDb with the decoded inputs
+func adaptDenyCustomerAccessAndSendWarningMongoDb(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1, err := DenyCustomerAccessAndSendWarningMongoDb(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptDenyCustomerAccessAndSendWarningMongoDbUserId calls DenyCustomerAccessAndSendWarningMongoDbUserId with the decoded inputs
+func adaptDenyCustomerAccessAndSendWarningMongoDbUserId(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := DenyCustomerAccessAndSendWarningMongoDbUserId(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptSendLogicAppNotificationEmail calls SendLogicAppNotificationEmail with the decoded inputs
+func adaptSendLogicAppNotificationEmail(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	err = SendLogicAppNotificationEmail(ctx, in0, in1, in2, in3)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{}, nil
+}
+
+// adaptUpdateTotalTokenCountForCustomerMongoDb calls UpdateTotalTokenCountForCustomerMongoDb with the decoded inputs
+func adaptUpdateTotalTokenCountForCustomerMongoDb(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeInt[int](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := UpdateTotalTokenCountForCustomerMongoDb(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptUpdateTotalTokenCountForUserIdMongoDb calls UpdateTotalTokenCountForUserIdMongoDb with the decoded inputs
+func adaptUpdateTotalTokenCountForUserIdMongoDb(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeText[string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeInt[int](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := UpdateTotalTokenCountForUserIdMongoDb(ctx, in0, in1, in2, in3, in4)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptExecuteTool calls ExecuteTool with the decoded inputs
+func adaptExecuteTool(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeJSON[map[string]interface{}](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := ExecuteTool(ctx, in0, in1, in2)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptGetResource calls GetResource with the decoded inputs
+func adaptGetResource(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := GetResource(ctx, in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptGetSystemPrompt calls GetSystemPrompt with the decoded inputs
+func adaptGetSystemPrompt(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := GetSystemPrompt(ctx, in0, in1)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptListAll calls ListAll with the decoded inputs
+func adaptListAll(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0, err := ListAll(ctx, in0)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{out0}, nil
+}
+
+// adaptAddGuidsToAttributes calls AddGuidsToAttributes with the decoded inputs
+func adaptAddGuidsToAttributes(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.MaterialLlmCriterion](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.MaterialAttribute](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := AddGuidsToAttributes(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptExtractCriteriaSuggestions calls ExtractCriteriaSuggestions with the decoded inputs
+func adaptExtractCriteriaSuggestions(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := ExtractCriteriaSuggestions(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptExtractJson calls ExtractJson with the decoded inputs
+func adaptExtractJson(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := ExtractJson(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptFilterOutDuplicateAttributes calls FilterOutDuplicateAttributes with the decoded inputs
+func adaptFilterOutDuplicateAttributes(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.MaterialLlmCriterion](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	out0 := FilterOutDuplicateAttributes(in0)
+	return []interface{}{out0}, nil
+}
+
+// adaptFilterOutNonExistingAttributes calls FilterOutNonExistingAttributes with the decoded inputs
+func adaptFilterOutNonExistingAttributes(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.MaterialLlmCriterion](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.MaterialAttribute](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := FilterOutNonExistingAttributes(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptLogRequestFailed calls LogRequestFailed with the decoded inputs
+func adaptLogRequestFailed(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	LogRequestFailed()
+	return []interface{}{}, nil
+}
+
+// adaptLogRequestFailedDebugWithMessage calls LogRequestFailedDebugWithMessage with the decoded inputs
+func adaptLogRequestFailedDebugWithMessage(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	LogRequestFailedDebugWithMessage(in0, in1)
+	return []interface{}{}, nil
+}
+
+// adaptLogRequestSuccess calls LogRequestSuccess with the decoded inputs
+func adaptLogRequestSuccess(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	LogRequestSuccess()
+	return []interface{}{}, nil
+}
+
+// adaptPerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput calls PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput with the decoded inputs
+func adaptPerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeJSON[[]sharedtypes.HistoricMessage](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeJSON[[]string](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeText[string](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeInt[int](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	out0, out1 := PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(ctx, in0, in1, in2, in3, in4, in5)
+	return []interface{}{out0, out1}, nil
+}
+
+// adaptSerializeResponse calls SerializeResponse with the decoded inputs
+func adaptSerializeResponse(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeJSON[[]sharedtypes.MaterialCriterionWithGuid](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeInt[int](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SerializeResponse(in0, in1)
+	return []interface{}{out0}, nil
+}
+
+// adaptSetCopilotGenerateRequestJsonBody calls SetCopilotGenerateRequestJsonBody with the decoded inputs
+func adaptSetCopilotGenerateRequestJsonBody(ctx context.Context, decode InputDecoder) ([]interface{}, error) {
+	in0, err := decodeText[string](decode, 0)
+	if err != nil {
+		return nil, err
+	}
+	in1, err := decodeText[string](decode, 1)
+	if err != nil {
+		return nil, err
+	}
+	in2, err := decodeText[string](decode, 2)
+	if err != nil {
+		return nil, err
+	}
+	in3, err := decodeInt[int](decode, 3)
+	if err != nil {
+		return nil, err
+	}
+	in4, err := decodeInt[int](decode, 4)
+	if err != nil {
+		return nil, err
+	}
+	in5, err := decodeText[string](decode, 5)
+	if err != nil {
+		return nil, err
+	}
+	in6, err := decodeBool[bool](decode, 6)
+	if err != nil {
+		return nil, err
+	}
+	in7, err := decodeInt[int](decode, 7)
+	if err != nil {
+		return nil, err
+	}
+	in8, err := decodeBool[bool](decode, 8)
+	if err != nil {
+		return nil, err
+	}
+	in9, err := decodeInt[int](decode, 9)
+	if err != nil {
+		return nil, err
+	}
+	in10, err := decodeBool[bool](decode, 10)
+	if err != nil {
+		return nil, err
+	}
+	out0 := SetCopilotGenerateRequestJsonBody(in0, in1, in2, in3, in4, in5, in6, in7, in8, in9, in10)
+	return []interface{}{out0}, nil
+}
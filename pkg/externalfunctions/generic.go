@@ -24,6 +24,7 @@ package externalfunctions
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,6 +32,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/ansys/aali-flowkit/pkg/tracing"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
 	"github.com/google/uuid"
 	"k8s.io/client-go/util/jsonpath"
@@ -42,7 +44,9 @@ import (
 //   - @displayName: REST Call
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - requestType: the type of the request (GET, POST, PUT, PATCH, DELETE)
+//   - @enum: GET, POST, PUT, PATCH, DELETE
 //   - urlString: the URL to send the request to
 //   - headers: the headers to include in the request
 //   - query: the query parameters to include in the request
@@ -51,7 +55,7 @@ import (
 // Returns:
 //   - success: a boolean indicating whether the request was successful
 //   - returnJsonBody: the JSON body of the response as a string
-func SendRestAPICall(requestType string, endpoint string, header map[string]string, query map[string]string, jsonBody string) (success bool, returnJsonBody string) {
+func SendRestAPICall(ctx context.Context, requestType string, endpoint string, header map[string]string, query map[string]string, jsonBody string) (success bool, returnJsonBody string) {
 	// verify correct request type
 	if requestType != "GET" && requestType != "POST" && requestType != "PUT" && requestType != "PATCH" && requestType != "DELETE" {
 		panic(fmt.Sprintf("Invalid request type: %v", requestType))
@@ -72,9 +76,9 @@ func SendRestAPICall(requestType string, endpoint string, header map[string]stri
 	// Create the HTTP request
 	var req *http.Request
 	if jsonBody != "" {
-		req, err = http.NewRequest(requestType, parsedURL.String(), bytes.NewBuffer([]byte(jsonBody)))
+		req, err = http.NewRequestWithContext(ctx, requestType, parsedURL.String(), bytes.NewBuffer([]byte(jsonBody)))
 	} else {
-		req, err = http.NewRequest(requestType, parsedURL.String(), nil)
+		req, err = http.NewRequestWithContext(ctx, requestType, parsedURL.String(), nil)
 	}
 	if err != nil {
 		panic(fmt.Sprintf("Error creating request: %v", err))
@@ -86,7 +90,7 @@ func SendRestAPICall(requestType string, endpoint string, header map[string]stri
 	}
 
 	// Execute the request
-	client := &http.Client{}
+	client := &http.Client{Transport: tracing.NewTransport(nil)}
 	resp, err := client.Do(req)
 	if err != nil {
 		panic(fmt.Sprintf("Error executing request: %v", err))
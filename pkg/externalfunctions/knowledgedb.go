@@ -29,6 +29,7 @@ import (
 
 	"github.com/ansys/aali-flowkit/pkg/privatefunctions/graphdb"
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/aali_graphdb"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
@@ -49,6 +50,7 @@ import (
 //   - @displayName: Similarity Search
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - vector: the vector to be sent to the KnowledgeDB
 //   - keywords: the keywords to be used to filter the results
 //   - keywordsSearch: the flag to enable the keywords search
@@ -58,7 +60,7 @@ import (
 //
 // Returns:
 //   - databaseResponse: an array of the most relevant data
-func SendVectorsToKnowledgeDB(vector []float32, keywords []string, keywordsSearch bool, collection string, similaritySearchResults int, similaritySearchMinScore float64) (databaseResponse []sharedtypes.DbResponse) {
+func SendVectorsToKnowledgeDB(ctx context.Context, vector []float32, keywords []string, keywordsSearch bool, collection string, similaritySearchResults int, similaritySearchMinScore float64) (databaseResponse []sharedtypes.DbResponse) {
 	logCtx := &logging.ContextMap{}
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
@@ -86,27 +88,27 @@ func SendVectorsToKnowledgeDB(vector []float32, keywords []string, keywordsSearc
 		WithVectors:    qdrant.NewWithVectorsEnable(false),
 		WithPayload:    qdrant.NewWithPayloadInclude("guid", "document_id", "document_name", "summary", "keywords", "text"),
 	}
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
 		logPanic(logCtx, "error in qdrant query: %q", err)
 	}
-	logging.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
+	redact.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
 
 	// transform qdrant result into aali type
 	dbResponses := make([]sharedtypes.DbResponse, len(scoredPoints))
 	for i, scoredPoint := range scoredPoints {
-		logging.Log.Debugf(&logging.ContextMap{}, "Result #%d:", i)
-		logging.Log.Debugf(&logging.ContextMap{}, "Similarity score: %v", scoredPoint.Score)
+		redact.Log.Debugf(&logging.ContextMap{}, "Result #%d:", i)
+		redact.Log.Debugf(&logging.ContextMap{}, "Similarity score: %v", scoredPoint.Score)
 		dbResponse, err := qdrant_utils.QdrantPayloadToType[sharedtypes.DbResponse](scoredPoint.GetPayload())
 		if err != nil {
 			errMsg := fmt.Sprintf("error converting qdrant payload to dbResponse: %q", err)
-			logging.Log.Errorf(logCtx, "%s", errMsg)
+			redact.Log.Errorf(logCtx, "%s", errMsg)
 			panic(errMsg)
 		}
 
-		logging.Log.Debugf(&logging.ContextMap{}, "Similarity file id: %v", dbResponse.DocumentId)
-		logging.Log.Debugf(&logging.ContextMap{}, "Similarity file name: %v", dbResponse.DocumentName)
-		logging.Log.Debugf(&logging.ContextMap{}, "Similarity summary: %v", dbResponse.Summary)
+		redact.Log.Debugf(&logging.ContextMap{}, "Similarity file id: %v", dbResponse.DocumentId)
+		redact.Log.Debugf(&logging.ContextMap{}, "Similarity file name: %v", dbResponse.DocumentName)
+		redact.Log.Debugf(&logging.ContextMap{}, "Similarity summary: %v", dbResponse.Summary)
 
 		// Add the result to the list
 		dbResponses[i] = dbResponse
@@ -122,18 +124,19 @@ func SendVectorsToKnowledgeDB(vector []float32, keywords []string, keywordsSearc
 // The function returns the list of collections.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - knowledgeDbEndpoint: the KnowledgeDB endpoint
 //
 // Returns:
 //   - collectionsList: the list of collections
-func GetListCollections() (collectionsList []string) {
+func GetListCollections(ctx context.Context) (collectionsList []string) {
 	logCtx := &logging.ContextMap{}
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
 		logPanic(logCtx, "unable to create qdrant client: %q", err)
 	}
 
-	collectionsList, err = client.ListCollections(context.TODO())
+	collectionsList, err = client.ListCollections(ctx)
 	if err != nil {
 		logPanic(logCtx, "unable to list qdrant collections: %q", err)
 	}
@@ -235,6 +238,7 @@ func GeneralGraphDbQuery(query string, parameters aali_graphdb.ParameterMap) []m
 //   - @displayName: Query
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - collectionName: the name of the collection to which the data objects will be added.
 //   - maxRetrievalCount: the maximum number of results to be retrieved.
 //   - outputFields: the fields to be included in the output.
@@ -242,7 +246,7 @@ func GeneralGraphDbQuery(query string, parameters aali_graphdb.ParameterMap) []m
 //
 // Returns:
 //   - databaseResponse: the query results
-func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []string, filters sharedtypes.DbFilters) (databaseResponse []sharedtypes.DbResponse) {
+func GeneralQuery(ctx context.Context, collectionName string, maxRetrievalCount int, outputFields []string, filters sharedtypes.DbFilters) (databaseResponse []sharedtypes.DbResponse) {
 	logCtx := &logging.ContextMap{}
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
@@ -259,11 +263,11 @@ func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []s
 		WithVectors:    qdrant.NewWithVectorsEnable(false),
 		WithPayload:    qdrant.NewWithPayloadInclude(outputFields...),
 	}
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
 		logPanic(logCtx, "error in qdrant query: %q", err)
 	}
-	logging.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
+	redact.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
 
 	// convert to aali type
 	databaseResponse = make([]sharedtypes.DbResponse, len(scoredPoints))
@@ -284,8 +288,10 @@ func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []s
 //
 // Tags:
 //   - @displayName: Similarity Search (Filtered)
+//   - @cache: 5m
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - collectionName: the name of the collection to which the data objects will be added.
 //   - embeddedVector: the embedded vector used for searching.
 //   - maxRetrievalCount: the maximum number of results to be retrieved.
@@ -299,7 +305,7 @@ func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []s
 //
 // Returns:
 //   - databaseResponse: the similarity search results
-func SimilaritySearch(
+func SimilaritySearch(ctx context.Context,
 	collectionName string,
 	embeddedVector []float32,
 	maxRetrievalCount int,
@@ -327,11 +333,11 @@ func SimilaritySearch(
 		WithVectors:    qdrant.NewWithVectorsEnable(false),
 		WithPayload:    qdrant.NewWithPayloadEnable(true),
 	}
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
 		logPanic(logCtx, "error in qdrant query: %q", err)
 	}
-	logging.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
+	redact.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
 
 	// convert to aali type
 	databaseResponse = make([]sharedtypes.DbResponse, len(scoredPoints))
@@ -351,29 +357,29 @@ func SimilaritySearch(
 
 	// get related nodes if requested
 	if getLeafNodes {
-		logging.Log.Debugf(logCtx, "getting leaf nodes")
-		err := qdrant_utils.RetrieveLeafNodes(logCtx, client, collectionName, &databaseResponse)
+		redact.Log.Debugf(logCtx, "getting leaf nodes")
+		err := qdrant_utils.RetrieveLeafNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
 			logPanic(logCtx, "error getting leaf nodes: %q", err)
 		}
 	}
 	if getSiblings {
-		logging.Log.Debugf(logCtx, "getting sibling nodes")
-		err := qdrant_utils.RetrieveDirectSiblingNodes(logCtx, client, collectionName, &databaseResponse)
+		redact.Log.Debugf(logCtx, "getting sibling nodes")
+		err := qdrant_utils.RetrieveDirectSiblingNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
 			logPanic(logCtx, "error getting sibling nodes: %q", err)
 		}
 	}
 	if getParent {
-		logging.Log.Debugf(logCtx, "getting parent nodes")
-		err := qdrant_utils.RetrieveParentNodes(logCtx, client, collectionName, &databaseResponse)
+		redact.Log.Debugf(logCtx, "getting parent nodes")
+		err := qdrant_utils.RetrieveParentNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
 			logPanic(logCtx, "error getting parent nodes: %q", err)
 		}
 	}
 	if getChildren {
-		logging.Log.Debugf(logCtx, "getting child nodes")
-		err := qdrant_utils.RetrieveChildNodes(logCtx, client, collectionName, &databaseResponse)
+		redact.Log.Debugf(logCtx, "getting child nodes")
+		err := qdrant_utils.RetrieveChildNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
 			logPanic(logCtx, "error getting child nodes: %q", err)
 		}
@@ -520,9 +526,10 @@ func CreateDbFilter(
 //   - @displayName: Add Data
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - collectionName: name of the collection the request is sent to.
 //   - data: the data to add.
-func AddDataRequest(collectionName string, documentData []sharedtypes.DbData) {
+func AddDataRequest(ctx context.Context, collectionName string, documentData []sharedtypes.DbData) {
 	points := make([]*qdrant.PointStruct, len(documentData))
 	for i, doc := range documentData {
 		id := qdrant.NewIDUUID(doc.Guid.String())
@@ -545,8 +552,6 @@ func AddDataRequest(collectionName string, documentData []sharedtypes.DbData) {
 		logPanic(nil, "unable to create qdrant client: %q", err)
 	}
 
-	ctx := context.TODO()
-
 	resp, err := client.Upsert(ctx, &qdrant.UpsertPoints{
 		CollectionName: collectionName,
 		Points:         points,
@@ -555,7 +560,7 @@ func AddDataRequest(collectionName string, documentData []sharedtypes.DbData) {
 	if err != nil {
 		logPanic(nil, "failed to insert data: %q", err)
 	}
-	logging.Log.Debugf(&logging.ContextMap{}, "successfully upserted %d points into qdrant collection %q: %q", len(points), collectionName, resp.GetStatus())
+	redact.Log.Debugf(&logging.ContextMap{}, "successfully upserted %d points into qdrant collection %q: %q", len(points), collectionName, resp.GetStatus())
 }
 
 // CreateCollectionRequest sends a request to the collection endpoint.
@@ -564,10 +569,11 @@ func AddDataRequest(collectionName string, documentData []sharedtypes.DbData) {
 //   - @displayName: Create Collection
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - collectionName: the name of the collection to create.
 //   - vectorSize: the length of the vector embeddings
 //   - vectorDistance: the vector similarity distance algorithm to use for the vector index (cosine, dot, euclid, manhattan)
-func CreateCollectionRequest(collectionName string, vectorSize uint64, vectorDistance string) {
+func CreateCollectionRequest(ctx context.Context, collectionName string, vectorSize uint64, vectorDistance string) {
 	logCtx := &logging.ContextMap{}
 
 	client, err := qdrant_utils.QdrantClient()
@@ -575,15 +581,13 @@ func CreateCollectionRequest(collectionName string, vectorSize uint64, vectorDis
 		logPanic(logCtx, "unable to create qdrant client: %q", err)
 	}
 
-	ctx := context.TODO()
-
 	// check if collection already exists
 	collectionExists, err := client.CollectionExists(ctx, collectionName)
 	if err != nil {
 		logPanic(logCtx, "unable to determine if collection already exists: %v", err)
 	}
 	if collectionExists {
-		logging.Log.Debugf(logCtx, "collection %q already exists, skipping creation", collectionName)
+		redact.Log.Debugf(logCtx, "collection %q already exists, skipping creation", collectionName)
 		return
 	}
 
@@ -598,7 +602,7 @@ func CreateCollectionRequest(collectionName string, vectorSize uint64, vectorDis
 	if err != nil {
 		logPanic(logCtx, "failed to create collection: %q", err)
 	}
-	logging.Log.Debugf(logCtx, "Created collection: %s", collectionName)
+	redact.Log.Debugf(logCtx, "Created collection: %s", collectionName)
 
 	// now create the default indexes (these are the things that other knowledgedb functions filter/search on)
 	// does ID need to be indexed?
@@ -621,6 +625,6 @@ func CreateCollectionRequest(collectionName string, vectorSize uint64, vectorDis
 		if err != nil {
 			logPanic(logCtx, "error creating payload index on %q: %v", index.name, err)
 		}
-		logging.Log.Debugf(logCtx, "created payload index on %q: %q", index.name, res.Status)
+		redact.Log.Debugf(logCtx, "created payload index on %q: %q", index.name, res.Status)
 	}
 }
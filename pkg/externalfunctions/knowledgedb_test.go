@@ -69,7 +69,7 @@ func TestSendVectorsToKnowledgeDB(t *testing.T) {
 		assert.False(collExists, "collection %q shouldn't exist before running", collection)
 
 		// now create collection
-		QdrantCreateCollection(collection, 4, distance)
+		require.NoError(QdrantCreateCollection(context.Background(), collection, 4, distance))
 
 		// now check collection is there
 		collExists, err = qdrantClient.CollectionExists(ctx, collection)
@@ -100,20 +100,20 @@ func TestSendVectorsToKnowledgeDB(t *testing.T) {
 				"level":         "leaf",
 			},
 		}
-		QdrantInsertData(collection, data, "id", "vector")
+		require.NoError(QdrantInsertData(context.Background(), collection, data, "id", "vector"))
 
 		// create index
-		QdrantCreateIndex(collection, "document_name", "keyword", true)
-		QdrantCreateIndex(collection, "keywords", "keyword", true)
-		QdrantCreateIndex(collection, "level", "keyword", true)
+		require.NoError(QdrantCreateIndex(context.Background(), collection, "document_name", "keyword", true))
+		require.NoError(QdrantCreateIndex(context.Background(), collection, "keywords", "keyword", true))
+		require.NoError(QdrantCreateIndex(context.Background(), collection, "level", "keyword", true))
 
 		// do a straight up search with an exact match
-		resp := SendVectorsToKnowledgeDB([]float32{0, -1, -2, -3}, []string{}, false, collection, 1, 0)
+		resp := SendVectorsToKnowledgeDB(context.Background(), []float32{0, -1, -2, -3}, []string{}, false, collection, 1, 0)
 		require.Len(resp, 1, "expected 1 result but got %d", len(resp))
 		assert.Equal("Doc 1", resp[0].DocumentName)
 
 		// do a keyword filtered search with approx match
-		resp = SendVectorsToKnowledgeDB([]float32{4, 5, 6, 7}, []string{"kw5"}, true, collection, 100, 0)
+		resp = SendVectorsToKnowledgeDB(context.Background(), []float32{4, 5, 6, 7}, []string{"kw5"}, true, collection, 100, 0)
 		require.Len(resp, 1, "expected 1 result but got %d", len(resp))
 		assert.Equal("Doc 3", resp[0].DocumentName)
 	}
@@ -141,7 +141,7 @@ func TestCreateAndListCollections(t *testing.T) {
 	config.GlobalConfig = &setup.config
 	logging.InitLogger(&setup.config)
 
-	colls := GetListCollections()
+	colls := GetListCollections(context.Background())
 	require.Len(colls, 0, "should be 0 collections initially")
 
 	collReqs := map[string]struct {
@@ -154,10 +154,10 @@ func TestCreateAndListCollections(t *testing.T) {
 		"mycollection4": {1524, "dot"},
 	}
 	for collName, params := range collReqs {
-		CreateCollectionRequest(collName, params.size, params.distance)
+		CreateCollectionRequest(context.Background(), collName, params.size, params.distance)
 	}
 
-	colls = GetListCollections()
+	colls = GetListCollections(context.Background())
 	assert.Len(colls, len(collReqs))
 
 	for expName := range maps.Keys(collReqs) {
@@ -195,7 +195,7 @@ func TestGeneralQuery(t *testing.T) {
 	assert.False(collExists, "collection %q shouldn't exist before running", COLLECTIONNAME)
 
 	// now create collection
-	QdrantCreateCollection(COLLECTIONNAME, 4, "cosine")
+	require.NoError(QdrantCreateCollection(context.Background(), COLLECTIONNAME, 4, "cosine"))
 
 	// now check collection is there
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -245,12 +245,12 @@ func TestGeneralQuery(t *testing.T) {
 			"tags":          []any{"tag2", "tag1"},
 		},
 	}
-	QdrantInsertData(COLLECTIONNAME, data, "id", "vector")
+	require.NoError(QdrantInsertData(context.Background(), COLLECTIONNAME, data, "id", "vector"))
 
 	// create index
-	QdrantCreateIndex(COLLECTIONNAME, "document_name", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "keywords", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "level", "keyword", true)
+	require.NoError(QdrantCreateIndex(context.Background(), COLLECTIONNAME, "document_name", "keyword", true))
+	require.NoError(QdrantCreateIndex(context.Background(), COLLECTIONNAME, "keywords", "keyword", true))
+	require.NoError(QdrantCreateIndex(context.Background(), COLLECTIONNAME, "level", "keyword", true))
 
 	// do search
 	filters := sharedtypes.DbFilters{
@@ -266,7 +266,7 @@ func TestGeneralQuery(t *testing.T) {
 		},
 	}
 
-	resp := GeneralQuery(COLLECTIONNAME, 100, []string{"document_name", "level", "keywords", "tags"}, filters)
+	resp := GeneralQuery(context.Background(), COLLECTIONNAME, 100, []string{"document_name", "level", "keywords", "tags"}, filters)
 	require.Len(resp, 1, "expected 1 result but got %d", len(resp))
 	assert.Equal("title", resp[0].DocumentName)
 	assert.Equal("middle", resp[0].Level)
@@ -304,7 +304,7 @@ func TestSimilaritySearch(t *testing.T) {
 	assert.False(collExists, "collection %q shouldn't exist before running", COLLECTIONNAME)
 
 	// now create collection
-	QdrantCreateCollection(COLLECTIONNAME, 4, "cosine")
+	require.NoError(QdrantCreateCollection(context.Background(), COLLECTIONNAME, 4, "cosine"))
 
 	// now check collection is there
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -381,15 +381,16 @@ func TestSimilaritySearch(t *testing.T) {
 			"child_ids":           []any{uuids[1]},
 		},
 	}
-	QdrantInsertData(COLLECTIONNAME, data, "id", "vector")
+	require.NoError(QdrantInsertData(context.Background(), COLLECTIONNAME, data, "id", "vector"))
 
 	// create index
-	QdrantCreateIndex(COLLECTIONNAME, "document_name", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "keywords", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "level", "keyword", true)
+	require.NoError(QdrantCreateIndex(context.Background(), COLLECTIONNAME, "document_name", "keyword", true))
+	require.NoError(QdrantCreateIndex(context.Background(), COLLECTIONNAME, "keywords", "keyword", true))
+	require.NoError(QdrantCreateIndex(context.Background(), COLLECTIONNAME, "level", "keyword", true))
 
 	// do search
 	resp := SimilaritySearch(
+		context.Background(),
 		COLLECTIONNAME,
 		[]float32{4, 5, 6, 7},
 		1,
@@ -453,7 +454,7 @@ func TestAddDataRequest(t *testing.T) {
 	assert.False(collExists, "collection %q shouldn't exist before running", COLLECTIONNAME)
 
 	// now create collection
-	QdrantCreateCollection(COLLECTIONNAME, 4, "cosine")
+	require.NoError(QdrantCreateCollection(context.Background(), COLLECTIONNAME, 4, "cosine"))
 
 	// now check collection is there
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -517,7 +518,7 @@ func TestAddDataRequest(t *testing.T) {
 			Embedding:    []float32{0, 1, 2, 3},
 		},
 	}
-	AddDataRequest(COLLECTIONNAME, data)
+	AddDataRequest(context.Background(), COLLECTIONNAME, data)
 
 	// check theres some points in there
 	points, err := qdrantClient.Query(ctx, &qdrant.QueryPoints{
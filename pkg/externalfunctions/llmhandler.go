@@ -23,11 +23,14 @@
 package externalfunctions
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/ansys/aali-flowkit/pkg/jsonschema"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
@@ -37,44 +40,42 @@ import (
 //
 // Tags:
 //   - @displayName: Embeddings
+//   - @cache: 1h
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string
 //
 // Returns:
 //   - embeddedVector: the embedded vector in float32 format
-func PerformVectorEmbeddingRequest(input string) (embeddedVector []float32) {
+//   - error: an error if the request to aali-llm fails
+func PerformVectorEmbeddingRequest(ctx context.Context, input string) (embeddedVector []float32, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send embeddings request
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, false, nil)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, false, nil)
 	defer close(responseChannel)
 
 	// Process the first response and close the channel
 	var embedding32 []float32
-	var err error
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return nil, responseError(response)
 		}
 
 		// Log LLM response
-		logging.Log.Debugf(&logging.ContextMap{}, "Received embeddings response.")
+		redact.Log.Debugf(&logging.ContextMap{}, "Received embeddings response.")
 
 		// Get embedded vector array
 		interfaceArray, ok := response.EmbeddedData.([]interface{})
 		if !ok {
-			errMessage := "error converting embedded data to interface array"
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "error converting embedded data to interface array")
 		}
 		embedding32, err = convertToFloat32Slice(interfaceArray)
 		if err != nil {
-			errMessage := fmt.Sprintf("error converting embedded data to float32 slice: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "error converting embedded data to float32 slice: %v", err)
 		}
 
 		// Mark that the first response has been received
@@ -86,7 +87,7 @@ func PerformVectorEmbeddingRequest(input string) (embeddedVector []float32) {
 		}
 	}
 
-	return embedding32
+	return embedding32, nil
 }
 
 // PerformVectorEmbeddingRequestWithTokenLimitCatch performs a vector embedding request to LLM
@@ -96,46 +97,43 @@ func PerformVectorEmbeddingRequest(input string) (embeddedVector []float32) {
 //   - @displayName: Embeddings with Token Limit Catch
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string
 //
 // Returns:
 //   - embeddedVector: the embedded vector in float32 format
-func PerformVectorEmbeddingRequestWithTokenLimitCatch(input string, tokenLimitMessage string) (embeddedVector []float32, tokenLimitReached bool, responseMessage string) {
+//   - error: an error if the request to aali-llm fails
+func PerformVectorEmbeddingRequestWithTokenLimitCatch(ctx context.Context, input string, tokenLimitMessage string) (embeddedVector []float32, tokenLimitReached bool, responseMessage string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send embeddings request
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, false, nil)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, false, nil)
 	defer close(responseChannel)
 
 	// Process the first response and close the channel
 	var embedding32 []float32
-	var err error
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
 			if strings.Contains(response.Error.Message, "tokens") {
-				return nil, true, tokenLimitMessage
+				return nil, true, tokenLimitMessage, nil
 			} else {
-				panic(response.Error)
+				return nil, false, "", responseError(response)
 			}
 		}
 
 		// Log LLM response
-		logging.Log.Debugf(&logging.ContextMap{}, "Received embeddings response.")
+		redact.Log.Debugf(&logging.ContextMap{}, "Received embeddings response.")
 
 		// Get embedded vector array
 		interfaceArray, ok := response.EmbeddedData.([]interface{})
 		if !ok {
-			errMessage := "error converting embedded data to interface array"
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, false, "", logError(nil, ErrUpstreamUnavailable, "error converting embedded data to interface array")
 		}
 		embedding32, err = convertToFloat32Slice(interfaceArray)
 		if err != nil {
-			errMessage := fmt.Sprintf("error converting embedded data to float32 slice: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, false, "", logError(nil, ErrUpstreamUnavailable, "error converting embedded data to float32 slice: %v", err)
 		}
 
 		// Mark that the first response has been received
@@ -147,7 +145,7 @@ func PerformVectorEmbeddingRequestWithTokenLimitCatch(input string, tokenLimitMe
 		}
 	}
 
-	return embedding32, false, ""
+	return embedding32, false, "", nil
 }
 
 // PerformBatchEmbeddingRequest performs a batch vector embedding request to LLM
@@ -156,16 +154,18 @@ func PerformVectorEmbeddingRequestWithTokenLimitCatch(input string, tokenLimitMe
 //   - @displayName: Batch Embeddings
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input strings
 //
 // Returns:
 //   - embeddedVectors: the embedded vectors in float32 format
-func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32) {
+//   - error: an error if the request to aali-llm fails
+func PerformBatchEmbeddingRequest(ctx context.Context, input []string) (embeddedVectors [][]float32, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send embeddings request
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, false, nil)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, false, nil)
 	defer close(responseChannel)
 
 	// Process the first response and close the channel
@@ -173,32 +173,26 @@ func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32)
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return nil, responseError(response)
 		}
 
 		// Log LLM response
-		logging.Log.Debugf(&logging.ContextMap{}, "Received batch embeddings response.")
+		redact.Log.Debugf(&logging.ContextMap{}, "Received batch embeddings response.")
 
 		// Get embedded vector array
 		interfaceArray, ok := response.EmbeddedData.([]interface{})
 		if !ok {
-			errMessage := "error converting embedded data to interface array"
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "error converting embedded data to interface array")
 		}
 
 		for i, interfaceArrayElement := range interfaceArray {
 			lowerInterfaceArray, ok := interfaceArrayElement.([]interface{})
 			if !ok {
-				errMessage := "error converting embedded data to interface array"
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				return nil, logError(nil, ErrUpstreamUnavailable, "error converting embedded data to interface array")
 			}
 			embedding32, err := convertToFloat32Slice(lowerInterfaceArray)
 			if err != nil {
-				errMessage := fmt.Sprintf("error converting embedded data to float32 slice: %v", err)
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				return nil, logError(nil, ErrUpstreamUnavailable, "error converting embedded data to float32 slice: %v", err)
 			}
 			embedding32Array[i] = embedding32
 		}
@@ -212,7 +206,7 @@ func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32)
 		}
 	}
 
-	return embedding32Array
+	return embedding32Array, nil
 }
 
 // PerformBatchHybridEmbeddingRequest performs a batch hybrid embedding request to LLM
@@ -222,12 +216,14 @@ func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32)
 //   - @displayName: Batch Hybrid Embeddings
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input strings
 //
 // Returns:
 //   - denseEmbeddings: the dense embeddings in float32 format
 //   - sparseEmbeddings: the sparse embeddings in map format
-func PerformBatchHybridEmbeddingRequest(input []string, maxBatchSize int) (denseEmbeddings [][]float32, sparseEmbeddings []map[uint]float32) {
+//   - error: an error if the request to aali-llm fails
+func PerformBatchHybridEmbeddingRequest(ctx context.Context, input []string, maxBatchSize int) (denseEmbeddings [][]float32, sparseEmbeddings []map[uint]float32, err error) {
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -241,11 +237,9 @@ func PerformBatchHybridEmbeddingRequest(input []string, maxBatchSize int) (dense
 		batchTextToEmbed := input[i:end]
 
 		// Send http request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error performing batch embedding request: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, nil, classError(err, ErrUpstreamUnavailable)
 		}
 
 		// Add the embeddings to the list
@@ -253,10 +247,10 @@ func PerformBatchHybridEmbeddingRequest(input []string, maxBatchSize int) (dense
 		sparseEmbeddings = append(sparseEmbeddings, batchLexicalWeights...)
 
 		processedEmbeddings += len(batchTextToEmbed)
-		logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
+		redact.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
 	}
 
-	return denseEmbeddings, sparseEmbeddings
+	return denseEmbeddings, sparseEmbeddings, nil
 }
 
 // PerformKeywordExtractionRequest performs a keywords extraction request to LLM
@@ -265,17 +259,19 @@ func PerformBatchHybridEmbeddingRequest(input []string, maxBatchSize int) (dense
 //   - @displayName: Keyword Extraction
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string
 //   - maxKeywordsSearch: the maximum number of keywords to search for
 //
 // Returns:
 //   - keywords: the keywords extracted from the input string as a slice of strings
-func PerformKeywordExtractionRequest(input string, maxKeywordsSearch uint32) (keywords []string) {
+//   - error: an error if the request to aali-llm fails
+func PerformKeywordExtractionRequest(ctx context.Context, input string, maxKeywordsSearch uint32) (keywords []string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequestNoHistory(input, "keywords", maxKeywordsSearch, llmHandlerEndpoint, nil, nil)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "keywords", maxKeywordsSearch, llmHandlerEndpoint, nil, nil)
 	defer close(responseChannel)
 
 	// Process all responses
@@ -283,7 +279,7 @@ func PerformKeywordExtractionRequest(input string, maxKeywordsSearch uint32) (ke
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -295,18 +291,16 @@ func PerformKeywordExtractionRequest(input string, maxKeywordsSearch uint32) (ke
 		}
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Received keywords response.")
+	redact.Log.Debugf(&logging.ContextMap{}, "Received keywords response.")
 
 	// Unmarshal JSON data into the result variable
-	err := json.Unmarshal([]byte(responseAsStr), &keywords)
+	err = json.Unmarshal([]byte(responseAsStr), &keywords)
 	if err != nil {
-		errMessage := fmt.Sprintf("Error unmarshalling keywords response from aali-llm: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, logError(nil, ErrUpstreamUnavailable, "Error unmarshalling keywords response from aali-llm: %v", err)
 	}
 
 	// Return the response
-	return keywords
+	return keywords, nil
 }
 
 // PerformSummaryRequest performs a summary request to LLM
@@ -315,16 +309,18 @@ func PerformKeywordExtractionRequest(input string, maxKeywordsSearch uint32) (ke
 //   - @displayName: Summary
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string
 //
 // Returns:
 //   - summary: the summary extracted from the input string
-func PerformSummaryRequest(input string) (summary string) {
+//   - error: an error if the request to aali-llm fails
+func PerformSummaryRequest(ctx context.Context, input string) (summary string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequestNoHistory(input, "summary", 1, llmHandlerEndpoint, nil, nil)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "summary", 1, llmHandlerEndpoint, nil, nil)
 	defer close(responseChannel)
 
 	// Process all responses
@@ -332,7 +328,7 @@ func PerformSummaryRequest(input string) (summary string) {
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", responseError(response)
 		}
 
 		// Accumulate the responses
@@ -344,10 +340,10 @@ func PerformSummaryRequest(input string) (summary string) {
 		}
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Received summary response.")
+	redact.Log.Debugf(&logging.ContextMap{}, "Received summary response.")
 
 	// Return the response
-	return responseAsStr
+	return responseAsStr, nil
 }
 
 // PerformGeneralRequest performs a general chat completion request to LLM
@@ -356,6 +352,7 @@ func PerformSummaryRequest(input string) (summary string) {
 //   - @displayName: General LLM Request
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string
 //   - history: the conversation history
 //   - isStream: the stream flag
@@ -364,22 +361,23 @@ func PerformSummaryRequest(input string) (summary string) {
 // Returns:
 //   - message: the generated message
 //   - stream: the stream channel
-func PerformGeneralRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func PerformGeneralRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil)
+	responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil)
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
 		// Create a stream channel
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// Close the response channel
@@ -390,7 +388,7 @@ func PerformGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -403,7 +401,7 @@ func PerformGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 	}
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // PerformGeneralRequestWithImages performs a general request to LLM with images
@@ -412,6 +410,7 @@ func PerformGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 //   - @displayName: General LLM Request (with Images)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
@@ -421,22 +420,23 @@ func PerformGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestWithImages(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, images []string) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func PerformGeneralRequestWithImages(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, images []string) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, images)
+	responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, images)
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
 		// Create a stream channel
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// Close the response channel
@@ -447,7 +447,7 @@ func PerformGeneralRequestWithImages(input string, history []sharedtypes.Histori
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -460,7 +460,7 @@ func PerformGeneralRequestWithImages(input string, history []sharedtypes.Histori
 	}
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // PerformGeneralModelSpecificationRequest performs a specified request to LLM with a configured model and Systemprompt.
@@ -469,23 +469,25 @@ func PerformGeneralRequestWithImages(input string, history []sharedtypes.Histori
 //   - @displayName: General LLM Request (Specified System Prompt)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs, tried in order as fallback chain; "chain:<name>" stands for a configured chain
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralModelSpecificationRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt map[string]string, modelIds []string) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func PerformGeneralModelSpecificationRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt map[string]string, modelIds []string) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	fmt.Printf("[%s] type of alpsRequest inside modelspecification %T\n", time.Now().Format("2006-01-02 15:04:05.000"), systemPrompt)
-	logging.Log.Infof(&logging.ContextMap{}, "[%s] type of alpsRequest inside modelspecification %T\n", time.Now().Format("2006-01-02 15:04:05.000"), systemPrompt)
+	redact.Log.Infof(&logging.ContextMap{}, "[%s] type of alpsRequest inside modelspecification %T\n", time.Now().Format("2006-01-02 15:04:05.000"), systemPrompt)
 
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
+	responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -493,10 +495,10 @@ func PerformGeneralModelSpecificationRequest(input string, history []sharedtypes
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// Close the response channel
@@ -507,7 +509,7 @@ func PerformGeneralModelSpecificationRequest(input string, history []sharedtypes
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -520,7 +522,7 @@ func PerformGeneralModelSpecificationRequest(input string, history []sharedtypes
 	}
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model
@@ -529,21 +531,23 @@ func PerformGeneralModelSpecificationRequest(input string, history []sharedtypes
 //   - @displayName: General LLM Request (Specific Models)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs, tried in order as fallback chain; "chain:<name>" stands for a configured chain
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestSpecificModel(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func PerformGeneralRequestSpecificModel(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
+	responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -551,10 +555,10 @@ func PerformGeneralRequestSpecificModel(input string, history []sharedtypes.Hist
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// Close the response channel
@@ -565,7 +569,7 @@ func PerformGeneralRequestSpecificModel(input string, history []sharedtypes.Hist
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -578,7 +582,7 @@ func PerformGeneralRequestSpecificModel(input string, history []sharedtypes.Hist
 	}
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model
@@ -587,22 +591,24 @@ func PerformGeneralRequestSpecificModel(input string, history []sharedtypes.Hist
 //   - @displayName: General LLM Request (Specific Models & Model Options)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs, tried in order as fallback chain; "chain:<name>" stands for a configured chain
 //   - modelOptions: the model options
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestSpecificModelAndModelOptions(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func PerformGeneralRequestSpecificModelAndModelOptions(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, &modelOptions, nil)
+	responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, &modelOptions, nil)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -610,10 +616,10 @@ func PerformGeneralRequestSpecificModelAndModelOptions(input string, history []s
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// Close the response channel
@@ -624,7 +630,7 @@ func PerformGeneralRequestSpecificModelAndModelOptions(input string, history []s
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -637,7 +643,7 @@ func PerformGeneralRequestSpecificModelAndModelOptions(input string, history []s
 	}
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // PerformGeneralRequestSpecificModelModelOptionsAndImages performs a general request to LLM with a specific model including model options and images
@@ -646,23 +652,25 @@ func PerformGeneralRequestSpecificModelAndModelOptions(input string, history []s
 //   - @displayName: General LLM Request (Specific Models, Model Options & Images)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs, tried in order as fallback chain; "chain:<name>" stands for a configured chain
 //   - modelOptions: the model options
 //   - images: the images to include in the request
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestSpecificModelModelOptionsAndImages(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, images []string) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func PerformGeneralRequestSpecificModelModelOptionsAndImages(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, images []string) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, &modelOptions, images)
+	responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, &modelOptions, images)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -670,10 +678,10 @@ func PerformGeneralRequestSpecificModelModelOptionsAndImages(input string, histo
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// Close the response channel
@@ -684,7 +692,7 @@ func PerformGeneralRequestSpecificModelModelOptionsAndImages(input string, histo
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -697,7 +705,7 @@ func PerformGeneralRequestSpecificModelModelOptionsAndImages(input string, histo
 	}
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model
@@ -707,21 +715,23 @@ func PerformGeneralRequestSpecificModelModelOptionsAndImages(input string, histo
 //   - @displayName: General LLM Request (Specific Models, No Stream, OpenAI Token Output)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
-//   - modelIds: the model IDs of the AI models to use
-//   - tokenCountModelName: the model name to use for token count
+//   - modelIds: the model IDs of the AI models to use, tried in order as fallback chain
+//   - tokenCountModelName: the model name to use for token count, unless the answering model sets its own
 //
 // Returns:
 //   - message: the response message
 //   - tokenCount: the token count
-func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, tokenCountModelName string) (message string, tokenCount int) {
+//   - error: an error if the request to aali-llm fails or the tokens cannot be counted
+func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, tokenCountModelName string) (message string, tokenCount int, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
+	responseChannel, answered := sendChatRequestWithModel(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil)
 	defer close(responseChannel)
 
 	// else Process all responses
@@ -729,7 +739,7 @@ func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(input strin
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", 0, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -741,21 +751,20 @@ func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(input strin
 		}
 	}
 
+	// count the tokens with the token count model of the model that answered
+	tokenCountModelName = answered.tokenCountModelName(tokenCountModelName)
+
 	// get input token count
 	totalTokenCount, err := openAiTokenCount(tokenCountModelName, input+systemPrompt)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting input token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
+		return "", 0, logError(nil, ErrInvalidInput, "Error getting input token count: %v", err)
 	}
 
 	// get history token count
 	for _, message := range history {
 		historyTokenCount, err := openAiTokenCount(tokenCountModelName, message.Content)
 		if err != nil {
-			errorMessage := fmt.Sprintf("Error getting history token count: %v", err)
-			logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-			panic(errorMessage)
+			return "", 0, logError(nil, ErrInvalidInput, "Error getting history token count: %v", err)
 		}
 		totalTokenCount += historyTokenCount
 	}
@@ -763,17 +772,15 @@ func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(input strin
 	// get the output token count
 	outputTokenCount, err := openAiTokenCount(tokenCountModelName, responseAsStr)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting output token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
+		return "", 0, logError(nil, ErrInvalidInput, "Error getting output token count: %v", err)
 	}
 	totalTokenCount += outputTokenCount
 
 	// log token count
-	logging.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
+	redact.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
 
 	// Return the response
-	return responseAsStr, totalTokenCount
+	return responseAsStr, totalTokenCount, nil
 }
 
 // PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model
@@ -783,22 +790,24 @@ func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(input strin
 //   - @displayName: General LLM Request (Specific Models, Model Options, No Stream, OpenAI Token Output)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the user input
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
-//   - modelIds: the model IDs of the AI models to use
+//   - modelIds: the model IDs of the AI models to use, tried in order as fallback chain
 //   - modelOptions: the model options
-//   - tokenCountModelName: the model name to use for token count
+//   - tokenCountModelName: the model name to use for token count, unless the answering model sets its own
 //
 // Returns:
 //   - message: the response message
 //   - tokenCount: the token count
-func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, tokenCountModelName string) (message string, tokenCount int) {
+//   - error: an error if the request to aali-llm fails or the tokens cannot be counted
+func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, tokenCountModelName string) (message string, tokenCount int, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, &modelOptions, nil)
+	responseChannel, answered := sendChatRequestWithModel(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, &modelOptions, nil)
 	defer close(responseChannel)
 
 	// else Process all responses
@@ -806,7 +815,7 @@ func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOut
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", 0, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -818,21 +827,20 @@ func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOut
 		}
 	}
 
+	// count the tokens with the token count model of the model that answered
+	tokenCountModelName = answered.tokenCountModelName(tokenCountModelName)
+
 	// get input token count
 	totalTokenCount, err := openAiTokenCount(tokenCountModelName, input+systemPrompt)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting input token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
+		return "", 0, logError(nil, ErrInvalidInput, "Error getting input token count: %v", err)
 	}
 
 	// get history token count
 	for _, message := range history {
 		historyTokenCount, err := openAiTokenCount(tokenCountModelName, message.Content)
 		if err != nil {
-			errorMessage := fmt.Sprintf("Error getting history token count: %v", err)
-			logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-			panic(errorMessage)
+			return "", 0, logError(nil, ErrInvalidInput, "Error getting history token count: %v", err)
 		}
 		totalTokenCount += historyTokenCount
 	}
@@ -840,17 +848,15 @@ func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOut
 	// get the output token count
 	outputTokenCount, err := openAiTokenCount(tokenCountModelName, responseAsStr)
 	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting output token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
+		return "", 0, logError(nil, ErrInvalidInput, "Error getting output token count: %v", err)
 	}
 	totalTokenCount += outputTokenCount
 
 	// log token count
-	logging.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
+	redact.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
 
 	// Return the response
-	return responseAsStr, totalTokenCount
+	return responseAsStr, totalTokenCount, nil
 }
 
 // PerformCodeLLMRequest performs a code generation request to LLM
@@ -859,6 +865,7 @@ func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOut
 //   - @displayName: Code LLM Request
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string
 //   - history: the conversation history
 //   - isStream: the stream flag
@@ -866,12 +873,13 @@ func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOut
 // Returns:
 //   - message: the generated code
 //   - stream: the stream channel
-func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, validateCode bool) (message string, stream *chan string) {
+//   - error: an error if the request to aali-llm fails
+func PerformCodeLLMRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, validateCode bool) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "code", history, 0, "", llmHandlerEndpoint, nil, nil, nil)
+	responseChannel := sendChatRequest(ctx, input, "code", history, 0, "", llmHandlerEndpoint, nil, nil, nil)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -879,10 +887,10 @@ func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage,
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, validateCode, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, validateCode, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel
-		return "", &streamChannel
+		return "", &streamChannel, nil
 	}
 
 	// Close the response channel
@@ -893,7 +901,7 @@ func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage,
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			return "", nil, responseError(response)
 		}
 
 		// Accumulate the responses
@@ -911,13 +919,13 @@ func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage,
 		// Extract the code from the response
 		pythonCode, err := extractPythonCode(responseAsStr)
 		if err != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error extracting Python code: %v", err)
+			redact.Log.Errorf(&logging.ContextMap{}, "Error extracting Python code: %v", err)
 		} else {
 
 			// Validate the Python code
 			valid, warnings, err := validatePythonCode(pythonCode)
 			if err != nil {
-				logging.Log.Errorf(&logging.ContextMap{}, "Error validating Python code: %v", err)
+				redact.Log.Errorf(&logging.ContextMap{}, "Error validating Python code: %v", err)
 			} else {
 				if valid {
 					if warnings {
@@ -933,7 +941,7 @@ func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage,
 	}
 
 	// Return the response
-	return responseAsStr, nil
+	return responseAsStr, nil, nil
 }
 
 // PerformGeneralRequestNoStreaming performs a general chat completion request to LLM without streaming
@@ -942,21 +950,94 @@ func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage,
 //   - @displayName: General LLM Request (no streaming)
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
 //
 // Returns:
 //   - message: the generated message
-func PerformGeneralRequestNoStreaming(input string, history []sharedtypes.HistoricMessage, systemPrompt string) (message string) {
+//   - error: an error if the request to aali-llm fails
+func PerformGeneralRequestNoStreaming(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string) (message string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseString := sendChatRequestNoStreaming(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil)
+	responseString, err := sendChatRequestNoStreaming(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
 
 	// Return the response
-	return responseString
+	return responseString, nil
+}
+
+// PerformStructuredRequest performs a general request to LLM whose answer is JSON matching a JSON Schema
+// The schema is passed to the models supporting a response format (FLOWKIT_LLM_RESPONSE_FORMAT_MODELS) and
+// described in the system prompt for all others. An answer that is no JSON or does not match the schema is
+// sent back to the model with the validation errors, at most maxRepairs times.
+//
+// Tags:
+//   - @displayName: Structured LLM Request
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - input: the user input
+//   - history: the conversation history
+//   - systemPrompt: the system prompt, the instructions of the JSON answer are appended to it
+//   - schema: the JSON Schema of the answer
+//   - modelIds: the model IDs, tried in order as fallback chain; "chain:<name>" stands for a configured chain
+//   - @optional
+//   - maxRepairs: the number of repair requests for invalid answers
+//   - @default: 2
+//   - @min: 0
+//   - @max: 5
+//
+// Returns:
+//   - result: the parsed JSON answer; the last answer, which may be nil, if no valid answer was received
+//   - valid: true if the result matches the schema
+//   - attempts: the number of requests sent, including the repair requests
+//   - validationErrors: the validation errors of the last answer, empty if it is valid
+//   - error: an error if the schema is invalid or a request fails
+func PerformStructuredRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, schema string, modelIds []string, maxRepairs int) (result interface{}, valid bool, attempts int, validationErrors []string, err error) {
+	if maxRepairs < 0 || maxRepairs > maxStructuredRepairs {
+		return nil, false, 0, nil, logError(nil, ErrInvalidInput, "maxRepairs must be between 0 and %d, got %d", maxStructuredRepairs, maxRepairs)
+	}
+	compiled, err := jsonschema.Compile([]byte(schema))
+	if err != nil {
+		return nil, false, 0, nil, logError(nil, ErrInvalidInput, "invalid JSON Schema: %v", err)
+	}
+	responseFormat, err := structuredResponseFormat(schema)
+	if err != nil {
+		return nil, false, 0, nil, logError(nil, ErrInvalidInput, "invalid JSON Schema: %v", err)
+	}
+
+	// Each repair request adds the invalid answer and the validation errors to the conversation
+	systemPrompt = structuredSystemPrompt(systemPrompt, schema)
+	conversation := append([]sharedtypes.HistoricMessage{}, history...)
+	prompt := input
+	for attempts = 1; ; attempts++ {
+		answer, err := llmHandlerPerformStructuredRequest(ctx, prompt, conversation, systemPrompt, modelIds, responseFormat)
+		if err != nil {
+			return nil, false, attempts, nil, classError(err, ErrUpstreamUnavailable)
+		}
+
+		result, validationErrors = parseStructuredAnswer(compiled, answer)
+		if len(validationErrors) == 0 {
+			return result, true, attempts, validationErrors, nil
+		}
+		if attempts > maxRepairs {
+			redact.Log.Warnf(&logging.ContextMap{}, "No valid structured answer after %d attempts: %v", attempts, strings.Join(validationErrors, "; "))
+			return result, false, attempts, validationErrors, nil
+		}
+
+		redact.Log.Debugf(&logging.ContextMap{}, "Repairing structured answer, attempt %d: %v", attempts, strings.Join(validationErrors, "; "))
+		conversation = append(conversation,
+			sharedtypes.HistoricMessage{Role: "user", Content: prompt},
+			sharedtypes.HistoricMessage{Role: "assistant", Content: answer},
+		)
+		prompt = structuredRepairPrompt(validationErrors)
+	}
 }
 
 // BuildLibraryContext builds the context string for the query
@@ -1098,7 +1179,7 @@ func AppendMessageHistory(newMessage string, role AppendMessageHistoryRole, hist
 	case system:
 	default:
 		errMessage := fmt.Sprintf("Invalid role used for 'AppendMessageHistory': %v", role)
-		logging.Log.Warn(&logging.ContextMap{}, errMessage)
+		redact.Log.Warn(&logging.ContextMap{}, errMessage)
 		panic(errMessage)
 	}
 
@@ -1152,16 +1233,17 @@ func ShortenMessageHistory(history []sharedtypes.HistoricMessage, maxLength int)
 //
 // Returns:
 //   - tokenLimitReached: true if the token limit is reached, false otherwise
-func CheckTokenLimitReached(query string, tokenLimit int, modelName string, tokenLimitMessage string) (tokenLimitReached bool, responseMessage string) {
+//   - error: an error if the tokens cannot be counted with the model
+func CheckTokenLimitReached(query string, tokenLimit int, modelName string, tokenLimitMessage string) (tokenLimitReached bool, responseMessage string, err error) {
 	// Check if the query exceeds the token limit
 	tokenCount, err := openAiTokenCount(modelName, query)
 	if err != nil {
-		panic(fmt.Sprintf("Error counting tokens: %v", err))
+		return false, "", logError(nil, ErrInvalidInput, "Error counting tokens: %v", err)
 	}
 	if tokenCount > tokenLimit {
-		logging.Log.Warnf(&logging.ContextMap{}, "Query exceeds token limit: %d tokens, limit is %d tokens", tokenCount, tokenLimit)
-		return true, tokenLimitMessage
+		redact.Log.Warnf(&logging.ContextMap{}, "Query exceeds token limit: %d tokens, limit is %d tokens", tokenCount, tokenLimit)
+		return true, tokenLimitMessage, nil
 	}
 
-	return false, ""
+	return false, "", nil
 }
@@ -0,0 +1,508 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/llmmock"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveMockLLM starts a mock aali-llm replying with the given fixtures and points the LLM handler endpoint to it
+func serveMockLLM(t *testing.T, fixtures *llmmock.Fixtures) *llmmock.Server {
+	mock := llmmock.NewServer(fixtures)
+	server := httptest.NewServer(mock)
+	t.Cleanup(server.Close)
+	t.Cleanup(CloseLLMClients)
+	t.Cleanup(resetModelChains)
+
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	config.GlobalConfig = &config.Config{
+		LLM_HANDLER_ENDPOINT: "ws" + strings.TrimPrefix(server.URL, "http"),
+		LLM_API_KEY:          fixtures.APIKey,
+		WORKFLOW_CONFIG_VARIABLES: map[string]string{
+			"FLOWKIT_LLM_RETRY_INITIAL_BACKOFF": "1ms",
+			"FLOWKIT_LLM_RETRY_MAX_BACKOFF":     "5ms",
+		},
+	}
+	return mock
+}
+
+// serveLLMFixtures starts a mock aali-llm replying with the fixtures of testdata/llm_fixtures.yaml
+func serveLLMFixtures(t *testing.T) *llmmock.Server {
+	fixtures, err := llmmock.LoadFixtures("testdata/llm_fixtures.yaml")
+	require.NoError(t, err)
+	return serveMockLLM(t, fixtures)
+}
+
+// readStream returns the concatenated messages of a stream channel once it is closed
+func readStream(t *testing.T, stream *chan string) string {
+	require.NotNil(t, stream)
+	message := ""
+	for chunk := range *stream {
+		message += chunk
+	}
+	return message
+}
+
+// tokenCount returns the sum of the token counts of the texts with the given token count model
+func tokenCount(t *testing.T, model string, texts ...string) int {
+	count := 0
+	for _, text := range texts {
+		textCount, err := openAiTokenCount(model, text)
+		require.NoError(t, err)
+		count += textCount
+	}
+	return count
+}
+
+func TestPerformVectorEmbeddingRequest(t *testing.T) {
+	serveLLMFixtures(t)
+
+	embedding, err := PerformVectorEmbeddingRequest(context.Background(), "mesh")
+	require.NoError(t, err)
+	assert.Len(t, embedding, 4)
+	again, err := PerformVectorEmbeddingRequest(context.Background(), "mesh")
+	require.NoError(t, err)
+	assert.Equal(t, embedding, again)
+}
+
+func TestPerformBatchEmbeddingRequest(t *testing.T) {
+	serveLLMFixtures(t)
+
+	embeddings, err := PerformBatchEmbeddingRequest(context.Background(), []string{"mesh", "solver", "mesh"})
+	require.NoError(t, err)
+	require.Len(t, embeddings, 3)
+	assert.Len(t, embeddings[0], 4)
+	assert.Equal(t, embeddings[0], embeddings[2])
+	assert.NotEqual(t, embeddings[0], embeddings[1])
+}
+
+func TestPerformBatchHybridEmbeddingRequest(t *testing.T) {
+	mock := serveLLMFixtures(t)
+
+	dense, sparse, err := PerformBatchHybridEmbeddingRequest(context.Background(), []string{"mesh", "solver", "mesh solver mesh"}, 2)
+	require.NoError(t, err)
+	require.Len(t, dense, 3)
+	require.Len(t, sparse, 3)
+	assert.Len(t, dense[2], 4)
+	assert.Len(t, sparse[0], 1)
+	assert.Len(t, sparse[2], 2)
+
+	// the inputs are sent in batches asking for the sparse embeddings
+	requests := mock.Requests()
+	require.Len(t, requests, 2)
+	assert.True(t, *requests[0].EmbeddingOptions.ReturnSparse)
+}
+
+func TestLLMRequests(t *testing.T) {
+	history := []sharedtypes.HistoricMessage{{Role: "user", Content: "Hi"}}
+
+	tests := []struct {
+		name    string
+		request func(ctx context.Context) (interface{}, error)
+		want    interface{}
+		// check checks the request received by the mock, if set
+		check func(t *testing.T, request sharedtypes.HandlerRequest)
+	}{
+		{
+			name: "embedding with token limit catch",
+			request: func(ctx context.Context) (interface{}, error) {
+				embedding, tokenLimitReached, message, err := PerformVectorEmbeddingRequestWithTokenLimitCatch(ctx, "mesh", "too many tokens")
+				return []interface{}{len(embedding), tokenLimitReached, message}, err
+			},
+			want: []interface{}{4, false, ""},
+		},
+		{
+			name: "embedding over the token limit",
+			request: func(ctx context.Context) (interface{}, error) {
+				embedding, tokenLimitReached, message, err := PerformVectorEmbeddingRequestWithTokenLimitCatch(ctx, "far too long", "too many tokens")
+				return []interface{}{embedding, tokenLimitReached, message}, err
+			},
+			want: []interface{}{[]float32(nil), true, "too many tokens"},
+		},
+		{
+			name: "keywords",
+			request: func(ctx context.Context) (interface{}, error) {
+				return PerformKeywordExtractionRequest(ctx, "How do I mesh a solver domain?", 3)
+			},
+			want: []string{"mesh", "solver", "boundary"},
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.EqualValues(t, 3, request.MaxNumberOfKeywords)
+			},
+		},
+		{
+			name: "summary",
+			request: func(ctx context.Context) (interface{}, error) {
+				return PerformSummaryRequest(ctx, "A long text.")
+			},
+			want: "A short summary.",
+		},
+		{
+			name: "general without streaming",
+			request: func(ctx context.Context) (interface{}, error) {
+				return PerformGeneralRequestNoStreaming(ctx, "What is a mesh?", nil, "")
+			},
+			want: "What is a mesh?",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.False(t, request.DataStream)
+			},
+		},
+		{
+			name: "specific model with token output",
+			request: func(ctx context.Context) (interface{}, error) {
+				message, count, err := PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(ctx, "What is a mesh?", nil, "", []string{"gpt-4o-mini"}, "gpt-4o")
+				return []interface{}{message, count}, err
+			},
+			want: []interface{}{"Answer of the mini model.", tokenCount(t, "gpt-4o", "What is a mesh?", "Answer of the mini model.")},
+		},
+		{
+			name: "model options with token output",
+			request: func(ctx context.Context) (interface{}, error) {
+				message, count, err := PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(ctx, "What is a mesh?", history, "Be brief.", nil, sharedtypes.ModelOptions{}, "gpt-4")
+				return []interface{}{message, count}, err
+			},
+			// the input, system prompt, history and answer are counted
+			want: []interface{}{"What is a mesh?", tokenCount(t, "gpt-4", "What is a mesh?Be brief.", "Hi", "What is a mesh?")},
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, history, request.ConversationHistory)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := serveLLMFixtures(t)
+
+			got, err := test.request(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+			if test.check != nil {
+				requests := mock.Requests()
+				require.Len(t, requests, 1)
+				test.check(t, requests[0])
+			}
+		})
+	}
+}
+
+func TestGeneralLLMRequests(t *testing.T) {
+	history := []sharedtypes.HistoricMessage{{Role: "user", Content: "Hi"}, {Role: "assistant", Content: "Hello"}}
+	images := []string{"aW1hZ2U="}
+	temperature := float32(0.2)
+	options := sharedtypes.ModelOptions{Temperature: &temperature}
+
+	tests := []struct {
+		name string
+		// request sends the request once without and once with streaming
+		request func(ctx context.Context, isStream bool) (string, *chan string, error)
+		want    string
+		// check checks both requests received by the mock, if set
+		check func(t *testing.T, request sharedtypes.HandlerRequest)
+	}{
+		{
+			name: "general",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformGeneralRequest(ctx, "What is a mesh?", history, isStream, "Be brief.")
+			},
+			want: "What is a mesh?",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, "general", request.ChatRequestType)
+				assert.Equal(t, "Be brief.", request.SystemPrompt)
+				assert.True(t, request.IsConversation)
+				assert.Equal(t, history, request.ConversationHistory)
+			},
+		},
+		{
+			name: "images",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformGeneralRequestWithImages(ctx, "Describe the image.", nil, isStream, "", images)
+			},
+			want: "Describe the image.",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, images, request.Images)
+			},
+		},
+		{
+			name: "model specification",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformGeneralModelSpecificationRequest(ctx, "What is a mesh?", nil, isStream, map[string]string{"gpt-4o-mini": "Be brief."}, []string{"gpt-4o-mini"})
+			},
+			want: "Answer of the mini model.",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, []string{"gpt-4o-mini"}, request.ModelIds)
+			},
+		},
+		{
+			name: "specific model",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformGeneralRequestSpecificModel(ctx, "What is a mesh?", nil, isStream, "", []string{"gpt-4o-mini"})
+			},
+			want: "Answer of the mini model.",
+		},
+		{
+			// other models are answered by the echo fixture
+			name: "specific model without fixture",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformGeneralRequestSpecificModel(ctx, "What is a mesh?", nil, isStream, "", []string{"gpt-4o"})
+			},
+			want: "What is a mesh?",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, []string{"gpt-4o"}, request.ModelIds)
+			},
+		},
+		{
+			name: "specific model and model options",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformGeneralRequestSpecificModelAndModelOptions(ctx, "What is a mesh?", nil, isStream, "", []string{"gpt-4o-mini"}, options)
+			},
+			want: "Answer of the mini model.",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, options, request.ModelOptions)
+			},
+		},
+		{
+			name: "specific model, model options and images",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformGeneralRequestSpecificModelModelOptionsAndImages(ctx, "Describe the image.", nil, isStream, "", []string{"gpt-4o"}, sharedtypes.ModelOptions{}, images)
+			},
+			want: "Describe the image.",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, images, request.Images)
+			},
+		},
+		{
+			name: "code",
+			request: func(ctx context.Context, isStream bool) (string, *chan string, error) {
+				return PerformCodeLLMRequest(ctx, "Print hello.", nil, isStream, false)
+			},
+			want: "```python\nprint('hello')\n```",
+			check: func(t *testing.T, request sharedtypes.HandlerRequest) {
+				assert.Equal(t, "code", request.ChatRequestType)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := serveLLMFixtures(t)
+
+			message, stream, err := test.request(context.Background(), false)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, message)
+			assert.Nil(t, stream)
+
+			// streamed answers arrive in chunks
+			_, stream, err = test.request(context.Background(), true)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, readStream(t, stream))
+			assert.NoError(t, StreamError(stream))
+
+			requests := mock.Requests()
+			require.Len(t, requests, 2)
+			assert.True(t, requests[1].DataStream)
+			if test.check != nil {
+				for _, request := range requests {
+					test.check(t, request)
+				}
+			}
+		})
+	}
+}
+
+func TestLLMRequestErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		request func(ctx context.Context) error
+		want    error
+	}{
+		{"embedding error", func(ctx context.Context) error {
+			_, err := PerformVectorEmbeddingRequest(ctx, "far too long")
+			return err
+		}, ErrInvalidInput},
+		{"general error", func(ctx context.Context) error {
+			_, _, err := PerformGeneralRequest(ctx, "please fail", nil, false, "")
+			return err
+		}, ErrInvalidInput},
+		{"no streaming error", func(ctx context.Context) error {
+			_, err := PerformGeneralRequestNoStreaming(ctx, "please fail", nil, "")
+			return err
+		}, ErrInvalidInput},
+		{"token output error", func(ctx context.Context) error {
+			_, _, err := PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(ctx, "please fail", nil, "", nil, "gpt-4o")
+			return err
+		}, ErrInvalidInput},
+		// unknown token count models cannot count the tokens
+		{"unknown token count model", func(ctx context.Context) error {
+			_, _, err := PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(ctx, "What is a mesh?", nil, "", nil, sharedtypes.ModelOptions{}, "llama")
+			return err
+		}, ErrInvalidInput},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serveLLMFixtures(t)
+
+			assert.ErrorIs(t, test.request(context.Background()), test.want)
+		})
+	}
+}
+
+func TestResponseError(t *testing.T) {
+	tests := []struct {
+		code int
+		want error
+	}{
+		{400, ErrInvalidInput},
+		{404, ErrNotFound},
+		{429, ErrQuotaExceeded},
+		{500, ErrUpstreamUnavailable},
+	}
+	for _, test := range tests {
+		response := sharedtypes.HandlerResponse{Type: "error", Error: &sharedtypes.ErrorResponse{Code: test.code, Message: "failed"}}
+		err := responseError(response)
+		assert.ErrorIs(t, err, test.want, "code %d", test.code)
+		assert.ErrorContains(t, err, "failed")
+	}
+	assert.ErrorIs(t, responseError(sharedtypes.HandlerResponse{Type: "error"}), ErrUpstreamUnavailable)
+}
+
+func TestPerformGeneralRequestStreamError(t *testing.T) {
+	serveLLMFixtures(t)
+
+	// error responses end the stream with an error
+	_, stream, err := PerformGeneralRequest(context.Background(), "please fail", nil, true, "")
+	require.NoError(t, err)
+	assert.Empty(t, readStream(t, stream))
+	err = StreamError(stream)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.ErrorContains(t, err, "invalid request")
+	assert.NoError(t, StreamError(stream))
+}
+
+// structuredSchema is the schema of the structured request tests
+const structuredSchema = `{
+	"type": "object",
+	"properties": {"name": {"type": "string"}, "confidence": {"type": "number", "maximum": 1}},
+	"required": ["name", "confidence"]
+}`
+
+func TestPerformStructuredRequest(t *testing.T) {
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{
+		{Chat: "```json\n{\"name\": \"Density\", \"confidence\": 0.9}\n```"},
+	}})
+
+	result, valid, attempts, validationErrors, err := PerformStructuredRequest(context.Background(), "Which attribute?", nil, "Be brief.", structuredSchema, nil, 2)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, 1, attempts)
+	assert.Empty(t, validationErrors)
+	assert.Equal(t, map[string]interface{}{"name": "Density", "confidence": 0.9}, result)
+
+	// the schema is described in the system prompt, no response format is sent unless configured
+	request := mock.Requests()[0]
+	assert.Contains(t, request.SystemPrompt, "Be brief.")
+	assert.Contains(t, request.SystemPrompt, `"required": ["name", "confidence"]`)
+	assert.False(t, request.DataStream)
+	assert.Nil(t, request.ModelOptions.ResponseFormat)
+}
+
+func TestPerformStructuredRequestRepairs(t *testing.T) {
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{
+		{Times: 1, Chat: `{"name": "Density", "confidence": 1.5}`},
+		{Chat: `{"name": "Density", "confidence": 0.5}`},
+	}})
+
+	result, valid, attempts, _, err := PerformStructuredRequest(context.Background(), "Which attribute?", nil, "", structuredSchema, nil, 2)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, map[string]interface{}{"name": "Density", "confidence": 0.5}, result)
+
+	// the repair request continues the conversation with the validation errors
+	repair := mock.Requests()[1]
+	assert.Contains(t, repair.Data, "/confidence: number must be at most 1")
+	assert.Equal(t, []sharedtypes.HistoricMessage{
+		{Role: "user", Content: "Which attribute?"},
+		{Role: "assistant", Content: `{"name": "Density", "confidence": 1.5}`},
+	}, repair.ConversationHistory)
+}
+
+func TestPerformStructuredRequestInvalidAnswer(t *testing.T) {
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{{Chat: "The attribute is density."}}})
+
+	// the last answer is returned with its validation errors once the repairs are used up
+	result, valid, attempts, validationErrors, err := PerformStructuredRequest(context.Background(), "Which attribute?", nil, "", structuredSchema, nil, 1)
+	require.NoError(t, err)
+	assert.False(t, valid)
+	assert.Nil(t, result)
+	assert.Equal(t, 2, attempts)
+	require.Len(t, validationErrors, 1)
+	assert.Contains(t, validationErrors[0], "the answer is not valid JSON")
+	assert.Len(t, mock.Requests(), 2)
+}
+
+func TestPerformStructuredRequestResponseFormat(t *testing.T) {
+	withFormat := true
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{
+		{Match: llmmock.Match{ResponseFormat: &withFormat}, Chat: `{"name": "Density", "confidence": 0.5}`},
+		{Chat: "no JSON"},
+	}})
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_RESPONSE_FORMAT_MODELS"] = "gpt-4o, gpt-4o-mini"
+
+	// the response format is only sent to the models supporting it
+	_, valid, _, _, err := PerformStructuredRequest(context.Background(), "Which attribute?", nil, "", structuredSchema, []string{"gpt-4o"}, 0)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	_, valid, _, _, err = PerformStructuredRequest(context.Background(), "Which attribute?", nil, "", structuredSchema, []string{"llama"}, 0)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// the schema is sent in the model options
+	requests := mock.Requests()
+	require.Len(t, requests, 2)
+	schema := requests[0].ModelOptions.ResponseFormat["json_schema"].(map[string]interface{})["schema"]
+	assert.Equal(t, []interface{}{"name", "confidence"}, schema.(map[string]interface{})["required"])
+	assert.Nil(t, requests[1].ModelOptions.ResponseFormat)
+}
+
+func TestPerformStructuredRequestErrors(t *testing.T) {
+	serveLLMFixtures(t)
+
+	_, _, _, _, err := PerformStructuredRequest(context.Background(), "Which attribute?", nil, "", `{"type": "float"}`, nil, 2)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	_, _, _, _, err = PerformStructuredRequest(context.Background(), "Which attribute?", nil, "", structuredSchema, nil, 10)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, _, attempts, _, err := PerformStructuredRequest(context.Background(), "please fail", nil, "", structuredSchema, nil, 2)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"gopkg.in/yaml.v2"
+)
+
+// modelChainPrefix marks a model ID naming a fallback chain of the model chains file
+const modelChainPrefix = "chain:"
+
+// defaultModelChain is the chain used by the requests without model IDs
+const defaultModelChain = "default"
+
+// errFirstResponseTimeout is returned if a model does not start answering within the timeout of its chain
+var errFirstResponseTimeout = errors.New("no response within the fallback timeout")
+
+// modelChainsFile is the file of named fallback chains
+type modelChainsFile struct {
+	Chains map[string][]chainModel `yaml:"chains"`
+}
+
+// chainModel is a model of a fallback chain
+type chainModel struct {
+	// ModelId is the model ID sent to aali-llm, empty to let aali-llm choose
+	ModelId string `yaml:"modelId"`
+	// TokenCountModel is the OpenAI model name used to count the tokens of the answers of the model
+	TokenCountModel string `yaml:"tokenCountModel"`
+	// Timeout is the time the model has to start answering before the next model is tried
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// modelChains holds the chains of the file configured by FLOWKIT_LLM_MODEL_CHAINS_FILE, loaded on first use
+var modelChains struct {
+	once   sync.Once
+	chains map[string][]chainModel
+	err    error
+}
+
+// loadModelChains reads the fallback chains of a model chains file
+//
+// Parameters:
+//   - path: the path of the file
+//
+// Returns:
+//   - map[string][]chainModel: the chains by name
+//   - error: an error if the file cannot be read or a chain is invalid
+func loadModelChains(path string) (map[string][]chainModel, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model chains file: %v", err)
+	}
+
+	var file modelChainsFile
+	err = yaml.UnmarshalStrict(content, &file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse model chains file: %v", err)
+	}
+	for name, chain := range file.Chains {
+		if len(chain) == 0 {
+			return nil, fmt.Errorf("model chain %q is empty", name)
+		}
+		for _, model := range chain {
+			if model.ModelId == "" || strings.HasPrefix(model.ModelId, modelChainPrefix) {
+				return nil, fmt.Errorf("model chain %q must list model IDs, found %q", name, model.ModelId)
+			}
+			if model.Timeout < 0 {
+				return nil, fmt.Errorf("model chain %q has a negative timeout for model %q", name, model.ModelId)
+			}
+		}
+	}
+	return file.Chains, nil
+}
+
+// resolveModelChain returns the models a request tries in order
+// The model IDs are tried one after the other; an ID "chain:<name>" is replaced by the models of the named chain.
+// Without model IDs, the "default" chain is used if configured, otherwise aali-llm chooses the model.
+//
+// Parameters:
+//   - modelIds: the model IDs of the request
+//
+// Returns:
+//   - []chainModel: the models, at least one
+//   - error: an error if a chain is unknown or the model chains file is invalid
+func resolveModelChain(modelIds []string) ([]chainModel, error) {
+	modelChains.once.Do(func() {
+		path := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_MODEL_CHAINS_FILE"]
+		if path != "" {
+			modelChains.chains, modelChains.err = loadModelChains(path)
+		}
+	})
+	if modelChains.err != nil {
+		return nil, modelChains.err
+	}
+
+	timeout, err := fallbackTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(modelIds) == 0 {
+		if _, ok := modelChains.chains[defaultModelChain]; !ok {
+			return []chainModel{{}}, nil
+		}
+		modelIds = []string{modelChainPrefix + defaultModelChain}
+	}
+
+	chain := []chainModel{}
+	for _, modelId := range modelIds {
+		name, isChain := strings.CutPrefix(modelId, modelChainPrefix)
+		if !isChain {
+			chain = append(chain, chainModel{ModelId: modelId, Timeout: timeout})
+			continue
+		}
+		models, ok := modelChains.chains[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown model chain %q", name)
+		}
+		for _, model := range models {
+			if model.Timeout == 0 {
+				model.Timeout = timeout
+			}
+			chain = append(chain, model)
+		}
+	}
+	return chain, nil
+}
+
+// fallbackTimeout returns the time a model of a chain has to start answering, FLOWKIT_LLM_FALLBACK_TIMEOUT
+//
+// Returns:
+//   - time.Duration: the timeout, 0 if the models are not timed out
+//   - error: an error if the variable is not a valid duration
+func fallbackTimeout() (time.Duration, error) {
+	value := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_FALLBACK_TIMEOUT"]
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid FLOWKIT_LLM_FALLBACK_TIMEOUT %q, expected a positive duration", value)
+	}
+	return timeout, nil
+}
+
+// shouldFallBack reports whether a failed request is sent to the next model of its chain
+// Requests fall back on retryable errors, on first response timeouts and on the codes of FLOWKIT_LLM_FALLBACK_CODES.
+//
+// Parameters:
+//   - err: the failure of the request
+//   - retryable: whether the failure is retryable on the same model
+//
+// Returns:
+//   - bool: true if the next model is tried
+func shouldFallBack(err error, retryable bool) bool {
+	if retryable || errors.Is(err, errFirstResponseTimeout) {
+		return true
+	}
+	var responseErr *llmResponseError
+	if !errors.As(err, &responseErr) {
+		return false
+	}
+	for _, field := range strings.Split(config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_FALLBACK_CODES"], ",") {
+		code, err := strconv.Atoi(strings.TrimSpace(field))
+		if err == nil && code == responseErr.code {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenCountModelName returns the OpenAI model name counting the tokens of the answers of the model
+//
+// Parameters:
+//   - defaultName: the name used if the model has no token count model
+//
+// Returns:
+//   - string: the token count model name
+func (m *chainModel) tokenCountModelName(defaultName string) string {
+	if m == nil || m.TokenCountModel == "" {
+		return defaultName
+	}
+	return m.TokenCountModel
+}
+
+// llmResponseError is an error response of aali-llm
+type llmResponseError struct {
+	guid    string
+	code    int
+	message string
+}
+
+// Error returns the message of the error response
+func (e *llmResponseError) Error() string {
+	return fmt.Sprintf("error in request %v: %v (%v)", e.guid, e.code, e.message)
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resetModelChains forgets the loaded model chains file
+func resetModelChains() {
+	modelChains.once = sync.Once{}
+	modelChains.chains = nil
+	modelChains.err = nil
+}
+
+// writeModelChains writes a model chains file and configures it
+func writeModelChains(t *testing.T, content string, variables map[string]string) {
+	path := filepath.Join(t.TempDir(), "model_chains.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	variables["FLOWKIT_LLM_MODEL_CHAINS_FILE"] = path
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: variables}
+	resetModelChains()
+	t.Cleanup(resetModelChains)
+}
+
+func TestResolveModelChain(t *testing.T) {
+	writeModelChains(t, `
+chains:
+  default:
+    - modelId: gpt-4o
+      tokenCountModel: gpt-4o
+      timeout: 20s
+    - modelId: gpt-4o-mini
+  small:
+    - modelId: gpt-4o-mini
+`, map[string]string{"FLOWKIT_LLM_FALLBACK_TIMEOUT": "5s"})
+
+	// requests without model IDs use the default chain
+	chain, err := resolveModelChain(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []chainModel{
+		{ModelId: "gpt-4o", TokenCountModel: "gpt-4o", Timeout: 20 * time.Second},
+		{ModelId: "gpt-4o-mini", Timeout: 5 * time.Second},
+	}, chain)
+
+	// named chains are expanded in place
+	chain, err = resolveModelChain([]string{"llama", "chain:small"})
+	require.NoError(t, err)
+	assert.Equal(t, []chainModel{
+		{ModelId: "llama", Timeout: 5 * time.Second},
+		{ModelId: "gpt-4o-mini", Timeout: 5 * time.Second},
+	}, chain)
+
+	_, err = resolveModelChain([]string{"chain:large"})
+	assert.ErrorContains(t, err, "unknown model chain")
+}
+
+func TestResolveModelChainWithoutFile(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{}}
+	resetModelChains()
+	t.Cleanup(resetModelChains)
+
+	// without a default chain aali-llm chooses the model
+	chain, err := resolveModelChain(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []chainModel{{}}, chain)
+
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_FALLBACK_TIMEOUT"] = "soon"
+	_, err = resolveModelChain([]string{"gpt-4o"})
+	assert.ErrorContains(t, err, "invalid FLOWKIT_LLM_FALLBACK_TIMEOUT")
+}
+
+func TestLoadModelChainsInvalid(t *testing.T) {
+	files := map[string]string{
+		"empty chain":      "chains:\n  default: []\n",
+		"nested chain":     "chains:\n  default:\n    - modelId: chain:small\n",
+		"missing model":    "chains:\n  default:\n    - tokenCountModel: gpt-4o\n",
+		"unknown field":    "chains:\n  default:\n    - modelId: gpt-4o\n      model: gpt-4o\n",
+		"negative timeout": "chains:\n  default:\n    - modelId: gpt-4o\n      timeout: -1s\n",
+	}
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			writeModelChains(t, content, map[string]string{})
+			_, err := resolveModelChain(nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestShouldFallBack(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"FLOWKIT_LLM_FALLBACK_CODES": "404"}}
+
+	assert.True(t, shouldFallBack(errors.New("connection lost"), true))
+	assert.True(t, shouldFallBack(errFirstResponseTimeout, false))
+	assert.True(t, shouldFallBack(&llmResponseError{code: 404}, false))
+	assert.False(t, shouldFallBack(&llmResponseError{code: 400}, false))
+	assert.False(t, shouldFallBack(errors.New("canceled"), false))
+}
+
+func TestTokenCountModelName(t *testing.T) {
+	var model *chainModel
+	assert.Equal(t, "gpt-4", model.tokenCountModelName("gpt-4"))
+	assert.Equal(t, "gpt-4", (&chainModel{ModelId: "llama"}).tokenCountModelName("gpt-4"))
+	assert.Equal(t, "gpt-4o", (&chainModel{TokenCountModel: "gpt-4o"}).tokenCountModelName("gpt-4"))
+}
@@ -28,22 +28,25 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"os/exec"
-	"os/signal"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
-	"syscall"
 	"time"
 
+	"github.com/ansys/aali-flowkit/pkg/llmclient"
+	"github.com/ansys/aali-flowkit/pkg/metrics"
 	"github.com/ansys/aali-flowkit/pkg/privatefunctions/codegeneration"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
@@ -60,6 +63,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
@@ -69,6 +73,7 @@ import (
 //   - responseChannel: the response channel
 //   - streamChannel: the stream channel
 //   - validateCode: the flag to indicate whether the code should be validated
+//   - answered: the model of the fallback chain that answered, whose token count model is used if set; may be nil
 func transferDatafromResponseToStreamChannel(
 	responseChannel *chan sharedtypes.HandlerResponse,
 	streamChannel *chan string,
@@ -81,26 +86,29 @@ func transferDatafromResponseToStreamChannel(
 	jwtToken string,
 	userEmail string,
 	sendContex bool,
-	contex string) {
+	contex string,
+	answered *chainModel) {
+	// Close the stream channel with the error the stream ended with
+	var streamErr error
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic in transferDatafromResponseToStreamChannel: %v\n", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic in transferDatafromResponseToStreamChannel: %v\n", r)
+			streamErr = fmt.Errorf("panic while streaming the response: %v", r)
 		}
+		CloseStream(*streamChannel, streamErr)
 	}()
 
-	// Defer the closing of the channels
+	// Defer the closing of the response channel
 	defer close(*responseChannel)
-	defer close(*streamChannel)
 
 	// Loop through the response channel
 	responseAsStr := ""
 	for response := range *responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error in request %v: %v\n", response.InstructionGuid, response.Error.Message)
-			// send the error message to the stream channel and exit function
-			*streamChannel <- fmt.Sprintf("$&$error$&$:$&$%v$&$", response.Error.Message)
+			// end the stream with the error and exit function
+			streamErr = responseError(response)
 			return
 		}
 
@@ -117,12 +125,12 @@ func transferDatafromResponseToStreamChannel(
 			// check for token count
 			if sendTokenCount {
 
-				// get the output token count
-				outputTokenCount, err := openAiTokenCount(tokenCountModelName, responseAsStr)
+				// get the output token count with the token count model of the model that answered
+				outputTokenCount, err := openAiTokenCount(answered.tokenCountModelName(tokenCountModelName), responseAsStr)
 				if err != nil {
-					logging.Log.Errorf(&logging.ContextMap{}, "Error getting token count: %v\n", err)
-					// send the error message to the stream channel and exit function
-					*streamChannel <- fmt.Sprintf("$&$error$&$:$&$Error getting token count: %v$&$", err)
+					redact.Log.Errorf(&logging.ContextMap{}, "Error getting token count: %v\n", err)
+					// end the stream with the error once the final message is sent
+					streamErr = fmt.Errorf("error getting token count: %w", err)
 				}
 
 				// calculate the total token count
@@ -132,13 +140,20 @@ func transferDatafromResponseToStreamChannel(
 				// send the token count to the token count endpoint
 				err = sendTokenCountToEndpoint(jwtToken, tokenCountEndpoint, totalInputTokenCount, totalOuputTokenCount)
 				if err != nil {
-					logging.Log.Errorf(&logging.ContextMap{}, "Error sending token count: %v\n", err)
-					// send the error message to the stream channel and exit function
-					*streamChannel <- fmt.Sprintf("$&$error$&$:$&$Error in updating token count: %v$&$", err)
+					redact.Log.Errorf(&logging.ContextMap{}, "Error sending token count: %v\n", err)
+					// end the stream with the error once the final message is sent
+					if streamErr == nil {
+						streamErr = fmt.Errorf("%w: error in updating token count: %w", ErrUpstreamUnavailable, err)
+					}
 				} else {
 					// append the token count message to the final message
 					finalMessage += fmt.Sprintf("$&$input_token_count$&$:$&$%d$&$;$&$output_token_count$&$:$&$%d$&$;", totalInputTokenCount, totalOuputTokenCount)
 				}
+
+				// report the model of the fallback chain the tokens were counted for
+				if answered != nil && answered.ModelId != "" {
+					finalMessage += fmt.Sprintf("$&$model$&$:$&$%s$&$;", answered.ModelId)
+				}
 			}
 
 			// check for contex
@@ -152,13 +167,13 @@ func transferDatafromResponseToStreamChannel(
 				// Extract the code from the response
 				pythonCode, err := extractPythonCode(responseAsStr)
 				if err != nil {
-					logging.Log.Errorf(&logging.ContextMap{}, "Error extracting Python code: %v\n", err)
+					redact.Log.Errorf(&logging.ContextMap{}, "Error extracting Python code: %v\n", err)
 				} else {
 
 					// Validate the Python code
 					valid, warnings, err := validatePythonCode(pythonCode)
 					if err != nil {
-						logging.Log.Errorf(&logging.ContextMap{}, "Error validating Python code: %v\n", err)
+						redact.Log.Errorf(&logging.ContextMap{}, "Error validating Python code: %v\n", err)
 					} else {
 						if valid {
 							if warnings {
@@ -256,6 +271,7 @@ func sendTokenCountToEndpoint(jwtToken string, tokenCountEndpoint string, inputT
 // sendChatRequestNoHistory sends a chat request to LLM without history
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - data: the input string
 //   - chatRequestType: the chat request type
 //   - maxKeywordsSearch: the maximum number of keywords to search for
@@ -265,41 +281,182 @@ func sendTokenCountToEndpoint(jwtToken string, tokenCountEndpoint string, inputT
 //
 // Returns:
 //   - chan sharedtypes.HandlerResponse: the response channel
-func sendChatRequestNoHistory(data string, chatRequestType string, maxKeywordsSearch uint32, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions) chan sharedtypes.HandlerResponse {
-	return sendChatRequest(data, chatRequestType, nil, maxKeywordsSearch, "", llmHandlerEndpoint, modelIds, options, nil)
+func sendChatRequestNoHistory(ctx context.Context, data string, chatRequestType string, maxKeywordsSearch uint32, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions) chan sharedtypes.HandlerResponse {
+	return sendChatRequest(ctx, data, chatRequestType, nil, maxKeywordsSearch, "", llmHandlerEndpoint, modelIds, options, nil)
+}
+
+// llmClients holds the connection pools to aali-llm, keyed by endpoint
+var llmClients = struct {
+	sync.Mutex
+	pools map[string]*llmclient.Pool
+}{pools: map[string]*llmclient.Pool{}}
+
+// llmClient returns the connection pool to an aali-llm endpoint, creating it on first use
+//
+// Parameters:
+//   - llmHandlerEndpoint: the LLM Handler endpoint
+//
+// Returns:
+//   - *llmclient.Pool: the connection pool
+func llmClient(llmHandlerEndpoint string) *llmclient.Pool {
+	llmClients.Lock()
+	defer llmClients.Unlock()
+
+	pool, ok := llmClients.pools[llmHandlerEndpoint]
+	if !ok {
+		pool = llmclient.NewPool(llmClientOptions(llmHandlerEndpoint))
+		llmClients.pools[llmHandlerEndpoint] = pool
+	}
+	return pool
+}
+
+// llmClientOptions reads the options of the connection pool to aali-llm from the workflow config variables
+// Invalid values are logged and replaced by the defaults of the pool.
+//
+// The pool is configured by:
+//   - FLOWKIT_LLM_CONNECTIONS: the number of connections, 4 by default
+//   - FLOWKIT_LLM_MAX_IN_FLIGHT: the number of unfinished requests per connection, 64 by default
+//   - FLOWKIT_LLM_HEARTBEAT_INTERVAL: the time between the pings of a connection, 30s by default
+//   - FLOWKIT_LLM_RETRY_MAX_ATTEMPTS: the attempts of an idempotent request, 3 by default
+//   - FLOWKIT_LLM_RETRY_INITIAL_BACKOFF: the wait before the first retry, 200ms by default
+//   - FLOWKIT_LLM_RETRY_MAX_BACKOFF: the maximum wait between two attempts, 5s by default
+//   - FLOWKIT_LLM_RETRY_CODES: the retryable error codes of aali-llm, "429,500,502,503,504" by default
+//   - FLOWKIT_LLM_BREAKER_THRESHOLD: the consecutive failures opening the circuit breaker, 5 by default, 0 disables it
+//   - FLOWKIT_LLM_BREAKER_COOLDOWN: the time the open circuit breaker fails requests fast, 30s by default
+//
+// Parameters:
+//   - llmHandlerEndpoint: the LLM Handler endpoint
+//
+// Returns:
+//   - llmclient.Options: the options of the pool
+func llmClientOptions(llmHandlerEndpoint string) llmclient.Options {
+	// Get API key
+	apiKey := config.GlobalConfig.LLM_API_KEY
+
+	// Legacy authentication
+	if apiKey == "" {
+		apiKey = "testkey"
+	}
+
+	options := llmclient.Options{Endpoint: llmHandlerEndpoint, APIKey: apiKey}
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+	for key, value := range map[string]*int{
+		"FLOWKIT_LLM_CONNECTIONS":        &options.Connections,
+		"FLOWKIT_LLM_MAX_IN_FLIGHT":      &options.MaxInFlight,
+		"FLOWKIT_LLM_RETRY_MAX_ATTEMPTS": &options.Retry.MaxAttempts,
+		"FLOWKIT_LLM_BREAKER_THRESHOLD":  &options.BreakerThreshold,
+	} {
+		if variables[key] == "" {
+			continue
+		}
+		number, err := strconv.Atoi(variables[key])
+		if err != nil || number < 0 || (number == 0 && key != "FLOWKIT_LLM_BREAKER_THRESHOLD") {
+			redact.Log.Warnf(&logging.ContextMap{}, "Ignoring invalid %v %q, expected a positive integer.", key, variables[key])
+			continue
+		}
+		// A threshold of 0 disables the circuit breaker
+		if number == 0 {
+			number = -1
+		}
+		*value = number
+	}
+
+	for key, value := range map[string]*time.Duration{
+		"FLOWKIT_LLM_HEARTBEAT_INTERVAL":    &options.HeartbeatInterval,
+		"FLOWKIT_LLM_RETRY_INITIAL_BACKOFF": &options.Retry.InitialBackoff,
+		"FLOWKIT_LLM_RETRY_MAX_BACKOFF":     &options.Retry.MaxBackoff,
+		"FLOWKIT_LLM_BREAKER_COOLDOWN":      &options.BreakerCooldown,
+	} {
+		if variables[key] == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(variables[key])
+		if err != nil || duration == 0 {
+			redact.Log.Warnf(&logging.ContextMap{}, "Ignoring invalid %v %q, expected a duration.", key, variables[key])
+			continue
+		}
+		*value = duration
+	}
+
+	if variables["FLOWKIT_LLM_RETRY_CODES"] != "" {
+		codes := []int{}
+		for _, field := range strings.Split(variables["FLOWKIT_LLM_RETRY_CODES"], ",") {
+			code, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				redact.Log.Warnf(&logging.ContextMap{}, "Ignoring invalid error code %q in FLOWKIT_LLM_RETRY_CODES.", field)
+				continue
+			}
+			codes = append(codes, code)
+		}
+		options.Retry.RetryableCodes = codes
+	}
+	return options
+}
+
+// CloseLLMClients closes the connections to aali-llm
+// The unfinished requests receive an error response.
+func CloseLLMClients() {
+	llmClients.Lock()
+	defer llmClients.Unlock()
+
+	for endpoint, pool := range llmClients.pools {
+		pool.Close()
+		delete(llmClients.pools, endpoint)
+	}
 }
 
 // sendChatRequest sends a chat request to LLM
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - data: the input string
 //   - chatRequestType: the chat request type
 //   - history: the conversation history
 //   - maxKeywordsSearch: the maximum number of keywords to search for
 //   - systemPrompt: the system prompt
 //   - llmHandlerEndpoint: the LLM Handler endpoint
-//   - modelIds: the model IDs
+//   - modelIds: the model IDs, tried in order as fallback chain
 //   - options: the model options
 //
 // Returns:
 //   - chan sharedtypes.HandlerResponse: the response channel
-func sendChatRequest(data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions, images []string) chan sharedtypes.HandlerResponse {
-	// Initiate the channels
-	requestChannelChat := make(chan []byte, 400)
+func sendChatRequest(ctx context.Context, data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions, images []string) chan sharedtypes.HandlerResponse {
+	responseChannel, _ := sendChatRequestWithModel(ctx, data, chatRequestType, history, maxKeywordsSearch, systemPrompt, llmHandlerEndpoint, modelIds, options, images)
+	return responseChannel
+}
+
+// sendChatRequestWithModel sends a chat request to LLM and reports the model of the fallback chain that answered it
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - data: the input string
+//   - chatRequestType: the chat request type
+//   - history: the conversation history
+//   - maxKeywordsSearch: the maximum number of keywords to search for
+//   - systemPrompt: the system prompt
+//   - llmHandlerEndpoint: the LLM Handler endpoint
+//   - modelIds: the model IDs, tried in order as fallback chain
+//   - options: the model options
+//
+// Returns:
+//   - chan sharedtypes.HandlerResponse: the response channel
+//   - *chainModel: the model that answered, set before the first chat response is received
+func sendChatRequestWithModel(ctx context.Context, data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions, images []string) (chan sharedtypes.HandlerResponse, *chainModel) {
 	responseChannel := make(chan sharedtypes.HandlerResponse) // Create a channel for responses
+	answered := &chainModel{}
 
-	c := initializeClient(llmHandlerEndpoint)
-	go shutdownHandler(c)
-	go listener(c, responseChannel, false)
-	go writer(c, requestChannelChat, responseChannel)
-	go sendRequest("chat", data, requestChannelChat, chatRequestType, "true", false, history, maxKeywordsSearch, systemPrompt, responseChannel, modelIds, options, images)
+	// Trace the request; the span is ended by sendRequest
+	ctx, _ = tracing.Start(ctx, "aali-llm.chat")
 
-	return responseChannel // Return the response channel
+	go sendRequest(ctx, llmHandlerEndpoint, "chat", data, chatRequestType, "true", false, history, maxKeywordsSearch, systemPrompt, responseChannel, modelIds, options, images, nil, answered)
+
+	return responseChannel, answered // Return the response channel
 }
 
 // sendChatRequestNoStreaming sends a chat request to LLM without streaming
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - data: the input string
 //   - chatRequestType: the chat request type
 //   - history: the conversation history
@@ -311,33 +468,31 @@ func sendChatRequest(data string, chatRequestType string, history []sharedtypes.
 //
 // Returns:
 //   - string: the response
-func sendChatRequestNoStreaming(data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt string, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions, images []string) string {
-	// Initiate the channels
-	requestChannelChat := make(chan []byte, 400)
+//   - error: an error if aali-llm answers with an error
+func sendChatRequestNoStreaming(ctx context.Context, data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt string, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions, images []string) (string, error) {
 	responseChannel := make(chan sharedtypes.HandlerResponse) // Create a channel for responses
 
-	// Initialize the client, handlers and send the request
-	c := initializeClient(llmHandlerEndpoint)
-	go shutdownHandler(c)
-	go listener(c, responseChannel, true)
-	go writer(c, requestChannelChat, responseChannel)
-	go sendRequest("chat", data, requestChannelChat, chatRequestType, "false", false, history, maxKeywordsSearch, systemPrompt, responseChannel, modelIds, options, images)
+	// Trace the request; the span is ended by sendRequest
+	ctx, _ = tracing.Start(ctx, "aali-llm.chat")
+
+	// Send the request on the pooled connections
+	go sendRequest(ctx, llmHandlerEndpoint, "chat", data, chatRequestType, "false", false, history, maxKeywordsSearch, systemPrompt, responseChannel, modelIds, options, images, nil, nil)
 
 	// receive single answer from the response channel
 	response := <-responseChannel
 
 	// check for error
 	if response.Type == "error" {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error in request %v: %v\n", response.InstructionGuid, response.Error.Message)
-		panic(response.Error.Message)
+		return "", responseError(response)
 	}
 
-	return *response.ChatData
+	return *response.ChatData, nil
 }
 
 // sendEmbeddingsRequest sends an embeddings request to LLM
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - data: the input string
 //   - llmHandlerEndpoint: the LLM Handler endpoint
 //   - getSparseEmbeddings: the flag to indicate whether to get sparse embeddings
@@ -345,199 +500,41 @@ func sendChatRequestNoStreaming(data string, chatRequestType string, history []s
 //
 // Returns:
 //   - chan sharedtypes.HandlerResponse: the response channel
-func sendEmbeddingsRequest(data interface{}, llmHandlerEndpoint string, getSparseEmbeddings bool, modelIds []string) chan sharedtypes.HandlerResponse {
-	// Initiate the channels
-	requestChannelEmbeddings := make(chan []byte, 400)
+func sendEmbeddingsRequest(ctx context.Context, data interface{}, llmHandlerEndpoint string, getSparseEmbeddings bool, modelIds []string) chan sharedtypes.HandlerResponse {
 	responseChannel := make(chan sharedtypes.HandlerResponse) // Create a channel for responses
 
-	c := initializeClient(llmHandlerEndpoint)
-	go shutdownHandler(c)
-	go listener(c, responseChannel, false)
-	go writer(c, requestChannelEmbeddings, responseChannel)
+	// Trace the request; the span is ended by sendRequest
+	ctx, _ = tracing.Start(ctx, "aali-llm.embeddings")
 
-	go sendRequest("embeddings", data, requestChannelEmbeddings, "", "", getSparseEmbeddings, nil, 0, "", responseChannel, modelIds, nil, nil)
+	go sendRequest(ctx, llmHandlerEndpoint, "embeddings", data, "", "", getSparseEmbeddings, nil, 0, "", responseChannel, modelIds, nil, nil, nil, nil)
 	return responseChannel // Return the response channel
 }
 
-// initializeClient initializes the LLM Handler client
-//
-// Returns:
-//   - *websocket.Conn: the websocket connection
-func initializeClient(llmHandlerEndpoint string) *websocket.Conn {
-	url := llmHandlerEndpoint
-
-	c, _, err := websocket.Dial(context.Background(), url, nil)
-	if err != nil {
-		errMessage := fmt.Sprintf("failed to connect to aali-llm: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
-	}
-	// Disable the read limit
-	c.SetReadLimit(-1)
-
-	// Get API key
-	apiKey := config.GlobalConfig.LLM_API_KEY
-
-	// Legacy authentication
-	if apiKey == "" {
-		apiKey = "testkey"
-	}
-
-	// Send apikey for authentication
-	err = c.Write(context.Background(), websocket.MessageText, []byte(apiKey))
-	if err != nil {
-		errMessage := fmt.Sprintf("failed to send authentication message to aali-llm: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
-	}
-
-	return c
-}
-
-// listener listens for messages from the LLM Handler
-//
-// Parameters:
-//   - c: the websocket connection
-//   - responseChannel: the response channel
-func listener(c *websocket.Conn, responseChannel chan sharedtypes.HandlerResponse, singleRequest bool) {
-
-	// Close the connection when the function returns
-	defer c.Close(websocket.StatusNormalClosure, "")
-
-	// Boolean flag to stop the listener (and close the connection)
-	var stopListener bool
-
-	for {
-		// By default, stop the listener after receiving a message (most of them will be single messages)
-		stopListener = true
-		typ, message, err := c.Read(context.Background())
-		if err != nil {
-			errMessage := fmt.Sprintf("failed to read message from aali-llm: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			response := sharedtypes.HandlerResponse{
-				Type: "error",
-				Error: &sharedtypes.ErrorResponse{
-					Code:    4,
-					Message: errMessage,
-				},
-			}
-			responseChannel <- response
-			return
-		}
-		switch typ {
-		case websocket.MessageText, websocket.MessageBinary:
-			var response sharedtypes.HandlerResponse
-
-			err = json.Unmarshal(message, &response)
-			if err != nil {
-				// Check if it is the authentication message
-				msgAsStr := string(message)
-				if msgAsStr == "authentication successful" {
-					logging.Log.Debugf(&logging.ContextMap{}, "Authentication to LLM was successful.")
-					continue
-				} else {
-					errMessage := fmt.Sprintf("failed to unmarshal message from aali-llm: %v", err)
-					logging.Log.Error(&logging.ContextMap{}, errMessage)
-					response := sharedtypes.HandlerResponse{
-						Type: "error",
-						Error: &sharedtypes.ErrorResponse{
-							Code:    4,
-							Message: errMessage,
-						},
-					}
-					responseChannel <- response
-					return
-				}
-			}
-
-			if response.Type == "error" {
-				errMessage := fmt.Sprintf("error in request %v: %v (%v)\n", response.InstructionGuid, response.Error.Code, response.Error.Message)
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				response := sharedtypes.HandlerResponse{
-					Type: "error",
-					Error: &sharedtypes.ErrorResponse{
-						Code:    4,
-						Message: errMessage,
-					},
-				}
-				responseChannel <- response
-				return
-			} else {
-				switch response.Type {
-				case "chat":
-					if !singleRequest && !*(response.IsLast) {
-						// If it is not the last message, continue listening
-						stopListener = false
-					} else {
-						// If it is the last message, stop listening
-						logging.Log.Debugf(&logging.ContextMap{}, "Chat response completely received from aali-llm.")
-					}
-				case "embeddings":
-					logging.Log.Debugf(&logging.ContextMap{}, "Embeddings received from aali-llm.")
-				case "info":
-					logging.Log.Infof(&logging.ContextMap{}, "Info %v: %v\n", response.InstructionGuid, *response.InfoMessage)
-					stopListener = false
-					continue
-				default:
-					logging.Log.Warn(&logging.ContextMap{}, "Response with unsupported value for 'Type' property received from aali-llm. Ignoring...")
-				}
-				// Send the response to the channel
-				responseChannel <- response
-			}
-		default:
-			logging.Log.Warnf(&logging.ContextMap{}, "Response with unsupported message type '%v'received from aali-llm. Ignoring...\n", typ)
-		}
-
-		// If stopListener is true, stop the listener
-		// This will happen when:
-		// - the chat response is the last one
-		// - the embeddings response is received
-		// - an unsupported adapter type is received
-		if stopListener {
-			logging.Log.Debugf(&logging.ContextMap{}, "Stopping listener for aali-llm request.")
-			return
-		}
-	}
-}
-
-// writer writes messages to the LLM Handler
-//
-// Parameters:
-//   - c: the websocket connection
-//   - RequestChannel: the request channel
-func writer(c *websocket.Conn, RequestChannel chan []byte, responseChannel chan sharedtypes.HandlerResponse) {
-	defer close(RequestChannel)
-	for {
-		requestJSON := <-RequestChannel
-
-		err := c.Write(context.Background(), websocket.MessageBinary, requestJSON)
-		if err != nil {
-			errMessage := fmt.Sprintf("failed to write message to aali-llm: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			response := sharedtypes.HandlerResponse{
-				Type: "error",
-				Error: &sharedtypes.ErrorResponse{
-					Code:    4,
-					Message: errMessage,
-				},
-			}
-			responseChannel <- response
-			return
-		}
-	}
-}
-
-// sendRequest sends a request to LLM
+// sendRequest sends a request to LLM on the pooled connections and forwards its responses
+// The last forwarded response is either the final answer or an error response, also if the request is canceled.
 //
 // Parameters:
+//   - ctx: the context of the request, carrying the span ended once the last response is forwarded
+//   - llmHandlerEndpoint: the LLM Handler endpoint
 //   - adapter: the adapter type. Types: "chat", "embeddings"
 //   - data: the input string
-//   - RequestChannel: the request channel
 //   - chatRequestType: the chat request type. Types: "summary", "code", "keywords"
 //   - dataStream: the data stream flag
 //   - history: the conversation history
-//   - sc: the session context
-func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, chatRequestType string, dataStream string, getSparseEmbeddings bool, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, responseChannel chan sharedtypes.HandlerResponse, modelIds []string, options *sharedtypes.ModelOptions, images []string) {
+//   - responseChannel: the channel the responses are forwarded to
+//   - modelIds: the model IDs; chat requests try them in order as fallback chain
+//   - responseFormat: the response format, sent to the models supporting one; may be nil
+//   - answered: set to the model that answered before its first response is forwarded, may be nil
+func sendRequest(ctx context.Context, llmHandlerEndpoint string, adapter string, data interface{}, chatRequestType string, dataStream string, getSparseEmbeddings bool, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, responseChannel chan sharedtypes.HandlerResponse, modelIds []string, options *sharedtypes.ModelOptions, images []string, responseFormat map[string]interface{}, answered *chainModel) {
+	// Record the duration of the request and end its span once the last response is forwarded
+	start := time.Now()
+	operation := "request"
+	var requestErr error
+	defer func() {
+		metrics.ObserveClientRequest(ctx, "aali-llm", operation, start, requestErr)
+		tracing.End(trace.SpanFromContext(ctx), requestErr)
+	}()
+
 	request := sharedtypes.HandlerRequest{
 		Adapter:         adapter,
 		InstructionGuid: strings.Replace(uuid.New().String(), "-", "", -1),
@@ -548,8 +545,25 @@ func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, c
 		},
 	}
 
-	// check for modelId
-	if len(modelIds) > 0 {
+	// Chat requests fall back from one model to the next, other requests pass the model IDs as they are
+	chain := []chainModel{{}}
+	if adapter == "chat" {
+		var err error
+		chain, err = resolveModelChain(modelIds)
+		if err != nil {
+			redact.Log.Error(&logging.ContextMap{}, err.Error())
+			requestErr = err
+			response := sharedtypes.HandlerResponse{
+				Type: "error",
+				Error: &sharedtypes.ErrorResponse{
+					Code:    4,
+					Message: err.Error(),
+				},
+			}
+			responseChannel <- response
+			return
+		}
+	} else if len(modelIds) > 0 {
 		request.ModelIds = modelIds
 	}
 
@@ -563,7 +577,8 @@ func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, c
 	if adapter == "chat" {
 		if chatRequestType == "" {
 			errMessage := "Property 'ChatRequestType' is required for 'Adapter' type 'chat' requests to aali-llm."
-			logging.Log.Warn(&logging.ContextMap{}, errMessage)
+			redact.Log.Warn(&logging.ContextMap{}, errMessage)
+			requestErr = errors.New(errMessage)
 			response := sharedtypes.HandlerResponse{
 				Type: "error",
 				Error: &sharedtypes.ErrorResponse{
@@ -578,7 +593,8 @@ func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, c
 
 		if dataStream == "" {
 			errMessage := "Property 'DataStream' is required for for 'Adapter' type 'chat' requests to aali-llm."
-			logging.Log.Warn(&logging.ContextMap{}, errMessage)
+			redact.Log.Warn(&logging.ContextMap{}, errMessage)
+			requestErr = errors.New(errMessage)
 			response := sharedtypes.HandlerResponse{
 				Type: "error",
 				Error: &sharedtypes.ErrorResponse{
@@ -609,40 +625,162 @@ func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, c
 		}
 	}
 
-	requestJSON, err := json.Marshal(request)
-	if err != nil {
-		errMessage := fmt.Sprintf("failed to marshal request to aali-llm: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		response := sharedtypes.HandlerResponse{
-			Type: "error",
-			Error: &sharedtypes.ErrorResponse{
-				Code:    4,
-				Message: errMessage,
-			},
+	// Retry idempotent requests and fall back to the next model, as long as no response has been forwarded
+	idempotent := adapter == "embeddings" || !request.DataStream || request.ChatRequestType == "summary" || request.ChatRequestType == "keywords"
+	pool := llmClient(llmHandlerEndpoint)
+	policy := pool.RetryPolicy()
+	forwarded := false
+fallback:
+	for i := range chain {
+		model := &chain[i]
+		timeout := time.Duration(0)
+		if model.ModelId != "" {
+			request.ModelIds = []string{model.ModelId}
+		}
+		// The last model is given all the time it needs
+		if i < len(chain)-1 {
+			timeout = model.Timeout
+		}
+		format := map[string]interface{}(nil)
+		if supportsResponseFormat(model.ModelId) {
+			format = responseFormat
+		}
+
+		var retryable bool
+		for attempt := 1; ; attempt++ {
+			retryable, requestErr = forwardLLMResponses(ctx, pool, request, format, responseChannel, timeout, func() {
+				forwarded = true
+				if answered != nil {
+					*answered = *model
+				}
+			}, &operation)
+			if requestErr == nil {
+				return
+			}
+			// A canceled request is neither retried nor falls back, but still ends with an error response
+			if ctx.Err() != nil {
+				requestErr = ctx.Err()
+				break fallback
+			}
+			if !retryable || !idempotent || forwarded || attempt >= policy.MaxAttempts {
+				break
+			}
+
+			backoff := policy.Backoff(attempt)
+			redact.Log.Warnf(&logging.ContextMap{}, "Retrying request %v to aali-llm in %v after attempt %v failed: %v", request.InstructionGuid, backoff, attempt, requestErr)
+			metrics.ObserveClientRetry("aali-llm", adapter)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				requestErr = ctx.Err()
+				break fallback
+			}
+			request.InstructionGuid = strings.Replace(uuid.New().String(), "-", "", -1)
+		}
+
+		if forwarded || i == len(chain)-1 || !shouldFallBack(requestErr, retryable) {
+			break
 		}
-		responseChannel <- response
-		return
+		redact.Log.Warnf(&logging.ContextMap{}, "Model %v failed, falling back to model %v: %v", model.ModelId, chain[i+1].ModelId, requestErr)
+		metrics.ObserveModelFallback(model.ModelId, chain[i+1].ModelId)
+		request.InstructionGuid = strings.Replace(uuid.New().String(), "-", "", -1)
 	}
 
-	RequestChannel <- requestJSON
+	redact.Log.Error(&logging.ContextMap{}, requestErr.Error())
+	response := sharedtypes.HandlerResponse{
+		Type: "error",
+		Error: &sharedtypes.ErrorResponse{
+			Code:    4,
+			Message: requestErr.Error(),
+		},
+	}
+	// Error responses of aali-llm are passed on as they are
+	var responseErr *llmResponseError
+	if errors.As(requestErr, &responseErr) {
+		response.InstructionGuid = responseErr.guid
+		response.Error = &sharedtypes.ErrorResponse{
+			Code:    responseErr.code,
+			Message: responseErr.message,
+		}
+	}
+	// No final response was forwarded, so a consumer still waiting gets the error,
+	// while one that left after the cancellation does not keep the request blocked
+	select {
+	case responseChannel <- response:
+	default:
+		select {
+		case responseChannel <- response:
+		case <-ctx.Done():
+		}
+	}
 }
 
-// shutdownHandler handles the shutdown of the LLM Handler
+// forwardLLMResponses sends a request to LLM and forwards its responses until the last one
+// An error response is not forwarded but returned, so the caller may retry the request.
+// Once the final response is forwarded, the request succeeded even if its context is canceled afterwards.
 //
 // Parameters:
-//   - c: the websocket connection
-//   - RequestChannel: the request channel
-func shutdownHandler(c *websocket.Conn) {
-	signalCh := make(chan os.Signal, 1)
-	signal.Notify(signalCh, syscall.SIGINT)
+//   - ctx: the context of the request
+//   - pool: the connection pool to aali-llm
+//   - request: the request
+//   - responseFormat: the response format of the request, may be nil
+//   - responseChannel: the channel the responses are forwarded to
+//   - timeout: the time to wait for the first response, no limit if 0
+//   - onFirstResponse: called before the first response is forwarded
+//   - operation: set to the type of the forwarded responses
+//
+// Returns:
+//   - bool: true if the failure is worth retrying
+//   - error: the failure of the request, nil if all responses were forwarded
+func forwardLLMResponses(ctx context.Context, pool *llmclient.Pool, request sharedtypes.HandlerRequest, responseFormat map[string]interface{}, responseChannel chan sharedtypes.HandlerResponse, timeout time.Duration, onFirstResponse func(), operation *string) (bool, error) {
+	call, err := pool.SendWithResponseFormat(ctx, request, responseFormat)
+	if err != nil {
+		return llmclient.IsUnavailable(err), fmt.Errorf("failed to send request to aali-llm: %w", err)
+	}
+	defer call.Close()
+
+	var firstResponseTimeout <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		firstResponseTimeout = timer.C
+	}
 
-	sig := <-signalCh
-	logging.Log.Debugf(&logging.ContextMap{}, "Closing client. Received closing signal: %v\n", sig)
+	first := true
+	for {
+		var response sharedtypes.HandlerResponse
+		var ok bool
+		select {
+		case response, ok = <-call.Responses():
+		case <-firstResponseTimeout:
+			return false, fmt.Errorf("model %v: %w (%v)", request.ModelIds, errFirstResponseTimeout, timeout)
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+		if !ok {
+			return false, nil
+		}
 
-	// close connection
-	c.Close(websocket.StatusNormalClosure, "Normal Closure")
+		if response.Type == "error" {
+			return pool.RetryPolicy().Retryable(response), &llmResponseError{guid: response.InstructionGuid, code: response.Error.Code, message: response.Error.Message}
+		}
+		*operation = response.Type
+		if first {
+			first = false
+			firstResponseTimeout = nil
+			onFirstResponse()
+		}
 
-	os.Exit(0)
+		select {
+		case responseChannel <- response:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+		// The consumer may stop reading after the final response, so nothing must follow it
+		if call.Final(response) {
+			return false, nil
+		}
+	}
 }
 
 // createDbArrayFilter creates an array filter for the KnowledgeDB.
@@ -765,7 +903,7 @@ func validatePythonCode(pythonCode string) (bool, bool, error) {
 		// Check for potential warnings in output
 		outputAsStr := string(output)
 		if !strings.Contains(outputAsStr, "0 warnings") {
-			logging.Log.Warn(&logging.ContextMap{}, "Potential errors in Python code...")
+			redact.Log.Warn(&logging.ContextMap{}, "Potential errors in Python code...")
 			return true, true, nil
 		} else {
 			return true, false, nil
@@ -795,6 +933,7 @@ func formatTemplate(template string, data map[string]string) string {
 // ansysGPTACSSemanticHybridSearch performs a semantic hybrid search in ACS
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - query: the query string
 //   - embeddedQuery: the embedded query
 //   - indexName: the index name
@@ -806,7 +945,7 @@ func formatTemplate(template string, data map[string]string) string {
 //
 // Returns:
 //   - output: the search results
-func ansysGPTACSSemanticHybridSearch(
+func ansysGPTACSSemanticHybridSearch(ctx context.Context,
 	acsEndpoint string,
 	acsApiKey string,
 	acsApiVersion string,
@@ -817,13 +956,6 @@ func ansysGPTACSSemanticHybridSearch(
 	topK int,
 	isAis bool,
 	physics []string) (output []sharedtypes.ACSSearchResponse, err error) {
-	defer func() {
-		r := recover()
-		if r != nil {
-			err = fmt.Errorf("panic occured in ansysGPTACSSemanticHybridSearch: %v", r)
-		}
-	}()
-
 	// Create the URL
 	url := fmt.Sprintf("https://%s.search.windows.net/indexes/%s/docs/search?api-version=%s", acsEndpoint, indexName, acsApiVersion)
 
@@ -877,10 +1009,13 @@ func ansysGPTACSSemanticHybridSearch(
 
 	// join filter data
 	filterQuery := strings.Join(filterData, " or ")
-	logging.Log.Debugf(&logging.ContextMap{}, "filter_data is : %s\n", filterQuery)
+	redact.Log.Debugf(&logging.ContextMap{}, "filter_data is : %s\n", filterQuery)
 
 	// Get the searchedEmbeddedFields and returnedProperties
-	searchedEmbeddedFields, returnedProperties := getFieldsAndReturnProperties(indexName)
+	searchedEmbeddedFields, returnedProperties, err := getFieldsAndReturnProperties(indexName)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create the search request payload
 	searchRequest := ACSSearchRequest{
@@ -906,15 +1041,15 @@ func ansysGPTACSSemanticHybridSearch(
 	requestBody, err := json.Marshal(searchRequest)
 	if err != nil {
 		errMessage := fmt.Errorf("failed to marshal search request to ACS: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return nil, errMessage
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		errMessage := fmt.Errorf("failed to create POST request for ACS: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return nil, errMessage
 	}
 
@@ -922,11 +1057,11 @@ func ansysGPTACSSemanticHybridSearch(
 	req.Header.Set("api-key", acsApiKey)
 
 	// Execute the request
-	client := &http.Client{}
+	client := &http.Client{Transport: tracing.NewTransport(nil)}
 	resp, err := client.Do(req)
 	if err != nil {
 		errMessage := fmt.Errorf("failed to send POST request to ACS: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return nil, errMessage
 	}
 	defer resp.Body.Close()
@@ -935,21 +1070,24 @@ func ansysGPTACSSemanticHybridSearch(
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		errMessage := fmt.Errorf("failed to read response body from ACS: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return nil, errMessage
 	}
 
 	// check if the reponse is an error
 	if resp.StatusCode != 200 {
 		errMessage := fmt.Errorf("error in ACS semantic hybrid search for index %v: %s", indexName, string(body))
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return nil, errMessage
 	}
 
 	// extract and convert the response
-	output = extractAndConvertACSResponse(body, indexName)
+	output, err = extractAndConvertACSResponse(body, indexName)
+	if err != nil {
+		return nil, err
+	}
 	for _, item := range output {
-		logging.Log.Debugf(&logging.ContextMap{}, "ACS topic returned for index %v: %v\n", indexName, item.SourceTitleLvl2)
+		redact.Log.Debugf(&logging.ContextMap{}, "ACS topic returned for index %v: %v\n", indexName, item.SourceTitleLvl2)
 	}
 
 	// assign index name to the output
@@ -968,7 +1106,8 @@ func ansysGPTACSSemanticHybridSearch(
 // Returns:
 //   - searchedEmbeddedFields: the ACS fields to be searched
 //   - returnedProperties: the properties to be returned
-func getFieldsAndReturnProperties(indexName string) (searchedEmbeddedFields string, returnedProperties string) {
+//   - err: an error if the index is unknown
+func getFieldsAndReturnProperties(indexName string) (searchedEmbeddedFields string, returnedProperties string, err error) {
 	switch indexName {
 	case "granular-ansysgpt", "ansysgpt-documentation-2023r2", "ansys-dot-com-marketing", "ibp-app-brief":
 		searchedEmbeddedFields = "content_vctr, sourceTitle_lvl1_vctr, sourceTitle_lvl2_vctr, sourceTitle_lvl3_vctr"
@@ -998,11 +1137,9 @@ func getFieldsAndReturnProperties(indexName string) (searchedEmbeddedFields stri
 		searchedEmbeddedFields = "content_vctr, sourceTitle_lvl1_vctr, sourceTitle_lvl2_vctr, sourceTitle_lvl3_vctr"
 		returnedProperties = "token_size, physics, typeOFasset, product, index_connection_id, version, weight, content, sourceTitle_lvl2, sourceURL_lvl2, sourceTitle_lvl3, sourceURL_lvl3"
 	default:
-		errMessage := fmt.Sprintf("Index name not found: %s", indexName)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return "", "", logError(nil, ErrNotFound, "Index name not found: %s", indexName)
 	}
-	return searchedEmbeddedFields, returnedProperties
+	return searchedEmbeddedFields, returnedProperties, nil
 }
 
 // extractAndConvertACSResponse extracts and converts the ACS response to ACSSearchResponse
@@ -1013,16 +1150,15 @@ func getFieldsAndReturnProperties(indexName string) (searchedEmbeddedFields stri
 //
 // Returns:
 //   - output: the search results
-func extractAndConvertACSResponse(body []byte, indexName string) (output []sharedtypes.ACSSearchResponse) {
+//   - err: an error if the index is unknown or the body cannot be decoded
+func extractAndConvertACSResponse(body []byte, indexName string) (output []sharedtypes.ACSSearchResponse, err error) {
 	respObject := ACSSearchResponseStruct{}
 	switch indexName {
 
 	case "granular-ansysgpt", "ansysgpt-documentation-2023r2", "scade-documentation-2023r2", "ansys-dot-com-marketing", "external-marketing", "ibp-app-brief":
 		err := json.Unmarshal(body, &respObject)
 		if err != nil {
-			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStruct: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "failed to unmarshal response body from ACS to ACSSearchResponseStruct: %v", err)
 		}
 		output = respObject.Value
 
@@ -1030,9 +1166,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		respObjectAlh := ACSSearchResponseStructALH{}
 		err := json.Unmarshal(body, &respObjectAlh)
 		if err != nil {
-			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStructALH: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "failed to unmarshal response body from ACS to ACSSearchResponseStructALH: %v", err)
 		}
 
 		for _, item := range respObjectAlh.Value {
@@ -1057,9 +1191,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		respObjectLsdyna := ACSSearchResponseStructLSdyna{}
 		err := json.Unmarshal(body, &respObjectLsdyna)
 		if err != nil {
-			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStructLSdyna: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "failed to unmarshal response body from ACS to ACSSearchResponseStructLSdyna: %v", err)
 		}
 
 		for _, item := range respObjectLsdyna.Value {
@@ -1082,9 +1214,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		respObjectCrtech := ACSSearchResponseStructCrtech{}
 		err := json.Unmarshal(body, &respObjectCrtech)
 		if err != nil {
-			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStructCrtech: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			return nil, logError(nil, ErrUpstreamUnavailable, "failed to unmarshal response body from ACS to ACSSearchResponseStructCrtech: %v", err)
 		}
 
 		for _, item := range respObjectCrtech.Value {
@@ -1106,12 +1236,10 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		}
 
 	default:
-		errMessage := fmt.Sprintf("Index name not found: %s", indexName)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return nil, logError(nil, ErrNotFound, "Index name not found: %s", indexName)
 	}
 
-	return output
+	return output, nil
 }
 
 // dataExtractionFilterGithubTreeEntries filters the Github tree entries based on the specified filters.
@@ -1221,7 +1349,7 @@ func dataExtractionFilterGithubTreeEntries(tree *github.Tree, githubFilteredDire
 	}
 
 	for _, file := range githubFilesToExtract {
-		logging.Log.Debugf(&logging.ContextMap{}, "Github file to extract: %s \n", file)
+		redact.Log.Debugf(&logging.ContextMap{}, "Github file to extract: %s \n", file)
 	}
 
 	return githubFilesToExtract
@@ -1230,14 +1358,12 @@ func dataExtractionFilterGithubTreeEntries(tree *github.Tree, githubFilteredDire
 // dataExtractNewGithubClient initializes a new GitHub client with the given access token.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - githubAccessToken: the GitHub access token.
 //
 // Returns:
 //   - *github.Client: the GitHub client.
-//   - context.Context: the context.
-func dataExtractNewGithubClient(githubAccessToken string) (client *github.Client, ctx context.Context) {
-	ctx = context.Background()
-
+func dataExtractNewGithubClient(ctx context.Context, githubAccessToken string) (client *github.Client) {
 	// Setup OAuth2 token source with the GitHub access token.
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: githubAccessToken},
@@ -1249,7 +1375,7 @@ func dataExtractNewGithubClient(githubAccessToken string) (client *github.Client
 	// Initialize a new GitHub client with the OAuth2 client.
 	client = github.NewClient(tc)
 
-	return client, ctx
+	return client
 }
 
 // dataExtractionLocalFilepathExtractWalker is the walker function for the local file extraction.
@@ -1476,6 +1602,7 @@ func dataExtractionNewLlmInputChannelItem(data *sharedtypes.DbData, instructionS
 // dataExtractionLLMHandlerWorker is a worker function for the LLM Handler requests during data extraction.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - waitgroup: the wait group
 //   - inputChannel: the input channel
 //   - errorChannel: the error channel
@@ -1483,13 +1610,13 @@ func dataExtractionNewLlmInputChannelItem(data *sharedtypes.DbData, instructionS
 //
 // Returns:
 //   - error: an error if any
-func dataExtractionLLMHandlerWorker(waitgroup *sync.WaitGroup, inputChannel chan *DataExtractionLLMInputChannelItem, errorChannel chan error, embeddingsDimensions int) {
+func dataExtractionLLMHandlerWorker(ctx context.Context, waitgroup *sync.WaitGroup, inputChannel chan *DataExtractionLLMInputChannelItem, errorChannel chan error, embeddingsDimensions int) {
 	defer waitgroup.Done()
 	// Listen to Input Channel
 	for instruction := range inputChannel {
 		// Check if text field for chunk is empty.
 		if instruction.Data.Text == "" {
-			logging.Log.Warnf(&logging.ContextMap{}, "Text field is empty for document %v \n", instruction.Data.DocumentName)
+			redact.Log.Warnf(&logging.ContextMap{}, "Text field is empty for document %v \n", instruction.Data.DocumentName)
 
 			// Lower instruction sequence waitgroup counter and update processed instructions counter.
 			instruction.InstructionSequenceWaitGroup.Done()
@@ -1501,13 +1628,13 @@ func dataExtractionLLMHandlerWorker(waitgroup *sync.WaitGroup, inputChannel chan
 		switch instruction.Adapter {
 		case "chat":
 			if instruction.ChatRequestType == "summary" {
-				res, err := llmHandlerPerformSummaryRequest(instruction.Data.Text)
+				res, err := llmHandlerPerformSummaryRequest(ctx, instruction.Data.Text)
 				if err != nil {
 					errorChannel <- err
 				}
 				instruction.Data.Summary = res
 			} else if instruction.ChatRequestType == "keywords" {
-				res, err := llmHandlerPerformKeywordExtractionRequest(instruction.Data.Text, instruction.MaxNumberOfKeywords)
+				res, err := llmHandlerPerformKeywordExtractionRequest(ctx, instruction.Data.Text, instruction.MaxNumberOfKeywords)
 				if err != nil {
 					errorChannel <- err
 				}
@@ -1520,18 +1647,19 @@ func dataExtractionLLMHandlerWorker(waitgroup *sync.WaitGroup, inputChannel chan
 		instruction.InstructionSequenceWaitGroup.Done()
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "LLM Handler Worker stopped.")
+	redact.Log.Debugf(&logging.ContextMap{}, "LLM Handler Worker stopped.")
 }
 
 // dataExtractionProcessBatchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - documentData: the document data.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func dataExtractionProcessBatchEmbeddings(documentData []*sharedtypes.DbData, maxBatchSize int) error {
+func dataExtractionProcessBatchEmbeddings(ctx context.Context, documentData []*sharedtypes.DbData, maxBatchSize int) error {
 	// Remove empty chunks (including root node if applicable)
 	nonEmptyDocumentData := make([]*sharedtypes.DbData, 0, len(documentData))
 	for _, data := range documentData {
@@ -1541,8 +1669,7 @@ func dataExtractionProcessBatchEmbeddings(documentData []*sharedtypes.DbData, ma
 	}
 
 	if len(nonEmptyDocumentData) == 0 {
-		logging.Log.Error(&logging.ContextMap{}, "error in dataExtractionProcessBatchEmbeddings: documentData slice is empty")
-		return fmt.Errorf("error in dataExtractionProcessBatchEmbeddings: documentData slice is empty")
+		return logError(nil, ErrInvalidInput, "error in dataExtractionProcessBatchEmbeddings: documentData slice is empty")
 	}
 
 	// Process data in batches
@@ -1560,7 +1687,7 @@ func dataExtractionProcessBatchEmbeddings(documentData []*sharedtypes.DbData, ma
 		}
 
 		// Perform vector embedding request to LLM handler
-		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, false)
+		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, false)
 		if err != nil {
 			return fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -1577,17 +1704,18 @@ func dataExtractionProcessBatchEmbeddings(documentData []*sharedtypes.DbData, ma
 // llmHandlerPerformVectorEmbeddingRequest performs a vector embedding request to LLM Handler.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: slice of input strings.
 //
 // Returns:
 //   - embeddedVector: the embedded vectors.
 //   - error: an error if any.
-func llmHandlerPerformVectorEmbeddingRequest(input []string, sparse bool) (embeddedVectors [][]float32, sparseEmbeddings []map[uint]float32, err error) {
+func llmHandlerPerformVectorEmbeddingRequest(ctx context.Context, input []string, sparse bool) (embeddedVectors [][]float32, sparseEmbeddings []map[uint]float32, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send embeddings request.
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, sparse, nil)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, sparse, nil)
 
 	// Process the first response and close the channel.
 	embeddedVectors = make([][]float32, len(input))
@@ -1595,12 +1723,12 @@ func llmHandlerPerformVectorEmbeddingRequest(input []string, sparse bool) (embed
 	for response := range responseChannel {
 		// Check if the response is an error.
 		if response.Type == "error" {
-			return nil, nil, fmt.Errorf("error in vector embedding request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			return nil, nil, responseError(response)
 		}
 
 		// Check if the response is an info message.
 		if response.Type == "info" {
-			logging.Log.Infof(&logging.ContextMap{}, "Received info message for batch embedding request: %v: %v", response.InstructionGuid, response.InfoMessage)
+			redact.Log.Infof(&logging.ContextMap{}, "Received info message for batch embedding request: %v: %v", response.InstructionGuid, response.InfoMessage)
 			continue
 		}
 
@@ -1680,24 +1808,25 @@ func llmHandlerPerformVectorEmbeddingRequest(input []string, sparse bool) (embed
 // llmHandlerPerformSummaryRequest performs a summary request to LLM Handler.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string.
 //
 // Returns:
 //   - summary: the summary.
 //   - error: an error if any.
-func llmHandlerPerformSummaryRequest(input string) (summary string, err error) {
+func llmHandlerPerformSummaryRequest(ctx context.Context, input string) (summary string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequestNoHistory(input, "summary", 1, llmHandlerEndpoint, nil, nil)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "summary", 1, llmHandlerEndpoint, nil, nil)
 
 	// Process all responses.
 	var responseAsStr string
 	for response := range responseChannel {
 		// Check if the response is an error.
 		if response.Type == "error" {
-			return "", fmt.Errorf("error in summary request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			return "", responseError(response)
 		}
 
 		// Accumulate the responses.
@@ -1709,7 +1838,7 @@ func llmHandlerPerformSummaryRequest(input string) (summary string, err error) {
 		}
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Received summary response.")
+	redact.Log.Debugf(&logging.ContextMap{}, "Received summary response.")
 
 	// Close the response channel.
 	close(responseChannel)
@@ -1721,6 +1850,7 @@ func llmHandlerPerformSummaryRequest(input string) (summary string, err error) {
 // performGeneralRequest performs a general chat completion request to LLM.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string.
 //   - history: the conversation history.
 //   - isStream: the stream flag.
@@ -1730,12 +1860,12 @@ func llmHandlerPerformSummaryRequest(input string) (summary string, err error) {
 //   - message: the generated message.
 //   - stream: the stream channel.
 //   - err: the error.
-func performGeneralRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, options *sharedtypes.ModelOptions) (message string, stream *chan string, err error) {
+func performGeneralRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, options *sharedtypes.ModelOptions) (message string, stream *chan string, err error) {
 	// get the LLM handler endpoint.
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, options, nil)
+	responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, options, nil)
 
 	// If isStream is true, create a stream channel and return asap.
 	if isStream {
@@ -1743,7 +1873,7 @@ func performGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel.
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "", nil)
 
 		// Return the stream channel.
 		return "", &streamChannel, nil
@@ -1754,11 +1884,11 @@ func performGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 	for response := range responseChannel {
 		// Check if the response is an error.
 		if response.Type == "error" {
-			return "", nil, fmt.Errorf("error in general llm request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			return "", nil, responseError(response)
 		}
 
 		if response.Type == "info" {
-			logging.Log.Infof(&logging.ContextMap{}, "Received info message for general llm request: %v: %v", response.InstructionGuid, response.InfoMessage)
+			redact.Log.Infof(&logging.ContextMap{}, "Received info message for general llm request: %v: %v", response.InstructionGuid, response.InfoMessage)
 			continue
 		}
 
@@ -1781,25 +1911,26 @@ func performGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 // llmHandlerPerformKeywordExtractionRequest performs a keyword extraction request to LLM Handler.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - input: the input string.
 //   - numKeywords: the number of keywords.
 //
 // Returns:
 //   - keywords: the keywords.
 //   - error: an error if any.
-func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32) (keywords []string, err error) {
+func llmHandlerPerformKeywordExtractionRequest(ctx context.Context, input string, numKeywords uint32) (keywords []string, err error) {
 	// get the LLM handler endpoint.
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequestNoHistory(input, "keywords", numKeywords, llmHandlerEndpoint, nil, nil)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "keywords", numKeywords, llmHandlerEndpoint, nil, nil)
 
 	// Process all responses.
 	var responseAsStr string
 	for response := range responseChannel {
 		// Check if the response is an error.
 		if response.Type == "error" {
-			return nil, fmt.Errorf("error in keyword extraction request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			return nil, responseError(response)
 		}
 
 		// Accumulate the responses.
@@ -1811,7 +1942,7 @@ func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32)
 		}
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Received keywords response.")
+	redact.Log.Debugf(&logging.ContextMap{}, "Received keywords response.")
 
 	// Close the response channel.
 	close(responseChannel)
@@ -1823,6 +1954,7 @@ func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32)
 // dataExtractionPerformSplitterRequest performs a data extraction splitter request to the Python service.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - content: the content.
 //   - documentType: the document type.
 //   - chunkSize: the chunk size.
@@ -1831,7 +1963,7 @@ func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32)
 // Returns:
 //   - output: the output.
 //   - error: an error if any.
-func dataExtractionPerformSplitterRequest(content []byte, documentType string, chunkSize int, chunkOverlap int) (output []string, err error) {
+func dataExtractionPerformSplitterRequest(ctx context.Context, content []byte, documentType string, chunkSize int, chunkOverlap int) (output []string, err error) {
 	// Define the URL and headers.
 	url := config.GlobalConfig.FLOWKIT_PYTHON_ENDPOINT + "/splitter/" + documentType
 	headers := map[string]string{
@@ -1851,7 +1983,7 @@ func dataExtractionPerformSplitterRequest(content []byte, documentType string, c
 	}
 
 	// Send the request.
-	response, err := httpRequest("POST", url, headers, body)
+	response, err := httpRequest(ctx, "POST", url, headers, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1872,6 +2004,7 @@ func dataExtractionPerformSplitterRequest(content []byte, documentType string, c
 // httpRequest is a general function for making HTTP requests.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - method: HTTP method.
 //   - url: URL to make the request to.
 //   - headers: headers to include in the request.
@@ -1880,9 +2013,9 @@ func dataExtractionPerformSplitterRequest(content []byte, documentType string, c
 // Returns:
 //   - response body.
 //   - error.
-func httpRequest(method string, url string, headers map[string]string, body []byte) ([]byte, error) {
+func httpRequest(ctx context.Context, method string, url string, headers map[string]string, body []byte) ([]byte, error) {
 	// Create a new request using http.
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
@@ -1893,7 +2026,7 @@ func httpRequest(method string, url string, headers map[string]string, body []by
 	}
 
 	// Create a new HTTP client and set timeout.
-	client := &http.Client{}
+	client := &http.Client{Transport: tracing.NewTransport(nil)}
 
 	// Send the request.
 	resp, err := client.Do(req)
@@ -1981,12 +2114,13 @@ func openAiTokenCount(modelName string, message string) (int, error) {
 // codeGenerationProcessBatchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - documentData: the document data.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func codeGenerationProcessBatchEmbeddings(elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (elementEmbeddings [][]float32, err error) {
+func codeGenerationProcessBatchEmbeddings(ctx context.Context, elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (elementEmbeddings [][]float32, err error) {
 	// Process data in batches
 	for i := 0; i < len(elements); i += maxBatchSize {
 		end := i + maxBatchSize
@@ -2002,7 +2136,7 @@ func codeGenerationProcessBatchEmbeddings(elements []sharedtypes.CodeGenerationE
 		}
 
 		// Perform vector embedding request to LLM handler
-		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, false)
+		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2011,7 +2145,7 @@ func codeGenerationProcessBatchEmbeddings(elements []sharedtypes.CodeGenerationE
 		elementEmbeddings = append(elementEmbeddings, batchEmbeddings...)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", len(elements))
+	redact.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", len(elements))
 
 	return elementEmbeddings, nil
 }
@@ -2019,12 +2153,13 @@ func codeGenerationProcessBatchEmbeddings(elements []sharedtypes.CodeGenerationE
 // codeGenerationProcessHybridSearchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - elements: the elements.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+func codeGenerationProcessHybridSearchEmbeddings(ctx context.Context, elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -2042,7 +2177,7 @@ func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGene
 		}
 
 		// Send http request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2052,7 +2187,7 @@ func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGene
 		lexicalWeights = append(lexicalWeights, batchLexicalWeights...)
 
 		processedEmbeddings += len(batchData)
-		logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
+		redact.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
 	}
 
 	return denseEmbeddings, lexicalWeights, nil
@@ -2061,12 +2196,13 @@ func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGene
 // codeGenerationProcessHybridSearchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - elements: the elements.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func codeGenerationProcessHybridSearchEmbeddingsForExamples(elements []codegeneration.VectorDatabaseExample, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+func codeGenerationProcessHybridSearchEmbeddingsForExamples(ctx context.Context, elements []codegeneration.VectorDatabaseExample, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -2084,7 +2220,7 @@ func codeGenerationProcessHybridSearchEmbeddingsForExamples(elements []codegener
 		}
 
 		// Send http request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2094,13 +2230,13 @@ func codeGenerationProcessHybridSearchEmbeddingsForExamples(elements []codegener
 		lexicalWeights = append(lexicalWeights, batchLexicalWeights...)
 
 		processedEmbeddings += len(batchData)
-		logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
+		redact.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
 	}
 
 	return denseEmbeddings, lexicalWeights, nil
 }
 
-func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(sections []codegeneration.VectorDatabaseUserGuideSection, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(ctx context.Context, sections []codegeneration.VectorDatabaseUserGuideSection, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -2118,7 +2254,7 @@ func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(sections []
 		}
 
 		// Send embedding request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2128,7 +2264,7 @@ func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(sections []
 		lexicalWeights = append(lexicalWeights, batchLexicalWeights...)
 
 		processedEmbeddings += len(batchData)
-		logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
+		redact.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
 	}
 
 	return denseEmbeddings, lexicalWeights, nil
@@ -2148,11 +2284,11 @@ type pythonEmbeddingResponse struct {
 	DenseVecs      [][]float32        `json:"dense_vecs"`
 }
 
-func CreateEmbeddings(dense bool, sparse bool, colbert bool, isDocument bool, passages []string) (dense_vector [][]float32, lexical_weights []map[uint]float32, colbert_vecs [][][]float32, func_error error) {
+func CreateEmbeddings(ctx context.Context, dense bool, sparse bool, colbert bool, isDocument bool, passages []string) (dense_vector [][]float32, lexical_weights []map[uint]float32, colbert_vecs [][][]float32, func_error error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic occured in CreateEmbeddings: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic occured in CreateEmbeddings: %v", r)
 			func_error = r.(error)
 		}
 	}()
@@ -2170,13 +2306,21 @@ func CreateEmbeddings(dense bool, sparse bool, colbert bool, isDocument bool, pa
 
 	jsonData, err := json.Marshal(request)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error marshalling request: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error marshalling request: %v", err)
+		return nil, nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(jsonData))
+	if err != nil {
+		redact.Log.Errorf(&logging.ContextMap{}, "Error creating request to python helper server: %v", err)
 		return nil, nil, nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := http.Post(url, "application/json", bytes.NewReader(jsonData))
+	client := &http.Client{Transport: tracing.NewTransport(nil)}
+	resp, err := client.Do(req)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error sending request to python helper server extract-text: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error sending request to python helper server extract-text: %v", err)
 		return nil, nil, nil, err
 	}
 	defer resp.Body.Close()
@@ -2191,7 +2335,7 @@ func CreateEmbeddings(dense bool, sparse bool, colbert bool, isDocument bool, pa
 	var response pythonEmbeddingResponse
 	err = json.Unmarshal([]byte(responseBody), &response)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error unmarshalling response: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error unmarshalling response: %v", err)
 		return nil, nil, nil, err
 	}
 
@@ -2215,7 +2359,7 @@ func dataExtractionTextSplitter(input string, chunkSize int, chunkOverlap int) (
 	splittedChunks, err = txtLoader.LoadAndSplit(context.Background(), splitter)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return nil, err
 	}
 
@@ -2240,7 +2384,7 @@ func getLocalFileContent(localFilePath string) (checksum string, content []byte,
 	content, err = os.ReadFile(localFilePath)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting local file content: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return "", nil, err
 	}
 
@@ -2249,14 +2393,14 @@ func getLocalFileContent(localFilePath string) (checksum string, content []byte,
 	_, err = hash.Write(content)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting local file content: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return "", nil, err
 	}
 
 	// Convert checksum to a hexadecimal string.
 	checksum = hex.EncodeToString(hash.Sum(nil))
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Got content from local file: %s", localFilePath)
+	redact.Log.Debugf(&logging.ContextMap{}, "Got content from local file: %s", localFilePath)
 
 	return checksum, content, err
 }
@@ -2320,6 +2464,7 @@ func getIndexNameFromToolName(toolName string) (indexName string, err error) {
 // downloadGithubFileContent downloads file content from github and returns checksum and content.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
@@ -2329,17 +2474,17 @@ func getIndexNameFromToolName(toolName string) (indexName string, err error) {
 // Returns:
 //   - checksum: checksum of file.
 //   - content: content of file.
-func downloadGithubFileContent(githubRepoName string, githubRepoOwner string,
+func downloadGithubFileContent(ctx context.Context, githubRepoName string, githubRepoOwner string,
 	githubRepoBranch string, gihubFilePath string, githubAccessToken string) (checksum string, content []byte, err error) {
 
 	// Create a new GitHub client and context.
-	client, ctx := dataExtractNewGithubClient(githubAccessToken)
+	client := dataExtractNewGithubClient(ctx, githubAccessToken)
 
 	// Retrieve the file content from the GitHub repository.
 	fileContent, _, _, err := client.Repositories.GetContents(ctx, githubRepoOwner, githubRepoName, gihubFilePath, &github.RepositoryContentGetOptions{Ref: githubRepoBranch})
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting file content from github file %v: %v", gihubFilePath, err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return "", nil, err
 	}
 
@@ -2347,7 +2492,7 @@ func downloadGithubFileContent(githubRepoName string, githubRepoOwner string,
 	stringContent, err := fileContent.GetContent()
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting file content from github file %v: %v", gihubFilePath, err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		redact.Log.Error(&logging.ContextMap{}, errMessage)
 		return "", nil, err
 	}
 
@@ -2357,7 +2502,7 @@ func downloadGithubFileContent(githubRepoName string, githubRepoOwner string,
 	// Convert the content to a byte slice.
 	content = []byte(stringContent)
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Got content from github file: %s", gihubFilePath)
+	redact.Log.Debugf(&logging.ContextMap{}, "Got content from github file: %s", gihubFilePath)
 
 	return checksum, content, nil
 }
@@ -2367,44 +2512,40 @@ func downloadGithubFileContent(githubRepoName string, githubRepoOwner string,
 // to initialize the mongodb client
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - mongoDbEndpoint: The MongoDB endpoint.
 //   - databaseName: The name of the database.
 //
 // Returns:
 //   - mongoDbClient: The MongoDB client.
 //   - err: An error if any.
-func mongoDbInitializeClient(mongoDbEndpoint string, databaseName string, collectionName string) (mongoDbContext *MongoDbContext, err error) {
+func mongoDbInitializeClient(ctx context.Context, mongoDbEndpoint string, databaseName string, collectionName string) (mongoDbContext *MongoDbContext, err error) {
 	// Set the server API options
 	serverAPI := options.ServerAPI(options.ServerAPIVersion1)
-	opts := options.Client().ApplyURI(mongoDbEndpoint).SetServerAPIOptions(serverAPI)
-
-	// create context
-	mongoDbCtx := context.Background()
+	opts := options.Client().ApplyURI(mongoDbEndpoint).SetServerAPIOptions(serverAPI).SetMonitor(tracing.MongoMonitor())
 
 	// Create a new client and connect to the server
-	client, err := mongo.Connect(mongoDbCtx, opts)
+	client, err := mongo.Connect(ctx, opts)
 	if err != nil {
-		return nil, fmt.Errorf("error in mongo.Connect: %v", err)
+		return nil, fmt.Errorf("%w: error in mongo.Connect: %v", ErrUpstreamUnavailable, err)
 	}
 
 	// Ping to verify connection
-	err = client.Ping(mongoDbCtx, readpref.Primary())
+	err = client.Ping(ctx, readpref.Primary())
 	if err != nil {
-		return nil, fmt.Errorf("failed to ping MongoDB: %v", err)
+		return nil, fmt.Errorf("%w: failed to ping MongoDB: %v", ErrUpstreamUnavailable, err)
 	}
 
 	// create database
 	database := client.Database(databaseName)
 
 	// check if collection exists
-	exists, err := mongoDbCollectionExists(database, collectionName)
+	exists, err := mongoDbCollectionExists(ctx, database, collectionName)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error checking if collection exists: %v", err)
-		panic(err)
+		return nil, logError(nil, ErrUpstreamUnavailable, "Error checking if collection exists: %v", err)
 	}
 	if !exists {
-		logging.Log.Errorf(&logging.ContextMap{}, "Collection %s does not exist", collectionName)
-		panic("Collection " + collectionName + " does not exist")
+		return nil, logError(nil, ErrNotFound, "Collection %s does not exist", collectionName)
 	}
 
 	// create collection
@@ -2423,15 +2564,16 @@ func mongoDbInitializeClient(mongoDbEndpoint string, databaseName string, collec
 // mongoDbCollectionExists checks if a collection exists in the database
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - database: The MongoDB database.
 //   - collectionName: The name of the collection.
 //
 // Returns:
 //   - exists: True if the collection exists, false otherwise.
 //   - err: An error if any.
-func mongoDbCollectionExists(database *mongo.Database, collectionName string) (exists bool, err error) {
+func mongoDbCollectionExists(ctx context.Context, database *mongo.Database, collectionName string) (exists bool, err error) {
 	// Get the list of collections in the database
-	collections, err := database.ListCollectionNames(context.Background(), map[string]interface{}{})
+	collections, err := database.ListCollectionNames(ctx, map[string]interface{}{})
 	if err != nil {
 		return false, err
 	}
@@ -2450,6 +2592,7 @@ func mongoDbCollectionExists(database *mongo.Database, collectionName string) (e
 // the customer object from the database using the API key
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - mongoDbContext: The MongoDB context.
 //   - apiKey: The API key.
 //
@@ -2457,12 +2600,12 @@ func mongoDbCollectionExists(database *mongo.Database, collectionName string) (e
 //   - exists: True if the customer exists, false otherwise.
 //   - customer: The customer object.
 //   - err: An error if any.
-func mongoDbGetCustomerByApiKey(mongoDbContext *MongoDbContext, apiKey string) (exists bool, customer *MongoDbCustomerObject, err error) {
+func mongoDbGetCustomerByApiKey(ctx context.Context, mongoDbContext *MongoDbContext, apiKey string) (exists bool, customer *MongoDbCustomerObject, err error) {
 	// Create filter for API key
 	filter := bson.M{"api_key": apiKey}
 
 	// Find one document
-	err = mongoDbContext.Collection.FindOne(context.Background(), filter).Decode(&customer)
+	err = mongoDbContext.Collection.FindOne(ctx, filter).Decode(&customer)
 	if err != nil {
 		// No matching document found
 		if err == mongo.ErrNoDocuments {
@@ -2478,19 +2621,20 @@ func mongoDbGetCustomerByApiKey(mongoDbContext *MongoDbContext, apiKey string) (
 // mongoDbGetCreateCustomerByUserId retrieves or creates a customer object by user ID.
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - mongoDbContext: The MongoDB context.
 //   - userId: The user ID.
 //   - tokenLimitForNewUsers: The token limit for new users.
 //
 // Returns:
 //   - err: An error if any.
-func mongoDbGetCreateCustomerByUserId(mongoDbContext *MongoDbContext, userId string, tokenLimitForNewUsers int) (existingUser bool, customer *MongoDbCustomerObjectDisco, err error) {
+func mongoDbGetCreateCustomerByUserId(ctx context.Context, mongoDbContext *MongoDbContext, userId string, tokenLimitForNewUsers int) (existingUser bool, customer *MongoDbCustomerObjectDisco, err error) {
 	// Create filter for API key
 	filter := bson.M{"user_id": userId}
 
 	// Find one document
 	existingUser = true
-	err = mongoDbContext.Collection.FindOne(context.Background(), filter).Decode(&customer)
+	err = mongoDbContext.Collection.FindOne(ctx, filter).Decode(&customer)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			// No matching document found
@@ -2512,7 +2656,7 @@ func mongoDbGetCreateCustomerByUserId(mongoDbContext *MongoDbContext, userId str
 		}
 
 		// Insert the new customer document
-		_, err = mongoDbContext.Collection.InsertOne(context.Background(), customer)
+		_, err = mongoDbContext.Collection.InsertOne(ctx, customer)
 		if err != nil {
 			return false, customer, fmt.Errorf("failed to insert new customer: %v", err)
 		}
@@ -2524,13 +2668,14 @@ func mongoDbGetCreateCustomerByUserId(mongoDbContext *MongoDbContext, userId str
 // mongoDbAddToTotalTokenCount increments the total token count for a customer
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - mongoDbContext: The MongoDB context.
 //   - apiKey: The API key.
 //   - additionalTokenCount: The number of tokens to add.
 //
 // Returns:
 //   - err: An error if any.
-func mongoDbAddToTotalTokenCount(mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string, additionalTokenCount int) (err error) {
+func mongoDbAddToTotalTokenCount(ctx context.Context, mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string, additionalTokenCount int) (err error) {
 	// Create filter for API key & update for total token count
 	filter := bson.M{indetificationKey: indetificationValue}
 	update := bson.M{
@@ -2540,7 +2685,7 @@ func mongoDbAddToTotalTokenCount(mongoDbContext *MongoDbContext, indetificationK
 	}
 
 	// Update the document
-	result, err := mongoDbContext.Collection.UpdateOne(context.Background(), filter, update)
+	result, err := mongoDbContext.Collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("failed to update token usage: %v", err)
 	}
@@ -2556,12 +2701,13 @@ func mongoDbAddToTotalTokenCount(mongoDbContext *MongoDbContext, indetificationK
 // mongoDbUpdateAccessAndWarning updates the access_denied and warning_sent fields
 //
 // Parameters:
+//   - ctx: the context of the request
 //   - mongoDbContext: The MongoDB context.
 //   - apiKey: The API key.
 //
 // Returns:
 //   - err: An error if any.
-func mongoDbUpdateAccessAndWarning(mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string) (err error) {
+func mongoDbUpdateAccessAndWarning(ctx context.Context, mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string) (err error) {
 	// Create filter for API key & update access_denied and warning_sent
 	filter := bson.M{indetificationKey: indetificationValue}
 	update := bson.M{
@@ -2572,7 +2718,7 @@ func mongoDbUpdateAccessAndWarning(mongoDbContext *MongoDbContext, indetificatio
 	}
 
 	// Update the document
-	result, err := mongoDbContext.Collection.UpdateOne(context.Background(), filter, update)
+	result, err := mongoDbContext.Collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("failed to update token usage: %v", err)
 	}
@@ -2593,7 +2739,7 @@ func logPanic(ctx *logging.ContextMap, msg string, args ...any) {
 	} else {
 		logCtx = ctx
 	}
-	logging.Log.Error(logCtx, errMsg)
+	redact.Log.Error(logCtx, errMsg)
 	panic(errMsg)
 }
 
@@ -2607,9 +2753,9 @@ func logPanic(ctx *logging.ContextMap, msg string, args ...any) {
 //   - conn: The established WebSocket connection.
 //   - err: An error if the connection fails.
 func connectToMCP(ctx context.Context, serverURL string) (*websocket.Conn, error) {
-	conn, _, err := websocket.Dial(ctx, serverURL, nil)
+	conn, _, err := websocket.Dial(ctx, serverURL, &websocket.DialOptions{HTTPHeader: tracing.HTTPHeader(ctx)})
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to WebSocket server: %w", err)
+		return nil, fmt.Errorf("%w: failed to connect to WebSocket server: %w", ErrUpstreamUnavailable, err)
 	}
 	return conn, nil
 }
@@ -2624,7 +2770,12 @@ func connectToMCP(ctx context.Context, serverURL string) (*websocket.Conn, error
 // Returns:
 //   - response: The parsed response from the MCP server as a map.
 //   - err: An error if marshaling, sending, or receiving fails.
-func sendMCPRequest(ctx context.Context, conn *websocket.Conn, request interface{}) (map[string]interface{}, error) {
+func sendMCPRequest(ctx context.Context, conn *websocket.Conn, request interface{}) (response map[string]interface{}, err error) {
+	ctx, span := tracing.Start(ctx, "mcp.request")
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	data, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -2632,15 +2783,14 @@ func sendMCPRequest(ctx context.Context, conn *websocket.Conn, request interface
 
 	err = conn.Write(ctx, websocket.MessageText, data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, fmt.Errorf("%w: failed to send request: %w", ErrUpstreamUnavailable, err)
 	}
 
 	_, msg, err := conn.Read(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, fmt.Errorf("%w: failed to read response: %w", ErrUpstreamUnavailable, err)
 	}
 
-	var response map[string]interface{}
 	if err := json.Unmarshal(msg, &response); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
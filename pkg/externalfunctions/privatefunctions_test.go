@@ -0,0 +1,191 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/llmmock"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveFakeLLM starts a mock aali-llm failing the first requests with the given error code
+// and echoing the data of the following requests
+func serveFakeLLM(t *testing.T, failures int, code int) (*llmmock.Server, string) {
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{
+		{Times: failures, Error: &llmmock.Error{Code: code, Message: "model overloaded"}},
+		{Echo: true},
+	}})
+	return mock, config.GlobalConfig.LLM_HANDLER_ENDPOINT
+}
+
+func TestLLMRequestRetries(t *testing.T) {
+	mock, _ := serveFakeLLM(t, 2, 503)
+
+	// idempotent requests are retried after retryable errors
+	summary, err := llmHandlerPerformSummaryRequest(context.Background(), "short summary")
+	require.NoError(t, err)
+	assert.Equal(t, "short summary", summary)
+	assert.Len(t, mock.Requests(), 3)
+}
+
+func TestLLMRequestRetriesExhausted(t *testing.T) {
+	mock, _ := serveFakeLLM(t, 5, 503)
+
+	// the last error is returned once all attempts failed
+	_, err := llmHandlerPerformKeywordExtractionRequest(context.Background(), "some keywords", 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "model overloaded")
+	assert.Len(t, mock.Requests(), 3)
+}
+
+func TestLLMRequestNotRetried(t *testing.T) {
+	// errors which are not retryable fail at once
+	mock, _ := serveFakeLLM(t, 1, 400)
+	_, err := llmHandlerPerformSummaryRequest(context.Background(), "short summary")
+	require.Error(t, err)
+	assert.Len(t, mock.Requests(), 1)
+
+	// streamed general chats are not idempotent
+	mock, endpoint := serveFakeLLM(t, 1, 503)
+	responses := sendChatRequest(context.Background(), "hello there", "general", nil, 0, "", endpoint, nil, nil, nil)
+	response := <-responses
+	assert.Equal(t, "error", response.Type)
+	assert.Len(t, mock.Requests(), 1)
+}
+
+func TestLLMRequestFallsBack(t *testing.T) {
+	// streamed chats move to the next model of their chain on retryable errors
+	mock, endpoint := serveFakeLLM(t, 1, 503)
+	responses, answered := sendChatRequestWithModel(context.Background(), "hello there", "general", nil, 0, "", endpoint, []string{"primary", "backup"}, nil, nil)
+	message := ""
+	for response := range responses {
+		require.Equal(t, "chat", response.Type)
+		message += *response.ChatData
+		if *response.IsLast {
+			break
+		}
+	}
+	assert.Equal(t, "hello there", message)
+	assert.Equal(t, "backup", answered.ModelId)
+	requests := mock.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, []string{"primary"}, requests[0].ModelIds)
+	assert.Equal(t, []string{"backup"}, requests[1].ModelIds)
+
+	// other errors only fall back if configured
+	mock, endpoint = serveFakeLLM(t, 1, 404)
+	responses, _ = sendChatRequestWithModel(context.Background(), "hello there", "general", nil, 0, "", endpoint, []string{"primary", "backup"}, nil, nil)
+	assert.Equal(t, "error", (<-responses).Type)
+	assert.Len(t, mock.Requests(), 1)
+
+	mock, endpoint = serveFakeLLM(t, 1, 404)
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_FALLBACK_CODES"] = "400, 404"
+	responses, answered = sendChatRequestWithModel(context.Background(), "hello", "general", nil, 0, "", endpoint, []string{"primary", "backup"}, nil, nil)
+	assert.Equal(t, "chat", (<-responses).Type)
+	assert.Equal(t, "backup", answered.ModelId)
+	assert.Len(t, mock.Requests(), 2)
+}
+
+func TestLLMRequestFallsBackAfterTimeout(t *testing.T) {
+	// models not answering within the fallback timeout are skipped
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{
+		{Match: llmmock.Match{ModelId: "primary"}, Delay: time.Hour, Echo: true},
+		{Echo: true},
+	}})
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_FALLBACK_TIMEOUT"] = "50ms"
+	responses, answered := sendChatRequestWithModel(context.Background(), "hello", "general", nil, 0, "", config.GlobalConfig.LLM_HANDLER_ENDPOINT, []string{"primary", "backup"}, nil, nil)
+	response := <-responses
+	require.Equal(t, "chat", response.Type)
+	assert.Equal(t, "hello", *response.ChatData)
+	assert.Equal(t, "backup", answered.ModelId)
+	assert.Len(t, mock.Requests(), 2)
+}
+
+func TestLLMRequestCanceled(t *testing.T) {
+	// the requests of a canceled context end with an error response instead of leaving the consumer waiting
+	mock := serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{{Delay: time.Hour, Echo: true}}})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := llmHandlerPerformSummaryRequest(ctx, "short summary")
+		done <- err
+	}()
+	responses := sendChatRequest(ctx, "hello there", "general", nil, 0, "", config.GlobalConfig.LLM_HANDLER_ENDPOINT, []string{"primary", "backup"}, nil, nil)
+	chatDone := make(chan sharedtypes.HandlerResponse, 1)
+	go func() { chatDone <- <-responses }()
+	require.Eventually(t, func() bool { return len(mock.Requests()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the summary request did not return after the cancellation")
+	}
+	select {
+	case response := <-chatDone:
+		require.Equal(t, "error", response.Type)
+		assert.Contains(t, response.Error.Message, "context canceled")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the chat request did not end after the cancellation")
+	}
+
+	// a canceled request neither retries nor falls back
+	assert.Len(t, mock.Requests(), 2)
+}
+
+func TestLLMRequestCanceledAfterFinalResponse(t *testing.T) {
+	// a cancellation racing the final response does not turn a forwarded answer into an error
+	serveMockLLM(t, &llmmock.Fixtures{Fixtures: []llmmock.Fixture{{Echo: true, ChunkSize: 100}}})
+	pool := llmClient(config.GlobalConfig.LLM_HANDLER_ENDPOINT)
+	isTrue := true
+	request := sharedtypes.HandlerRequest{Adapter: "chat", ChatRequestType: "general", DataStream: true, Data: "hello there"}
+	for i := 0; i < 50; i++ {
+		request.InstructionGuid = fmt.Sprintf("race%d", i)
+		ctx, cancel := context.WithCancel(context.Background())
+		responses := make(chan sharedtypes.HandlerResponse, 2)
+		operation := ""
+		_, err := forwardLLMResponses(ctx, pool, request, nil, responses, 0, cancel, &operation)
+		close(responses)
+
+		forwarded := []sharedtypes.HandlerResponse{}
+		for response := range responses {
+			forwarded = append(forwarded, response)
+		}
+		if len(forwarded) > 0 {
+			require.Len(t, forwarded, 1)
+			assert.Equal(t, &isTrue, forwarded[0].IsLast)
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+	}
+}
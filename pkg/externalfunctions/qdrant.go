@@ -28,6 +28,7 @@ import (
 	"strings"
 
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/qdrant/go-client/qdrant"
 )
@@ -41,14 +42,15 @@ import (
 //   - collectionName (string): The name of the collection
 //   - vectorSize (uint64): The size of the vectors stored in this collection
 //   - vectorDistance (string): The distance metric to use of vector similarity search (cosine, dot, euclid, manhattan)
-func QdrantCreateCollection(collectionName string, vectorSize uint64, vectorDistance string) {
+//
+// Returns:
+//   - error: an error if the collection could not be created
+func QdrantCreateCollection(ctx context.Context, collectionName string, vectorSize uint64, vectorDistance string) error {
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "unable to create qdrant client: %q", err)
+		return logError(nil, ErrUpstreamUnavailable, "unable to create qdrant client: %q", err)
 	}
 
-	ctx := context.TODO()
-
 	err = client.CreateCollection(ctx, &qdrant.CreateCollection{
 		CollectionName: collectionName,
 		VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
@@ -57,8 +59,9 @@ func QdrantCreateCollection(collectionName string, vectorSize uint64, vectorDist
 		}),
 	})
 	if err != nil {
-		logPanic(nil, "failed to create collection: %q", err)
+		return logError(nil, ErrUpstreamUnavailable, "failed to create collection: %q", err)
 	}
+	return nil
 }
 
 // QdrantInsertData inserts data into a collection in qdrant
@@ -71,12 +74,26 @@ func QdrantCreateCollection(collectionName string, vectorSize uint64, vectorDist
 //   - data ([]interface{}): The data points to insert (func will fail if elements are not `map[string]any`)
 //   - idFieldName (string): The name of the field to use as the ID
 //   - vectorFieldName (string): The name of the field to use as the vector
-func QdrantInsertData(collectionName string, data []interface{}, idFieldName string, vectorFieldName string) {
+//
+// Returns:
+//   - error: an error if the data could not be inserted
+func QdrantInsertData(ctx context.Context, collectionName string, data []interface{}, idFieldName string, vectorFieldName string) error {
 	points := make([]*qdrant.PointStruct, len(data))
 	for i, d := range data {
-		dataMap := d.(map[string]any)
-		id := qdrant.NewIDUUID(dataMap[idFieldName].(string))
-		vector := qdrant.NewVectorsDense(dataMap[vectorFieldName].([]float32))
+		dataMap, ok := d.(map[string]any)
+		if !ok {
+			return logError(nil, ErrInvalidInput, "data point %d is not a map but %T", i, d)
+		}
+		idStr, ok := dataMap[idFieldName].(string)
+		if !ok {
+			return logError(nil, ErrInvalidInput, "data point %d has no string field %q", i, idFieldName)
+		}
+		vectorData, ok := dataMap[vectorFieldName].([]float32)
+		if !ok {
+			return logError(nil, ErrInvalidInput, "data point %d has no vector field %q", i, vectorFieldName)
+		}
+		id := qdrant.NewIDUUID(idStr)
+		vector := qdrant.NewVectorsDense(vectorData)
 		delete(dataMap, idFieldName)
 		delete(dataMap, vectorFieldName)
 		points[i] = &qdrant.PointStruct{
@@ -88,20 +105,19 @@ func QdrantInsertData(collectionName string, data []interface{}, idFieldName str
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "unable to create qdrant client: %q", err)
+		return logError(nil, ErrUpstreamUnavailable, "unable to create qdrant client: %q", err)
 	}
 
-	ctx := context.TODO()
-
 	resp, err := client.Upsert(ctx, &qdrant.UpsertPoints{
 		CollectionName: collectionName,
 		Points:         points,
 	})
 
 	if err != nil {
-		logPanic(nil, "failed to insert data: %q", err)
+		return logError(nil, ErrUpstreamUnavailable, "failed to insert data: %q", err)
 	}
-	logging.Log.Debugf(&logging.ContextMap{}, "successfully upserted %d points into qdrant collection %q: %q", len(points), collectionName, resp.GetStatus())
+	redact.Log.Debugf(&logging.ContextMap{}, "successfully upserted %d points into qdrant collection %q: %q", len(points), collectionName, resp.GetStatus())
+	return nil
 }
 
 // QdrantCreateIndex creates a field index on a qdrant collection
@@ -114,15 +130,18 @@ func QdrantInsertData(collectionName string, data []interface{}, idFieldName str
 //   - fieldName (string): The name of the payload field to create an index on
 //   - fieldType (string): The qdrant type that the payload field is expected to be
 //   - wait (bool): Whether to wait for the index to be created or return immediately & continue indexing in background
-func QdrantCreateIndex(collectionName string, fieldName string, fieldType string, wait bool) {
-	client, err := qdrant_utils.QdrantClient()
+//
+// Returns:
+//   - error: an error if the index could not be created
+func QdrantCreateIndex(ctx context.Context, collectionName string, fieldName string, fieldType string, wait bool) error {
+	qdrantType, err := qdrantFieldType(fieldType)
 	if err != nil {
-		logPanic(nil, "unable to create qdrant client: %q", err)
+		return logError(nil, ErrInvalidInput, "could not create qdrant field type: %q", err)
 	}
 
-	qdrantType, err := qdrantFieldType(fieldType)
+	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "could not create qdrant field type: %q", err)
+		return logError(nil, ErrUpstreamUnavailable, "unable to create qdrant client: %q", err)
 	}
 
 	request := qdrant.CreateFieldIndexCollection{
@@ -132,11 +151,12 @@ func QdrantCreateIndex(collectionName string, fieldName string, fieldType string
 		Wait:           qdrant.PtrOf(wait),
 		// TODO: there is more customization here you can do, but specific to the field type
 	}
-	res, err := client.CreateFieldIndex(context.TODO(), &request)
+	res, err := client.CreateFieldIndex(ctx, &request)
 	if err != nil {
-		logPanic(nil, "failed to create index: %q", err)
+		return logError(nil, ErrUpstreamUnavailable, "failed to create index: %q", err)
 	}
-	logging.Log.Debugf(&logging.ContextMap{}, "successfully created index: %v", res.Status)
+	redact.Log.Debugf(&logging.ContextMap{}, "successfully created index: %v", res.Status)
+	return nil
 }
 
 func qdrantFieldType(fieldType string) (*qdrant.FieldType, error) {
@@ -0,0 +1,172 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/jsonschema"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+)
+
+// maxStructuredRepairs bounds the repair requests of a structured request
+const maxStructuredRepairs = 5
+
+// supportsResponseFormat reports whether a model accepts a JSON Schema response format,
+// i.e. is listed in FLOWKIT_LLM_RESPONSE_FORMAT_MODELS, or the variable is "*"
+//
+// Parameters:
+//   - modelId: the model ID, empty for the model chosen by aali-llm
+//
+// Returns:
+//   - bool: true if the response format is sent to the model
+func supportsResponseFormat(modelId string) bool {
+	for _, field := range strings.Split(config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_RESPONSE_FORMAT_MODELS"], ",") {
+		field = strings.TrimSpace(field)
+		if field == "*" || (field != "" && field == modelId) {
+			return true
+		}
+	}
+	return false
+}
+
+// structuredResponseFormat returns the response format asking for an answer matching a JSON Schema
+//
+// Parameters:
+//   - schema: the JSON Schema
+//
+// Returns:
+//   - map[string]interface{}: the response format in the shape of the OpenAI API
+//   - error: an error if the schema is not valid JSON
+func structuredResponseFormat(schema string) (map[string]interface{}, error) {
+	var decoded interface{}
+	err := json.Unmarshal([]byte(schema), &decoded)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"type": "json_schema",
+		"json_schema": map[string]interface{}{
+			"name":   "structured_output",
+			"schema": decoded,
+		},
+	}, nil
+}
+
+// structuredSystemPrompt appends the instructions of a structured answer to a system prompt
+// The instructions are given to every model, since not every model supports a response format.
+//
+// Parameters:
+//   - systemPrompt: the system prompt of the request
+//   - schema: the JSON Schema of the answer
+//
+// Returns:
+//   - string: the system prompt with the instructions
+func structuredSystemPrompt(systemPrompt string, schema string) string {
+	instructions := "Answer only with a JSON value matching the following JSON Schema, without any explanation or Markdown formatting:\n" + schema
+	if systemPrompt == "" {
+		return instructions
+	}
+	return systemPrompt + "\n\n" + instructions
+}
+
+// structuredRepairPrompt returns the request to correct an invalid structured answer
+//
+// Parameters:
+//   - validationErrors: the reasons the answer is invalid
+//
+// Returns:
+//   - string: the repair request
+func structuredRepairPrompt(validationErrors []string) string {
+	return "Your answer does not match the JSON Schema:\n- " + strings.Join(validationErrors, "\n- ") +
+		"\nAnswer again with only the corrected JSON value."
+}
+
+// parseStructuredAnswer extracts the JSON value of an answer and validates it
+// Models wrap JSON in Markdown code blocks or add text around it, so the outermost
+// object or array of the answer is used if the answer as a whole is not JSON.
+//
+// Parameters:
+//   - schema: the compiled JSON Schema
+//   - answer: the answer of the model
+//
+// Returns:
+//   - interface{}: the JSON value, nil if the answer holds no JSON
+//   - []string: the validation errors, empty if the value matches the schema
+func parseStructuredAnswer(schema *jsonschema.Schema, answer string) (interface{}, []string) {
+	text := strings.TrimSpace(answer)
+	if strings.HasPrefix(text, "```") {
+		text = strings.TrimPrefix(text, "```json")
+		text = strings.TrimPrefix(text, "```")
+		text = strings.TrimSuffix(strings.TrimSpace(text), "```")
+	}
+
+	var value interface{}
+	err := json.Unmarshal([]byte(text), &value)
+	if err != nil {
+		start := strings.IndexAny(text, "{[")
+		end := strings.LastIndexAny(text, "}]")
+		if start < 0 || end < start || json.Unmarshal([]byte(text[start:end+1]), &value) != nil {
+			return nil, []string{fmt.Sprintf("the answer is not valid JSON: %v", err)}
+		}
+	}
+
+	validationErrors := []string{}
+	for _, validationError := range schema.Validate(value) {
+		validationErrors = append(validationErrors, validationError.Error())
+	}
+	return value, validationErrors
+}
+
+// llmHandlerPerformStructuredRequest performs a chat request without streaming, sending a response format
+// to the models supporting one.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - input: the user input
+//   - history: the conversation history
+//   - systemPrompt: the system prompt
+//   - modelIds: the model IDs, tried in order as fallback chain
+//   - responseFormat: the response format
+//
+// Returns:
+//   - answer: the answer of the model
+//   - err: an error if the request failed
+func llmHandlerPerformStructuredRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, responseFormat map[string]interface{}) (answer string, err error) {
+	responseChannel := make(chan sharedtypes.HandlerResponse)
+
+	// Trace the request; the span is ended by sendRequest
+	ctx, _ = tracing.Start(ctx, "aali-llm.chat")
+	go sendRequest(ctx, config.GlobalConfig.LLM_HANDLER_ENDPOINT, "chat", input, "general", "false", false, history, 0, systemPrompt, responseChannel, modelIds, nil, nil, responseFormat, nil)
+
+	response := <-responseChannel
+	if response.Type == "error" {
+		return "", responseError(response)
+	}
+	return *response.ChatData, nil
+}
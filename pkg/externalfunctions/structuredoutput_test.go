@@ -0,0 +1,85 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/jsonschema"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStructuredAnswer(t *testing.T) {
+	schema, err := jsonschema.Compile([]byte(`{"type": "array", "items": {"type": "string"}}`))
+	require.NoError(t, err)
+
+	answers := map[string]string{
+		"plain":         `["mesh", "solver"]`,
+		"code block":    "```json\n[\"mesh\", \"solver\"]\n```",
+		"untagged code": "```\n[\"mesh\", \"solver\"]\n```",
+		"text around":   "Here are the keywords: [\"mesh\", \"solver\"]. Hope this helps!",
+	}
+	for name, answer := range answers {
+		t.Run(name, func(t *testing.T) {
+			value, validationErrors := parseStructuredAnswer(schema, answer)
+			assert.Empty(t, validationErrors)
+			assert.Equal(t, []interface{}{"mesh", "solver"}, value)
+		})
+	}
+
+	value, validationErrors := parseStructuredAnswer(schema, `["mesh", 3]`)
+	assert.Equal(t, []interface{}{"mesh", 3.0}, value)
+	assert.Equal(t, []string{"/1: expected string, got integer"}, validationErrors)
+
+	value, validationErrors = parseStructuredAnswer(schema, "mesh, solver")
+	assert.Nil(t, value)
+	assert.Len(t, validationErrors, 1)
+}
+
+func TestSupportsResponseFormat(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{}}
+	assert.False(t, supportsResponseFormat("gpt-4o"))
+	assert.False(t, supportsResponseFormat(""))
+
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_RESPONSE_FORMAT_MODELS"] = "gpt-4o, gpt-4o-mini"
+	assert.True(t, supportsResponseFormat("gpt-4o-mini"))
+	assert.False(t, supportsResponseFormat("llama"))
+	assert.False(t, supportsResponseFormat(""))
+
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_LLM_RESPONSE_FORMAT_MODELS"] = "*"
+	assert.True(t, supportsResponseFormat(""))
+}
+
+func TestStructuredResponseFormat(t *testing.T) {
+	format, err := structuredResponseFormat(`{"type": "object"}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"type":        "json_schema",
+		"json_schema": map[string]interface{}{"name": "structured_output", "schema": map[string]interface{}{"type": "object"}},
+	}, format)
+
+	_, err = structuredResponseFormat(`{"type": `)
+	assert.Error(t, err)
+}
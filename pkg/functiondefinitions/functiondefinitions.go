@@ -30,7 +30,9 @@ import (
 	"go/parser"
 	"go/token"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ansys/aali-flowkit/pkg/internalstates"
 	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
@@ -44,13 +46,19 @@ import (
 // The FunctionInput and FunctionOutput structs contain the name, type, and GoType of the input/output.
 // The GoType is the Go type of the input/output, while the Type is a simplified type string (e.g., "string", "number", "boolean", "json").
 //
-// The function returns an error if the file cannot be parsed.
+// The registry metadata of each function (@version, @since, @deprecated, @tags, @example, @cache) is stored in the
+// internalstates.AvailableFunctionsMetadata map, together with the input constraints
+// (@optional, @default, @min, @max, @pattern, @enum, @secret) annotated below the parameters.
+// Enum values are also published as the Options of the input definition.
+//
+// The function returns an error if the file cannot be parsed, if it exports a function
+// that is already defined by another file or if an input or cache annotation is invalid.
 //
 // Parameters:
 //   - packagePath: the path to the package file to parse.
 //
 // Returns:
-//   - error: an error if the file cannot be parsed.
+//   - error: an error if the file cannot be parsed or a function is defined twice.
 func ExtractFunctionDefinitionsFromPackage(content string, category string) error {
 	fset := token.NewFileSet() // positions are relative to fset
 
@@ -116,6 +124,11 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 		if fn, isFn := decl.(*ast.FuncDecl); isFn {
 			// Check if the function is exported
 			if fn.Name.IsExported() {
+				// Function names must be unique across all categories
+				if existing, exists := internalstates.AvailableFunctions[fn.Name.Name]; exists {
+					return fmt.Errorf("function %s of category %s is already defined in category %s", fn.Name.Name, category, existing.Category)
+				}
+
 				// Extract docstring text
 				description := fn.Doc.Text()
 				displayName := extractTagValue(description, "@displayName")
@@ -139,6 +152,11 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 								GoType: typeExprToString(param.Type),
 							})
 						} else {
+							// skip the request context, it is injected by the gRPC server
+							if isContextType(param.Type) {
+								continue
+							}
+
 							for _, paramName := range param.Names {
 								// skip if endpoint
 								if paramName.Name == "llmHandlerEndpoint" || paramName.Name == "knowledgeDbEndpoint" {
@@ -167,7 +185,13 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 
 				// Handle outputs (results)
 				if fn.Type.Results != nil {
-					for _, result := range fn.Type.Results.List {
+					results := fn.Type.Results.List
+					for i, result := range results {
+						// skip the trailing error, it is returned as gRPC status by the server
+						if i == len(results)-1 && len(result.Names) <= 1 && isErrorType(result.Type) {
+							continue
+						}
+
 						if len(result.Names) == 0 {
 							goType := typeExprToString(result.Type)
 							funcDef.Output = append(funcDef.Output, &aaliflowkitgrpc.FunctionOutputDefinition{
@@ -188,8 +212,26 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 					}
 				}
 
-				// Store the function definition
+				// Handle input constraints (annotations following the parameters)
+				metadata := extractFunctionMetadata(description)
+				metadata.Inputs, err = extractInputConstraints(description)
+				if err != nil {
+					return fmt.Errorf("invalid input annotation of function %s: %v", funcDef.Name, err)
+				}
+				err = applyInputConstraints(funcDef, metadata.Inputs)
+				if err != nil {
+					return fmt.Errorf("invalid input annotation of function %s: %v", funcDef.Name, err)
+				}
+
+				// Handle the time the outputs are cached for
+				metadata.CacheTTL, err = extractCacheTTL(description, funcDef)
+				if err != nil {
+					return fmt.Errorf("invalid cache annotation of function %s: %v", funcDef.Name, err)
+				}
+
+				// Store the function definition and its metadata
 				internalstates.AvailableFunctions[funcDef.Name] = funcDef
+				internalstates.AvailableFunctionsMetadata[funcDef.Name] = metadata
 			}
 		}
 	}
@@ -269,6 +311,34 @@ func typeExprToString(expr ast.Expr) string {
 	return cleanedTypeStr
 }
 
+// isContextType checks if an ast.Expr represents the type context.Context.
+//
+// Parameters:
+//   - expr: the ast.Expr representing the type.
+//
+// Returns:
+//   - bool: true if the type is context.Context, false otherwise.
+func isContextType(expr ast.Expr) bool {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := selector.X.(*ast.Ident)
+	return ok && pkg.Name == "context" && selector.Sel.Name == "Context"
+}
+
+// isErrorType checks if an ast.Expr represents the built-in error type.
+//
+// Parameters:
+//   - expr: the ast.Expr representing the type.
+//
+// Returns:
+//   - bool: true if the type is error, false otherwise.
+func isErrorType(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "error"
+}
+
 // extractTagValue extracts the value of a tag from a docstring.
 // The tag value is expected to be in the format "- tag: value".
 //
@@ -292,6 +362,239 @@ func extractTagValue(docText, tag string) string {
 	return ""
 }
 
+// extractTagValues extracts all values of a tag from a docstring.
+// Unlike extractTagValue, the tag may be given without value and may be repeated.
+//
+// Parameters:
+//   - docText: the docstring text to extract the tag values from.
+//   - tag: the tag to extract the values of.
+//
+// Returns:
+//   - []string: the trimmed values of the tag, in order of appearance.
+//   - bool: true if the tag is present at least once.
+func extractTagValues(docText, tag string) ([]string, bool) {
+	re := regexp.MustCompile(fmt.Sprintf(`(?m)^\s*- %s:[ \t]*(.*)$`, regexp.QuoteMeta(tag)))
+	matches := re.FindAllStringSubmatch(docText, -1)
+
+	values := []string{}
+	for _, match := range matches {
+		if value := strings.TrimSpace(match[1]); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values, len(matches) > 0
+}
+
+// extractFunctionMetadata extracts the registry metadata of a function from its docstring.
+//
+// Parameters:
+//   - docText: the docstring text of the function.
+//
+// Returns:
+//   - *internalstates.FunctionMetadata: the metadata of the function.
+func extractFunctionMetadata(docText string) *internalstates.FunctionMetadata {
+	metadata := &internalstates.FunctionMetadata{
+		Tags:     []string{},
+		Examples: []string{},
+	}
+
+	if versions, _ := extractTagValues(docText, "@version"); len(versions) > 0 {
+		metadata.Version = versions[0]
+	}
+	if since, _ := extractTagValues(docText, "@since"); len(since) > 0 {
+		metadata.Since = since[0]
+	}
+
+	deprecationMessages, deprecated := extractTagValues(docText, "@deprecated")
+	metadata.Deprecated = deprecated
+	metadata.DeprecationMessage = strings.Join(deprecationMessages, " ")
+
+	tagLists, _ := extractTagValues(docText, "@tags")
+	for _, tagList := range tagLists {
+		for _, tag := range strings.Split(tagList, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				metadata.Tags = append(metadata.Tags, tag)
+			}
+		}
+	}
+
+	metadata.Examples, _ = extractTagValues(docText, "@example")
+
+	return metadata
+}
+
+// extractInputConstraints extracts the input constraints of a function from its docstring.
+// The constraints are given as annotations in the "Parameters:" section and apply to
+// the parameter listed above them, e.g.:
+//
+//	Parameters:
+//	  - chunkSize: size of the chunks.
+//	  - @min: 1
+//	  - @default: 1000
+//
+// Parameters:
+//   - docText: the docstring text of the function.
+//
+// Returns:
+//   - map[string]*internalstates.InputConstraints: the constraints by parameter name.
+//   - error: an error if an annotation is unknown or its value is invalid.
+func extractInputConstraints(docText string) (map[string]*internalstates.InputConstraints, error) {
+	parameterRegex := regexp.MustCompile(`^\s*- (\w+):`)
+	annotationRegex := regexp.MustCompile(`^\s*- (@\w+):?[ \t]*(.*)$`)
+
+	constraints := map[string]*internalstates.InputConstraints{}
+	inParameters := false
+	parameterName := ""
+	for _, line := range strings.Split(docText, "\n") {
+		if strings.TrimSpace(line) == "Parameters:" {
+			inParameters = true
+			continue
+		}
+		if !inParameters {
+			continue
+		}
+
+		// the parameters section ends with the next unindented heading
+		if line != "" && line[0] != ' ' && line[0] != '\t' {
+			break
+		}
+
+		if match := annotationRegex.FindStringSubmatch(line); match != nil {
+			if parameterName == "" {
+				return nil, fmt.Errorf("annotation %s is not preceded by a parameter", match[1])
+			}
+			parameterConstraints, ok := constraints[parameterName]
+			if !ok {
+				parameterConstraints = &internalstates.InputConstraints{Required: true}
+				constraints[parameterName] = parameterConstraints
+			}
+			value := strings.TrimSpace(match[2])
+
+			switch match[1] {
+			case "@optional":
+				parameterConstraints.Required = false
+			case "@default":
+				parameterConstraints.Required = false
+				parameterConstraints.Default = &value
+			case "@min", "@max":
+				bound, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s value '%s' of parameter %s: %v", match[1], value, parameterName, err)
+				}
+				if match[1] == "@min" {
+					parameterConstraints.Min = &bound
+				} else {
+					parameterConstraints.Max = &bound
+				}
+			case "@pattern":
+				parameterConstraints.Pattern = value
+				if err := parameterConstraints.CompilePattern(); err != nil {
+					return nil, fmt.Errorf("%v of parameter %s", err, parameterName)
+				}
+			case "@enum":
+				parameterConstraints.Enum = []string{}
+				for _, option := range strings.Split(value, ",") {
+					if option = strings.TrimSpace(option); option != "" {
+						parameterConstraints.Enum = append(parameterConstraints.Enum, option)
+					}
+				}
+				if len(parameterConstraints.Enum) == 0 {
+					return nil, fmt.Errorf("empty @enum value of parameter %s", parameterName)
+				}
+			case "@secret":
+				parameterConstraints.Secret = true
+			default:
+				return nil, fmt.Errorf("unknown annotation %s of parameter %s", match[1], parameterName)
+			}
+			continue
+		}
+
+		if match := parameterRegex.FindStringSubmatch(line); match != nil {
+			parameterName = match[1]
+		}
+	}
+
+	return constraints, nil
+}
+
+// applyInputConstraints checks that the input constraints match the inputs of the function definition
+// and publishes the enum values as options of the inputs.
+// Inputs with options of an enumerable type get the options as enum constraint.
+//
+// Parameters:
+//   - funcDef: the function definition.
+//   - constraints: the input constraints by input name, completed in place.
+//
+// Returns:
+//   - error: an error if a constraint refers to an unknown input or does not fit its type.
+func applyInputConstraints(funcDef *aaliflowkitgrpc.FunctionDefinition, constraints map[string]*internalstates.InputConstraints) error {
+	inputs := map[string]*aaliflowkitgrpc.FunctionInputDefinition{}
+	for _, input := range funcDef.Input {
+		inputs[input.Name] = input
+	}
+
+	for name, inputConstraints := range constraints {
+		input, ok := inputs[name]
+		if !ok {
+			return fmt.Errorf("parameter %s is not an input of the function", name)
+		}
+		if (inputConstraints.Min != nil || inputConstraints.Max != nil) && input.Type != "number" {
+			return fmt.Errorf("@min and @max require a number input, parameter %s is of type %s", name, input.GoType)
+		}
+		if (inputConstraints.Pattern != "" || len(inputConstraints.Enum) > 0) && input.GoType != "string" {
+			return fmt.Errorf("@pattern and @enum require a string input, parameter %s is of type %s", name, input.GoType)
+		}
+		if inputConstraints.Secret && input.GoType != "string" {
+			return fmt.Errorf("@secret requires a string input, parameter %s is of type %s", name, input.GoType)
+		}
+		if len(inputConstraints.Enum) > 0 {
+			input.Options = inputConstraints.Enum
+		}
+	}
+
+	for _, input := range funcDef.Input {
+		if _, ok := constraints[input.Name]; !ok && len(input.Options) > 0 {
+			constraints[input.Name] = &internalstates.InputConstraints{Required: true, Enum: input.Options}
+		}
+	}
+
+	return nil
+}
+
+// extractCacheTTL extracts the time the outputs of a function are cached for from its docstring.
+// The outputs of functions without @cache tag are not cached. Streamed outputs cannot be cached.
+//
+// Parameters:
+//   - docText: the docstring text of the function.
+//   - funcDef: the function definition.
+//
+// Returns:
+//   - time.Duration: the cache duration, 0 if the outputs are not cached.
+//   - error: an error if the duration is invalid or the function streams its outputs.
+func extractCacheTTL(docText string, funcDef *aaliflowkitgrpc.FunctionDefinition) (time.Duration, error) {
+	values, cached := extractTagValues(docText, "@cache")
+	if !cached {
+		return 0, nil
+	}
+	if len(values) != 1 {
+		return 0, fmt.Errorf("@cache requires a single duration, e.g. @cache: 10m")
+	}
+
+	ttl, err := time.ParseDuration(values[0])
+	if err != nil {
+		return 0, fmt.Errorf("@cache requires a duration: %v", err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("@cache requires a positive duration, got %s", values[0])
+	}
+	for _, output := range funcDef.Output {
+		if strings.HasPrefix(output.GoType, "*chan") {
+			return 0, fmt.Errorf("output %s of type %s cannot be cached", output.Name, output.GoType)
+		}
+	}
+	return ttl, nil
+}
+
 // displayNameOrDefault returns the displayName if it is not empty, otherwise it returns the defaultName.
 //
 // Parameters:
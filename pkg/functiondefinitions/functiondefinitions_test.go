@@ -0,0 +1,222 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package functiondefinitions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPackage = `package externalfunctions
+
+// RephraseRequest performs a rephrase request to LLM
+//
+// Tags:
+//   - @displayName: Rephrase Request
+//   - @version: 2
+//   - @since: 1.1.0
+//   - @deprecated: superseded by RephraseRequestNew
+//   - @tags: llm, rephrase
+//   - @example: RephraseRequest("what is fluent?")
+//   - @example: RephraseRequest("how do I mesh?")
+//
+// Parameters:
+//   - query: the user query
+//
+// Returns:
+//   - rephrasedQuery: the rephrased query
+func RephraseRequest(query string) (rephrasedQuery string) {
+	return query
+}
+
+// AssignStringToString assigns a string to another string
+//
+// Tags:
+//   - @displayName: Assign String to String
+//   - @deprecated:
+//
+// Parameters:
+//   - inputString: the input string
+//
+// Returns:
+//   - outputString: the output string
+func AssignStringToString(inputString string) (outputString string) {
+	return inputString
+}
+`
+
+func TestExtractFunctionMetadata(t *testing.T) {
+	require := require.New(t)
+	internalstates.InitializeInternalStates()
+
+	err := ExtractFunctionDefinitionsFromPackage(testPackage, "llm_handler")
+	require.NoError(err)
+
+	metadata := internalstates.AvailableFunctionsMetadata["RephraseRequest"]
+	require.NotNil(metadata)
+	assert.Equal(t, "2", metadata.Version)
+	assert.Equal(t, "1.1.0", metadata.Since)
+	assert.True(t, metadata.Deprecated)
+	assert.Equal(t, "superseded by RephraseRequestNew", metadata.DeprecationMessage)
+	assert.Equal(t, []string{"llm", "rephrase"}, metadata.Tags)
+	assert.Equal(t, []string{`RephraseRequest("what is fluent?")`, `RephraseRequest("how do I mesh?")`}, metadata.Examples)
+	assert.Equal(t, "Rephrase Request", internalstates.AvailableFunctions["RephraseRequest"].DisplayName)
+
+	// a deprecation tag without message still marks the function as deprecated
+	metadata = internalstates.AvailableFunctionsMetadata["AssignStringToString"]
+	require.NotNil(metadata)
+	assert.True(t, metadata.Deprecated)
+	assert.Empty(t, metadata.DeprecationMessage)
+	assert.Empty(t, metadata.Version)
+	assert.Empty(t, metadata.Tags)
+}
+
+func TestExtractFunctionDefinitionsDuplicateName(t *testing.T) {
+	internalstates.InitializeInternalStates()
+
+	err := ExtractFunctionDefinitionsFromPackage(testPackage, "llm_handler")
+	require.NoError(t, err)
+
+	err = ExtractFunctionDefinitionsFromPackage(testPackage, "generic")
+	assert.ErrorContains(t, err, "function RephraseRequest of category generic is already defined in category llm_handler")
+}
+
+func TestExtractInputConstraints(t *testing.T) {
+	require := require.New(t)
+	internalstates.InitializeInternalStates()
+
+	err := ExtractFunctionDefinitionsFromPackage(`package externalfunctions
+
+type SplitMode string
+
+const (
+	recursive SplitMode = "recursive"
+	markdown  SplitMode = "markdown"
+)
+
+// SplitContent splits content into chunks.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - content: content to split.
+//   - @pattern: ^\S
+//   - @secret
+//   - documentType: type of document.
+//   - @enum: pdf, html, md
+//   - @default: pdf
+//   - chunkSize: size of the chunks.
+//   - @min: 1
+//   - @max: 8000
+//   - mode: the split mode.
+//   - verbose: log the chunks.
+//   - @optional
+//
+// Returns:
+//   - output: the chunks.
+func SplitContent(ctx context.Context, content string, documentType string, chunkSize int, mode SplitMode, verbose bool) (output []string) {
+	return nil
+}
+`, "data_extraction")
+	require.NoError(err)
+
+	inputs := internalstates.AvailableFunctionsMetadata["SplitContent"].Inputs
+	require.Len(inputs, 5)
+	content := &internalstates.InputConstraints{Required: true, Pattern: `^\S`, Secret: true}
+	require.NoError(content.CompilePattern())
+	assert.Equal(t, content, inputs["content"])
+	require.NotNil(inputs["documentType"].Default)
+	assert.Equal(t, "pdf", *inputs["documentType"].Default)
+	assert.False(t, inputs["documentType"].Required)
+	assert.Equal(t, []string{"pdf", "html", "md"}, inputs["documentType"].Enum)
+	assert.Equal(t, 1.0, *inputs["chunkSize"].Min)
+	assert.Equal(t, 8000.0, *inputs["chunkSize"].Max)
+	assert.Equal(t, []string{"recursive", "markdown"}, inputs["mode"].Enum)
+	assert.False(t, inputs["verbose"].Required)
+
+	// enum values are published as options of the input
+	functionDefinition := internalstates.AvailableFunctions["SplitContent"]
+	assert.Equal(t, []string{"pdf", "html", "md"}, functionDefinition.Input[1].Options)
+}
+
+func TestExtractInputConstraintsInvalid(t *testing.T) {
+	tests := map[string]string{
+		"//   - count: the count\n//   - @min: one":        "invalid @min value 'one' of parameter count",
+		"//   - count: the count\n//   - @pattern: ^\\w+$": "@pattern and @enum require a string input, parameter count is of type int",
+		"//   - query: the query\n//   - @max: 3":          "@min and @max require a number input, parameter query is of type string",
+		"//   - count: the count\n//   - @secret":          "@secret requires a string input, parameter count is of type int",
+		"//   - other: the other\n//   - @optional":        "parameter other is not an input of the function",
+		"//   - query: the query\n//   - @required":        "unknown annotation @required of parameter query",
+		"//   - query: the query\n//   - @pattern: [":      "invalid @pattern value '[': error parsing regexp: missing closing ]: `[` of parameter query",
+	}
+
+	for parameters, expected := range tests {
+		internalstates.InitializeInternalStates()
+		err := ExtractFunctionDefinitionsFromPackage(`package externalfunctions
+
+// Count counts.
+//
+// Parameters:
+`+parameters+`
+func Count(query string, count int) {}
+`, "generic")
+		assert.ErrorContains(t, err, expected)
+	}
+}
+
+func TestExtractCacheTTL(t *testing.T) {
+	tests := []struct {
+		tags     string
+		results  string
+		expected time.Duration
+		err      string
+	}{
+		{"", "string", 0, ""},
+		{"//   - @cache: 10m\n", "string", 10 * time.Minute, ""},
+		{"//   - @cache:\n", "string", 0, "@cache requires a single duration"},
+		{"//   - @cache: soon\n", "string", 0, "@cache requires a duration"},
+		{"//   - @cache: -1m\n", "string", 0, "@cache requires a positive duration, got -1m"},
+		{"//   - @cache: 1h\n", "*chan string", 0, "output *chan string of type *chan string cannot be cached"},
+	}
+
+	for _, test := range tests {
+		internalstates.InitializeInternalStates()
+		err := ExtractFunctionDefinitionsFromPackage(`package externalfunctions
+
+// Embed embeds.
+//
+// Tags:
+//   - @displayName: Embed
+`+test.tags+`func Embed(query string) `+test.results+` {}
+`, "llm_handler")
+		if test.err != "" {
+			assert.ErrorContains(t, err, "invalid cache annotation of function Embed: "+test.err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, internalstates.AvailableFunctionsMetadata["Embed"].CacheTTL)
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package functiondefinitions
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect of the generated schemas
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema describing a function input or output
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	// Stream marks an output that is streamed item by item (x-stream)
+	Stream bool `json:"x-stream,omitempty"`
+}
+
+// FunctionSchema holds the JSON Schemas of the inputs and outputs of a function
+type FunctionSchema struct {
+	Category   string  `json:"category"`
+	Deprecated bool    `json:"deprecated,omitempty"`
+	Inputs     *Schema `json:"inputs"`
+	Outputs    *Schema `json:"outputs"`
+}
+
+// Catalogue is a JSON Schema document describing the signatures of all functions
+// Named Go types are shared between the functions as definitions in $defs
+type Catalogue struct {
+	Schema    string                     `json:"$schema"`
+	Defs      map[string]*Schema         `json:"$defs"`
+	Functions map[string]*FunctionSchema `json:"functions"`
+}
+
+// GenerateCatalogue generates the JSON Schema catalogue of the given functions.
+// The Go types of the inputs and outputs are resolved from the signatures of the function values,
+// which also covers the types defined in other packages such as aali-sharedtypes.
+// The input constraints of internalstates.AvailableFunctionsMetadata are added to the input schemas.
+//
+// Parameters:
+//   - definitions: the function definitions by function name.
+//   - functions: the function values by function name, e.g. externalfunctions.ExternalFunctionsMap.
+//
+// Returns:
+//   - *Catalogue: the JSON Schema catalogue of the functions.
+func GenerateCatalogue(definitions map[string]*aaliflowkitgrpc.FunctionDefinition, functions map[string]interface{}) *Catalogue {
+	generator := &schemaGenerator{defs: map[string]*Schema{}}
+	catalogue := &Catalogue{
+		Schema:    JSONSchemaDraft,
+		Defs:      generator.defs,
+		Functions: map[string]*FunctionSchema{},
+	}
+
+	for name, definition := range definitions {
+		metadata, ok := internalstates.AvailableFunctionsMetadata[name]
+		if !ok {
+			metadata = &internalstates.FunctionMetadata{}
+		}
+
+		// resolve the parameter and result types, the request context and the trailing error are not part of the definition
+		var paramTypes, resultTypes []reflect.Type
+		if function, ok := functions[name]; ok && function != nil {
+			funcType := reflect.TypeOf(function)
+			for i := 0; i < funcType.NumIn(); i++ {
+				if i == 0 && funcType.In(i) == reflect.TypeOf((*context.Context)(nil)).Elem() {
+					continue
+				}
+				paramTypes = append(paramTypes, funcType.In(i))
+			}
+			for i := 0; i < funcType.NumOut(); i++ {
+				if i == funcType.NumOut()-1 && funcType.Out(i) == reflect.TypeOf((*error)(nil)).Elem() {
+					continue
+				}
+				resultTypes = append(resultTypes, funcType.Out(i))
+			}
+		}
+
+		inputs := &Schema{Type: "object", Properties: map[string]*Schema{}, Required: []string{}}
+		for i, input := range definition.Input {
+			var schema *Schema
+			if len(paramTypes) == len(definition.Input) {
+				schema = generator.typeSchema(paramTypes[i])
+			} else {
+				schema = simpleTypeSchema(input.Type)
+			}
+			constraints := metadata.Constraints(input.Name)
+			schema = withConstraints(schema, input, constraints)
+			inputs.Properties[input.Name] = schema
+			if constraints.Required {
+				inputs.Required = append(inputs.Required, input.Name)
+			}
+		}
+
+		outputs := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		for i, output := range definition.Output {
+			if len(resultTypes) == len(definition.Output) {
+				outputs.Properties[output.Name] = generator.typeSchema(resultTypes[i])
+			} else {
+				outputs.Properties[output.Name] = simpleTypeSchema(output.Type)
+			}
+		}
+
+		catalogue.Functions[name] = &FunctionSchema{
+			Category:   definition.Category,
+			Deprecated: metadata.Deprecated,
+			Inputs:     inputs,
+			Outputs:    outputs,
+		}
+	}
+
+	return catalogue
+}
+
+// schemaGenerator generates JSON Schemas for Go types and collects the definitions of named struct types
+type schemaGenerator struct {
+	defs map[string]*Schema
+}
+
+// typeSchema generates the JSON Schema of a Go type as it is (un)marshalled by encoding/json.
+//
+// Parameters:
+//   - t: the Go type.
+//
+// Returns:
+//   - *Schema: the JSON Schema of the type, named struct types are referenced from $defs.
+func (g *schemaGenerator) typeSchema(t reflect.Type) *Schema {
+	if t == reflect.TypeOf(time.Time{}) {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.typeSchema(t.Elem())
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		// byte slices are marshalled as base64 strings
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", ContentEncoding: "base64"}
+		}
+		return &Schema{Type: "array", Items: g.typeSchema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.typeSchema(t.Elem())}
+	case reflect.Chan:
+		schema := g.typeSchema(t.Elem())
+		schema.Stream = true
+		return schema
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		name := defName(t)
+		if _, exists := g.defs[name]; !exists {
+			// register the definition before generating it to support recursive types
+			g.defs[name] = &Schema{}
+			*g.defs[name] = *g.structSchema(t)
+		}
+		return &Schema{Ref: "#/$defs/" + name}
+	}
+
+	// interfaces and all other types accept any value
+	return &Schema{}
+}
+
+// structSchema generates the JSON Schema of the fields of a struct type.
+// Embedded structs without json tag are flattened like encoding/json does.
+//
+// Parameters:
+//   - t: the struct type.
+//
+// Returns:
+//   - *Schema: the object schema of the struct.
+func (g *schemaGenerator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedSchema := range g.structSchema(fieldType).Properties {
+				if _, exists := schema.Properties[embeddedName]; !exists {
+					schema.Properties[embeddedName] = embeddedSchema
+				}
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = g.typeSchema(field.Type)
+	}
+	return schema
+}
+
+// defName returns the name of the definition of a named type, e.g. "sharedtypes.DbFilters".
+//
+// Parameters:
+//   - t: the named type.
+//
+// Returns:
+//   - string: the definition name.
+func defName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if index := strings.LastIndex(pkg, "/"); index >= 0 {
+		pkg = pkg[index+1:]
+	}
+	name := t.Name()
+	if pkg != "" {
+		name = pkg + "." + name
+	}
+	// type parameters may contain characters that are not allowed in a JSON pointer
+	return regexp.MustCompile(`[^A-Za-z0-9_.]+`).ReplaceAllString(name, "_")
+}
+
+// simpleTypeSchema returns the JSON Schema of a simple type string as it is used
+// when the Go type of a function cannot be resolved.
+//
+// Parameters:
+//   - simpleType: the simple type ("string", "number", "boolean" or "json").
+//
+// Returns:
+//   - *Schema: the JSON Schema of the simple type.
+func simpleTypeSchema(simpleType string) *Schema {
+	switch simpleType {
+	case "string", "number", "boolean":
+		return &Schema{Type: simpleType}
+	}
+	return &Schema{}
+}
+
+// withConstraints adds the input constraints and options to the JSON Schema of an input.
+//
+// Parameters:
+//   - schema: the JSON Schema of the input type.
+//   - input: the input definition.
+//   - constraints: the constraints of the input.
+//
+// Returns:
+//   - *Schema: the JSON Schema of the input.
+func withConstraints(schema *Schema, input *aaliflowkitgrpc.FunctionInputDefinition, constraints *internalstates.InputConstraints) *Schema {
+	if len(input.Options) > 0 {
+		schema.Enum = input.Options
+	}
+	schema.Minimum = constraints.Min
+	schema.Maximum = constraints.Max
+	schema.Pattern = constraints.Pattern
+	if constraints.Default != nil {
+		schema.Default = defaultValue(*constraints.Default, schema.Type)
+	}
+	return schema
+}
+
+// defaultValue converts the string value of a @default annotation into the JSON value of the schema type.
+//
+// Parameters:
+//   - value: the default value as annotated.
+//   - schemaType: the JSON Schema type of the input.
+//
+// Returns:
+//   - interface{}: the default value, the string value if it cannot be converted.
+func defaultValue(value string, schemaType string) interface{} {
+	switch schemaType {
+	case "string":
+		return value
+	case "integer", "number":
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number
+		}
+	case "boolean":
+		if boolean, err := strconv.ParseBool(value); err == nil {
+			return boolean
+		}
+	default:
+		var jsonValue interface{}
+		if err := json.Unmarshal([]byte(value), &jsonValue); err == nil {
+			return jsonValue
+		}
+	}
+	return value
+}
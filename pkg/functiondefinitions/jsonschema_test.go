@@ -0,0 +1,122 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package functiondefinitions
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBase struct {
+	Guid string `json:"guid"`
+}
+
+type testNode struct {
+	testBase
+	Name     string            `json:"name,omitempty"`
+	Created  time.Time         `json:"created"`
+	Content  []byte            `json:"content"`
+	Metadata map[string]any    `json:"metadata"`
+	Children []*testNode       `json:"children"`
+	Labels   map[string]string `json:"-"`
+	internal string
+}
+
+func TestGenerateCatalogue(t *testing.T) {
+	require := require.New(t)
+	internalstates.InitializeInternalStates()
+
+	err := ExtractFunctionDefinitionsFromPackage(`package externalfunctions
+
+// Expand expands a node.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - node: the node to expand.
+//   - depth: the depth of the expansion.
+//   - @default: 2
+//   - @min: 1
+//
+// Returns:
+//   - expanded: the expanded nodes.
+//   - stream: the stream of node names.
+func Expand(ctx context.Context, node testNode, depth int) (expanded []testNode, stream *chan string, err error) {
+	return nil, nil, nil
+}
+`, "graph")
+	require.NoError(err)
+	internalstates.AvailableFunctions["Unresolved"] = &aaliflowkitgrpc.FunctionDefinition{
+		Name:     "Unresolved",
+		Category: "generic",
+		Input:    []*aaliflowkitgrpc.FunctionInputDefinition{{Name: "value", Type: "number", GoType: "float64"}},
+	}
+
+	expand := func(ctx context.Context, node testNode, depth int) ([]testNode, *chan string, error) {
+		return nil, nil, nil
+	}
+	catalogue := GenerateCatalogue(internalstates.AvailableFunctions, map[string]interface{}{"Expand": expand})
+
+	// the catalogue is a valid JSON document
+	_, err = json.Marshal(catalogue)
+	require.NoError(err)
+	assert.Equal(t, JSONSchemaDraft, catalogue.Schema)
+
+	function := catalogue.Functions["Expand"]
+	require.NotNil(function)
+	assert.Equal(t, "graph", function.Category)
+	assert.Equal(t, []string{"node"}, function.Inputs.Required)
+	assert.Equal(t, &Schema{Ref: "#/$defs/functiondefinitions.testNode"}, function.Inputs.Properties["node"])
+	depth := function.Inputs.Properties["depth"]
+	assert.Equal(t, "integer", depth.Type)
+	assert.Equal(t, 2.0, depth.Default)
+	assert.Equal(t, 1.0, *depth.Minimum)
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: "#/$defs/functiondefinitions.testNode"}}, function.Outputs.Properties["expanded"])
+	assert.Equal(t, &Schema{Type: "string", Stream: true}, function.Outputs.Properties["stream"])
+
+	node := catalogue.Defs["functiondefinitions.testNode"]
+	require.NotNil(node)
+	assert.Equal(t, "object", node.Type)
+	assert.ElementsMatch(t, []string{"guid", "name", "created", "content", "metadata", "children"}, keys(node.Properties))
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, node.Properties["created"])
+	assert.Equal(t, &Schema{Type: "string", ContentEncoding: "base64"}, node.Properties["content"])
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{}}, node.Properties["metadata"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: "#/$defs/functiondefinitions.testNode"}}, node.Properties["children"])
+
+	// functions without function value fall back to the simple types
+	assert.Equal(t, &Schema{Type: "number"}, catalogue.Functions["Unresolved"].Inputs.Properties["value"])
+}
+
+func keys(properties map[string]*Schema) []string {
+	names := []string{}
+	for name := range properties {
+		names = append(names, name)
+	}
+	return names
+}
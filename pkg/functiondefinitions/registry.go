@@ -0,0 +1,166 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package functiondefinitions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+)
+
+// LoadRegistry loads the function definitions and metadata generated by internal/gen/registry
+// into internalstates.AvailableFunctions and internalstates.AvailableFunctionsMetadata.
+//
+// Parameters:
+//   - definitions: the generated function definitions, e.g. externalfunctions.FunctionDefinitions.
+//   - metadata: the generated function metadata by function name, e.g. externalfunctions.FunctionsMetadata.
+//
+// Returns:
+//   - error: an error if a function is defined twice or an input pattern is invalid.
+func LoadRegistry(definitions []*aaliflowkitgrpc.FunctionDefinition, metadata map[string]*internalstates.FunctionMetadata) error {
+	for _, definition := range definitions {
+		if existing, exists := internalstates.AvailableFunctions[definition.Name]; exists {
+			return fmt.Errorf("function %s of category %s is already defined in category %s", definition.Name, definition.Category, existing.Category)
+		}
+		functionMetadata, ok := metadata[definition.Name]
+		if !ok {
+			functionMetadata = &internalstates.FunctionMetadata{Tags: []string{}, Examples: []string{}}
+		}
+		if err := functionMetadata.CompilePatterns(); err != nil {
+			return fmt.Errorf("invalid input annotation of function %s: %v", definition.Name, err)
+		}
+		internalstates.AvailableFunctions[definition.Name] = definition
+		internalstates.AvailableFunctionsMetadata[definition.Name] = functionMetadata
+	}
+	return nil
+}
+
+// CheckConsistency checks that the function definitions of internalstates.AvailableFunctions
+// agree with the dispatch map of the external functions: every definition has a function,
+// every function has a definition, and the inputs and outputs match the function signature.
+//
+// Parameters:
+//   - functions: the function values by function name, e.g. externalfunctions.ExternalFunctionsMap.
+//
+// Returns:
+//   - error: an error listing all disagreements, nil if there are none.
+func CheckConsistency(functions map[string]interface{}) error {
+	problems := []string{}
+
+	for name, definition := range internalstates.AvailableFunctions {
+		function, exists := functions[name]
+		if !exists || function == nil {
+			problems = append(problems, fmt.Sprintf("function %s is defined but missing from the dispatch map", name))
+			continue
+		}
+		problems = append(problems, checkSignature(definition, reflect.TypeOf(function))...)
+	}
+	for name := range functions {
+		if _, exists := internalstates.AvailableFunctions[name]; !exists {
+			problems = append(problems, fmt.Sprintf("function %s is in the dispatch map but has no definition", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("function registry and dispatch map disagree:\n%s", strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// checkSignature compares the inputs and outputs of a function definition with the function signature.
+// The request context and a trailing error are not part of the definition.
+//
+// Parameters:
+//   - definition: the function definition.
+//   - funcType: the type of the function value.
+//
+// Returns:
+//   - []string: the disagreements, empty if there are none.
+func checkSignature(definition *aaliflowkitgrpc.FunctionDefinition, funcType reflect.Type) []string {
+	if funcType.Kind() != reflect.Func {
+		return []string{fmt.Sprintf("function %s is not a function but %s", definition.Name, funcType)}
+	}
+
+	paramTypes := []reflect.Type{}
+	for i := 0; i < funcType.NumIn(); i++ {
+		if i == 0 && funcType.In(i) == reflect.TypeOf((*context.Context)(nil)).Elem() {
+			continue
+		}
+		paramTypes = append(paramTypes, funcType.In(i))
+	}
+	resultTypes := []reflect.Type{}
+	for i := 0; i < funcType.NumOut(); i++ {
+		if i == funcType.NumOut()-1 && funcType.Out(i) == reflect.TypeOf((*error)(nil)).Elem() {
+			continue
+		}
+		resultTypes = append(resultTypes, funcType.Out(i))
+	}
+
+	if len(paramTypes) != len(definition.Input) {
+		return []string{fmt.Sprintf("function %s takes %d inputs, but its definition has %d", definition.Name, len(paramTypes), len(definition.Input))}
+	}
+	if len(resultTypes) != len(definition.Output) {
+		return []string{fmt.Sprintf("function %s returns %d outputs, but its definition has %d", definition.Name, len(resultTypes), len(definition.Output))}
+	}
+
+	problems := []string{}
+	for i, input := range definition.Input {
+		// the inputs of enumerable types are published as strings
+		if len(input.Options) > 0 && paramTypes[i].Kind() == reflect.String {
+			continue
+		}
+		if normalizeTypeName(input.GoType) != normalizeTypeName(paramTypes[i].String()) {
+			problems = append(problems, fmt.Sprintf("input %s of function %s is of type %s, but its definition has %s", input.Name, definition.Name, paramTypes[i], input.GoType))
+		}
+	}
+	for i, output := range definition.Output {
+		if normalizeTypeName(output.GoType) != normalizeTypeName(resultTypes[i].String()) {
+			problems = append(problems, fmt.Sprintf("output %s of function %s is of type %s, but its definition has %s", output.Name, definition.Name, resultTypes[i], output.GoType))
+		}
+	}
+	return problems
+}
+
+// normalizeTypeName normalizes a Go type name, so that the type names of the source code
+// and of the reflect package can be compared.
+//
+// Parameters:
+//   - typeName: the Go type name.
+//
+// Returns:
+//   - string: the type name without package prefixes and aliases.
+func normalizeTypeName(typeName string) string {
+	typeName = regexp.MustCompile(`\b[a-zA-Z_]\w*\.`).ReplaceAllString(typeName, "")
+	typeName = strings.ReplaceAll(typeName, "interface {}", "interface{}")
+	typeName = regexp.MustCompile(`\bany\b`).ReplaceAllString(typeName, "interface{}")
+	typeName = regexp.MustCompile(`\bbyte\b`).ReplaceAllString(typeName, "uint8")
+	typeName = regexp.MustCompile(`\brune\b`).ReplaceAllString(typeName, "int32")
+	return typeName
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package functiondefinitions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRegistry(t *testing.T) {
+	internalstates.InitializeInternalStates()
+	definitions := []*aaliflowkitgrpc.FunctionDefinition{
+		{Name: "Concat", Category: "generic"},
+		{Name: "Split", Category: "generic"},
+	}
+	metadata := map[string]*internalstates.FunctionMetadata{"Concat": {
+		Version: "2",
+		Inputs:  map[string]*internalstates.InputConstraints{"separator": {Required: true, Pattern: `^\S$`}},
+	}}
+
+	require.NoError(t, LoadRegistry(definitions, metadata))
+	assert.Len(t, internalstates.AvailableFunctions, 2)
+	assert.Equal(t, "2", internalstates.AvailableFunctionsMetadata["Concat"].Version)
+	assert.NotNil(t, internalstates.AvailableFunctionsMetadata["Split"])
+
+	// the patterns are compiled once the registry is loaded
+	matches, err := internalstates.AvailableFunctionsMetadata["Concat"].Constraints("separator").MatchPattern(",")
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	err = LoadRegistry([]*aaliflowkitgrpc.FunctionDefinition{{Name: "Split", Category: "data_extraction"}}, nil)
+	assert.EqualError(t, err, "function Split of category data_extraction is already defined in category generic")
+
+	// invalid patterns are reported when the registry is loaded, not per request
+	metadata = map[string]*internalstates.FunctionMetadata{"Join": {
+		Inputs: map[string]*internalstates.InputConstraints{"separator": {Required: true, Pattern: `[`}},
+	}}
+	err = LoadRegistry([]*aaliflowkitgrpc.FunctionDefinition{{Name: "Join", Category: "generic"}}, metadata)
+	assert.ErrorContains(t, err, "invalid input annotation of function Join: input separator: invalid @pattern value '['")
+}
+
+func TestCheckConsistency(t *testing.T) {
+	internalstates.InitializeInternalStates()
+	internalstates.AvailableFunctions["Concat"] = &aaliflowkitgrpc.FunctionDefinition{
+		Name:   "Concat",
+		Input:  []*aaliflowkitgrpc.FunctionInputDefinition{{Name: "values", GoType: "[]string"}, {Name: "separator", GoType: "string"}},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{{Name: "result", GoType: "string"}},
+	}
+	internalstates.AvailableFunctions["Decode"] = &aaliflowkitgrpc.FunctionDefinition{
+		Name:   "Decode",
+		Input:  []*aaliflowkitgrpc.FunctionInputDefinition{{Name: "data", GoType: "[]byte"}, {Name: "fields", GoType: "map[string]any"}},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{{Name: "decoded", GoType: "interface{}"}},
+	}
+
+	concat := func(ctx context.Context, values []string, separator string) (string, error) { return "", nil }
+	decode := func(data []byte, fields map[string]interface{}) any { return nil }
+	require.NoError(t, CheckConsistency(map[string]interface{}{"Concat": concat, "Decode": decode}))
+
+	err := CheckConsistency(map[string]interface{}{"Concat": concat, "Split": concat})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "function Decode is defined but missing from the dispatch map")
+	assert.Contains(t, err.Error(), "function Split is in the dispatch map but has no definition")
+
+	err = CheckConsistency(map[string]interface{}{"Concat": func(values []string) string { return "" }, "Decode": decode})
+	assert.ErrorContains(t, err, "function Concat takes 1 inputs, but its definition has 2")
+
+	err = CheckConsistency(map[string]interface{}{"Concat": func(values []int, separator string) string { return "" }, "Decode": decode})
+	assert.ErrorContains(t, err, "input values of function Concat is of type []int, but its definition has []string")
+}
@@ -23,6 +23,7 @@
 package functiontesting
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
@@ -38,7 +39,11 @@ import (
 //   - indexName: the name of the index to search
 //   - query: the query string to search for
 func TestAnsysGPTACSSearchIndex(indexName string, query string) {
-	embeddedQuery := externalfunctions.PerformVectorEmbeddingRequest(query)
+	embeddedQuery, err := externalfunctions.PerformVectorEmbeddingRequest(context.Background(), query)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 
 	// defaultFields := []sharedtypes.AnsysGPTDefaultFields{
 	// 	{QueryWord: "course", FieldName: "type_of_asset", FieldDefaultValue: "aic"},
@@ -64,6 +69,10 @@ func TestAnsysGPTACSSearchIndex(indexName string, query string) {
 	// Extract fields from the query
 	// filter := externalfunctions.AnsysGPTExtractFieldsFromQuery(query, filedValues, defaultFields)
 	// output := externalfunctions.AnsysGPTACSSemanticHybridSearchs(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexNames, filter, 10)
-	output := externalfunctions.AisAcsSemanticHybridSearchs(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexNames, physics, 10)
+	output, err := externalfunctions.AisAcsSemanticHybridSearchs(context.Background(), acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexNames, physics, 10)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	fmt.Println(len(output))
 }
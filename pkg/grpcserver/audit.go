@@ -0,0 +1,173 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/audit"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/ratelimit"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// functionAudit records the function invocations, nil if auditing is disabled
+var functionAudit *auditLog
+
+// auditLog writes the audit records of the function invocations to a sink
+type auditLog struct {
+	sink     audit.Sink
+	redactor *audit.Redactor
+}
+
+// newAuditLog creates the audit log from the workflow config variables
+//
+// Returns:
+// - *auditLog: the audit log, nil if auditing is disabled
+// - error: an error if the configuration is invalid or the audit file cannot be opened
+func newAuditLog() (*auditLog, error) {
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+
+	var sink audit.Sink
+	switch sinkType := variables["FLOWKIT_AUDIT_SINK"]; sinkType {
+	case "", "none":
+		return nil, nil
+	case "stdout":
+		sink = audit.NewWriterSink(os.Stdout)
+	case "file":
+		path := variables["FLOWKIT_AUDIT_FILE"]
+		if path == "" {
+			path = "audit.jsonl"
+		}
+		maxSize, err := intConfigVariable("FLOWKIT_AUDIT_FILE_MAX_SIZE_MB", 100)
+		if err != nil {
+			return nil, err
+		}
+		maxBackups, err := intConfigVariable("FLOWKIT_AUDIT_FILE_MAX_BACKUPS", 5)
+		if err != nil {
+			return nil, err
+		}
+		sink, err = audit.NewFileSink(path, int64(maxSize)*1024*1024, maxBackups)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q, expected \"file\", \"stdout\" or \"none\"", sinkType)
+	}
+
+	maxValueLength, err := intConfigVariable("FLOWKIT_AUDIT_MAX_VALUE_LENGTH", 4096)
+	if err != nil {
+		return nil, err
+	}
+	sensitiveInputs := []string{}
+	if value := variables["FLOWKIT_AUDIT_SENSITIVE_INPUTS"]; value != "" {
+		sensitiveInputs = strings.Split(value, ",")
+	}
+	return &auditLog{sink: sink, redactor: audit.NewRedactor(sensitiveInputs, maxValueLength)}, nil
+}
+
+// record writes the audit record of a finished function invocation
+// Failing writes are logged, so the audit log does not fail the invocation
+//
+// Parameters:
+// - ctx: the context of the request
+// - method: the gRPC method, e.g. "RunFunction"
+// - functionName: the name of the called function
+// - inputs: the recorded inputs of the invocation
+// - start: the time the invocation started
+// - err: the error returned to the client
+func (a *auditLog) record(ctx context.Context, method string, functionName string, inputs []audit.Input, start time.Time, err error) {
+	if a == nil {
+		return
+	}
+
+	record := audit.Record{
+		Time:       start.UTC(),
+		Method:     method,
+		Function:   functionName,
+		Inputs:     inputs,
+		DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+		Outcome:    status.Code(err).String(),
+	}
+	if err != nil {
+		record.Error = redact.String(status.Convert(err).Message())
+	}
+	if functionDefinition, ok := internalstates.AvailableFunctions[functionName]; ok {
+		record.Category = functionDefinition.Category
+	}
+	// the API key is hashed like by the rate limiter, so both can be correlated
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["x-api-key"]) > 0 {
+		record.APIKeyHash = ratelimit.KeyID(md["x-api-key"][0])
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Peer = p.Addr.String()
+	}
+
+	if err := a.sink.Write(record); err != nil {
+		redact.Log.Errorf(&logging.ContextMap{}, "failed to write audit record of function %s: %v", functionName, err)
+	}
+}
+
+// inputs converts the request inputs of a function to audit inputs with redacted values
+// The inputs are named by the function definition, the request names are used for unknown functions
+//
+// Parameters:
+// - functionName: the name of the called function
+// - inputs: the inputs of the request, nil entries are skipped
+//
+// Returns:
+// - []audit.Input: the audit inputs
+func (a *auditLog) inputs(functionName string, inputs []*aaliflowkitgrpc.FunctionInput) []audit.Input {
+	if a == nil {
+		return nil
+	}
+
+	functionDefinition := internalstates.AvailableFunctions[functionName]
+	auditInputs := []audit.Input{}
+	for i, input := range inputs {
+		if input == nil {
+			continue
+		}
+		name := input.Name
+		if functionDefinition != nil && i < len(functionDefinition.Input) {
+			name = functionDefinition.Input[i].Name
+		}
+		// the value is redacted if the definition or the request name the input as sensitive or secret
+		value := a.redactor.Redact(name, input.Value)
+		if a.redactor.Sensitive(input.Name) || isSecretInput(functionName, name) || isSecretInput(functionName, input.Name) {
+			value = audit.RedactedValue
+		}
+		auditInputs = append(auditInputs, audit.Input{Name: name, Value: value})
+	}
+	return auditInputs
+}
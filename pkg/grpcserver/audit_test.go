@@ -0,0 +1,115 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/audit"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// memorySink keeps the audit records in memory
+type memorySink struct {
+	mutex   sync.Mutex
+	records []audit.Record
+}
+
+func (s *memorySink) Write(record audit.Record) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
+
+func TestAuditLog(t *testing.T) {
+	loadRegistry(t)
+	sink := &memorySink{}
+	functionAudit = &auditLog{sink: sink, redactor: audit.NewRedactor([]string{"separator"}, 0)}
+	defer func() { functionAudit = nil }()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "some-key"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}})
+
+	// the inputs are named by the definition and configured sensitive inputs are redacted
+	_, err := (&server{}).RunFunction(ctx, &aaliflowkitgrpc.FunctionInputs{Name: "StringConcat", Inputs: functionInputs("aali", "flowkit", "-")})
+	require.NoError(t, err)
+
+	// calls of unknown functions are recorded with the request names and default sensitive inputs are redacted
+	_, err = (&server{}).RunFunction(ctx, &aaliflowkitgrpc.FunctionInputs{Name: "UnknownFunction", Inputs: []*aaliflowkitgrpc.FunctionInput{
+		{Name: "githubAccessToken", Value: "ghp_secret"},
+		{Name: "query", Value: "MATCH (n) RETURN n"},
+	}})
+	require.Error(t, err)
+
+	require.Len(t, sink.records, 2)
+	record := sink.records[0]
+	assert.Equal(t, "RunFunction", record.Method)
+	assert.Equal(t, "StringConcat", record.Function)
+	assert.Equal(t, "generic", record.Category)
+	assert.Equal(t, "10.0.0.1:5000", record.Peer)
+	assert.Len(t, record.APIKeyHash, 64)
+	assert.NotContains(t, record.APIKeyHash, "some-key")
+	assert.Equal(t, codes.OK.String(), record.Outcome)
+	assert.Empty(t, record.Error)
+	assert.Equal(t, []audit.Input{{Name: "a", Value: "aali"}, {Name: "b", Value: "flowkit"}, {Name: "separator", Value: audit.RedactedValue}}, record.Inputs)
+
+	record = sink.records[1]
+	assert.Equal(t, codes.NotFound.String(), record.Outcome)
+	assert.NotEmpty(t, record.Error)
+	assert.Empty(t, record.Category)
+	assert.Equal(t, []audit.Input{{Name: "githubAccessToken", Value: audit.RedactedValue}, {Name: "query", Value: "MATCH (n) RETURN n"}}, record.Inputs)
+}
+
+func TestNewAuditLog(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{}}
+	auditLog, err := newAuditLog()
+	require.NoError(t, err)
+	assert.Nil(t, auditLog)
+
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_AUDIT_SINK"] = "syslog"
+	_, err = newAuditLog()
+	assert.Error(t, err)
+
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_AUDIT_SINK"] = "file"
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_AUDIT_FILE"] = t.TempDir() + "/audit.jsonl"
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_AUDIT_SENSITIVE_INPUTS"] = "query, password"
+	auditLog, err = newAuditLog()
+	require.NoError(t, err)
+	defer auditLog.sink.Close()
+	assert.True(t, auditLog.redactor.Sensitive("password"))
+	assert.True(t, auditLog.redactor.Sensitive("jwtToken"))
+}
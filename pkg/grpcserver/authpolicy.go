@@ -0,0 +1,210 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/ratelimit"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"gopkg.in/yaml.v2"
+)
+
+// authPolicyWildcard allows all functions or all categories
+const authPolicyWildcard = "*"
+
+// authPolicyFile is the structure of the authorization policy file
+//
+// Example:
+//
+//	scopes:
+//	  meshpilot:
+//	    categories: ["ansys_mesh_pilot", "llm_handler"]
+//	keys:
+//	  - key: "meshpilot-client-key"
+//	    scopes: ["meshpilot"]
+//	    functions: ["SendRestAPICall"]
+//	    rateLimit: 5
+//	    dailyQuota: 10000
+type authPolicyFile struct {
+	Scopes map[string]authRule `yaml:"scopes"`
+	Keys   []authKey           `yaml:"keys"`
+}
+
+// authRule lists the function names and categories a key or scope is allowed to call
+type authRule struct {
+	Functions  []string `yaml:"functions"`
+	Categories []string `yaml:"categories"`
+}
+
+// authKey assigns scopes and additional functions or categories to an API key
+// The rate limit, burst and daily quota replace the limits of the server for the key if set
+type authKey struct {
+	Key        string   `yaml:"key"`
+	Scopes     []string `yaml:"scopes"`
+	RateLimit  float64  `yaml:"rateLimit"`
+	RateBurst  int      `yaml:"rateBurst"`
+	DailyQuota int      `yaml:"dailyQuota"`
+	authRule   `yaml:",inline"`
+}
+
+// authPolicy maps API keys to their permissions and rate limits
+type authPolicy struct {
+	keys   map[string]*keyPermissions
+	limits map[string]ratelimit.Limit
+}
+
+// keyPermissions holds the resolved permissions of an API key
+type keyPermissions struct {
+	allFunctions bool
+	functions    map[string]bool
+	categories   map[string]bool
+}
+
+// permissionsContextKey is the context key under which the permissions of the caller are stored
+type permissionsContextKey struct{}
+
+// loadAuthPolicy loads the authorization policy from a YAML file
+//
+// Parameters:
+// - path: the path to the policy file
+//
+// Returns:
+// - *authPolicy: the loaded policy
+// - error: an error if the file cannot be read or is invalid
+func loadAuthPolicy(path string) (*authPolicy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorization policy file: %v", err)
+	}
+
+	var file authPolicyFile
+	err = yaml.UnmarshalStrict(content, &file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse authorization policy file: %v", err)
+	}
+
+	policy := &authPolicy{keys: map[string]*keyPermissions{}, limits: map[string]ratelimit.Limit{}}
+	for i, key := range file.Keys {
+		if key.Key == "" {
+			return nil, fmt.Errorf("key %d of the authorization policy has no value", i)
+		}
+		if _, exists := policy.keys[key.Key]; exists {
+			return nil, fmt.Errorf("key %d of the authorization policy is defined more than once", i)
+		}
+
+		permissions := &keyPermissions{functions: map[string]bool{}, categories: map[string]bool{}}
+		permissions.add(key.authRule)
+		for _, scopeName := range key.Scopes {
+			scope, exists := file.Scopes[scopeName]
+			if !exists {
+				return nil, fmt.Errorf("key %d of the authorization policy references unknown scope '%s'", i, scopeName)
+			}
+			permissions.add(scope)
+		}
+		policy.keys[key.Key] = permissions
+
+		if key.RateLimit < 0 || key.RateBurst < 0 || key.DailyQuota < 0 {
+			return nil, fmt.Errorf("key %d of the authorization policy has a negative rate limit, burst or daily quota", i)
+		}
+		if key.RateLimit > 0 || key.RateBurst > 0 || key.DailyQuota > 0 {
+			policy.limits[key.Key] = ratelimit.Limit{Rate: key.RateLimit, Burst: key.RateBurst, DailyQuota: key.DailyQuota}
+		}
+	}
+
+	policy.warnUnknownEntries()
+	return policy, nil
+}
+
+// add adds the functions and categories of a rule to the permissions
+//
+// Parameters:
+// - rule: the rule to add
+func (p *keyPermissions) add(rule authRule) {
+	for _, function := range rule.Functions {
+		if function == authPolicyWildcard {
+			p.allFunctions = true
+		}
+		p.functions[function] = true
+	}
+	for _, category := range rule.Categories {
+		if category == authPolicyWildcard {
+			p.allFunctions = true
+		}
+		p.categories[category] = true
+	}
+}
+
+// allows checks if the permissions allow calling the given function
+//
+// Parameters:
+// - function: the definition of the function
+//
+// Returns:
+// - bool: true if the function may be called
+func (p *keyPermissions) allows(function *aaliflowkitgrpc.FunctionDefinition) bool {
+	return p.allFunctions || p.functions[function.Name] || p.categories[function.Category]
+}
+
+// warnUnknownEntries logs a warning for functions and categories of the policy that are not available
+func (policy *authPolicy) warnUnknownEntries() {
+	categories := map[string]bool{}
+	for _, function := range internalstates.AvailableFunctions {
+		categories[function.Category] = true
+	}
+
+	for _, permissions := range policy.keys {
+		for function := range permissions.functions {
+			if _, exists := internalstates.AvailableFunctions[function]; !exists && function != authPolicyWildcard {
+				redact.Log.Warnf(&logging.ContextMap{}, "authorization policy references unknown function '%s'", function)
+			}
+		}
+		for category := range permissions.categories {
+			if !categories[category] && category != authPolicyWildcard {
+				redact.Log.Warnf(&logging.ContextMap{}, "authorization policy references unknown category '%s'", category)
+			}
+		}
+	}
+}
+
+// authorizeFunction checks if the caller stored in the context may call the given function
+// Callers authenticated with the global API key, or requests without authentication, may call every function
+//
+// Parameters:
+// - ctx: the context of the request
+// - function: the definition of the function
+//
+// Returns:
+// - bool: true if the function may be called
+func authorizeFunction(ctx context.Context, function *aaliflowkitgrpc.FunctionDefinition) bool {
+	permissions, ok := ctx.Value(permissionsContextKey{}).(*keyPermissions)
+	if !ok {
+		return true
+	}
+	return permissions.allows(function)
+}
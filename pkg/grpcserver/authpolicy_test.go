@@ -0,0 +1,97 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthPolicy(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+
+	policyFile := filepath.Join(t.TempDir(), "auth_policy.yaml")
+	err := os.WriteFile(policyFile, []byte(`
+scopes:
+  meshpilot:
+    categories: ["ansys_mesh_pilot"]
+keys:
+  - key: "meshpilot-key"
+    scopes: ["meshpilot"]
+    functions: ["PerformVectorEmbeddingRequest"]
+`), 0o600)
+	require.NoError(err)
+
+	policy, err := loadAuthPolicy(policyFile)
+	require.NoError(err)
+
+	meshPilotFunction := &aaliflowkitgrpc.FunctionDefinition{Name: "SimilartitySearchOnPathDescriptions", Category: "ansys_mesh_pilot"}
+	embeddingFunction := &aaliflowkitgrpc.FunctionDefinition{Name: "PerformVectorEmbeddingRequest", Category: "llm_handler"}
+	dataFunction := &aaliflowkitgrpc.FunctionDefinition{Name: "AddDataRequest", Category: "knowledge_db"}
+
+	incoming := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", key))
+	}
+
+	// policy key is restricted to its scopes and functions
+	ctx, err := authenticate(incoming("meshpilot-key"), "global-key", policy)
+	require.NoError(err)
+	assert.True(authorizeFunction(ctx, meshPilotFunction))
+	assert.True(authorizeFunction(ctx, embeddingFunction))
+	assert.False(authorizeFunction(ctx, dataFunction))
+
+	// global key may call everything
+	ctx, err = authenticate(incoming("global-key"), "global-key", policy)
+	require.NoError(err)
+	assert.True(authorizeFunction(ctx, dataFunction))
+
+	// unknown key is rejected
+	_, err = authenticate(incoming("other-key"), "global-key", policy)
+	assert.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthPolicyUnknownScope(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "auth_policy.yaml")
+	err := os.WriteFile(policyFile, []byte(`
+keys:
+  - key: "some-key"
+    scopes: ["missing"]
+`), 0o600)
+	require.NoError(t, err)
+
+	_, err = loadAuthPolicy(policyFile)
+	assert.Error(t, err)
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ansys/aali-flowkit/pkg/batchgrpc"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// batchServer implements the ExternalFunctionsBatch service
+// Every call of a batch runs through RunFunction, so it is authorized, traced and measured like a single call
+type batchServer struct {
+	batchgrpc.UnimplementedExternalFunctionsBatchServer
+	functions      *server
+	maxConcurrency int
+	maxCalls       int
+}
+
+// newBatchServer creates the batch service with the limits of the workflow config variables
+//
+// Parameters:
+// - functions: the server running the single function calls
+//
+// Returns:
+// - *batchServer: the batch service
+// - error: an error if a limit is invalid
+func newBatchServer(functions *server) (*batchServer, error) {
+	maxConcurrency, err := intConfigVariable("FLOWKIT_BATCH_MAX_CONCURRENCY", 8)
+	if err != nil {
+		return nil, err
+	}
+	maxCalls, err := intConfigVariable("FLOWKIT_BATCH_MAX_CALLS", 1000)
+	if err != nil {
+		return nil, err
+	}
+	return &batchServer{functions: functions, maxConcurrency: maxConcurrency, maxCalls: maxCalls}, nil
+}
+
+// RunFunctionsBatch runs a list of function calls on a bounded worker pool
+// The results are returned in the order of the calls, a failing call does not abort the others
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the function calls and the requested concurrency
+//
+// Returns:
+// - *batchgrpc.RunFunctionsBatchResponse: the result of every call, in the order of the calls
+// - error: an error if the batch itself is invalid
+func (s *batchServer) RunFunctionsBatch(ctx context.Context, req *batchgrpc.RunFunctionsBatchRequest) (*batchgrpc.RunFunctionsBatchResponse, error) {
+	if len(req.Calls) > s.maxCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "batch of %d calls exceeds the limit of %d calls", len(req.Calls), s.maxCalls)
+	}
+	if req.Concurrency < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid batch concurrency %d", req.Concurrency)
+	}
+
+	// The requested concurrency is capped by the server limit, no more workers than calls are started
+	concurrency := s.maxConcurrency
+	if req.Concurrency > 0 && int(req.Concurrency) < concurrency {
+		concurrency = int(req.Concurrency)
+	}
+	concurrency = min(concurrency, len(req.Calls))
+
+	// Each worker writes to the result slots of the calls it takes, so the order is preserved
+	results := make([]*batchgrpc.FunctionCallResult, len(req.Calls))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i] = s.runCall(ctx, i, req.Calls[i])
+			}
+		}()
+	}
+	for i := range req.Calls {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return &batchgrpc.RunFunctionsBatchResponse{Results: results}, nil
+}
+
+// runCall runs a single call of a batch in its own span
+// Calls not started before the request is cancelled fail with the error of the context,
+// each call is charged to the rate limit and quota of the API key
+//
+// Parameters:
+// - ctx: the context of the request
+// - index: the position of the call in the batch
+// - call: the function call
+//
+// Returns:
+// - *batchgrpc.FunctionCallResult: the outputs and the status of the call
+func (s *batchServer) runCall(ctx context.Context, index int, call *batchgrpc.FunctionCall) *batchgrpc.FunctionCallResult {
+	result := &batchgrpc.FunctionCallResult{Name: call.Name}
+	if err := ctx.Err(); err != nil {
+		result.Status = status.FromContextError(err).Proto()
+		return result
+	}
+	if err := callRateLimiter.checkCall(ctx); err != nil {
+		result.Status = status.Convert(err).Proto()
+		return result
+	}
+
+	ctx, span := tracing.Start(ctx, "RunFunctionsBatch/"+call.Name, attribute.Int("flowkit.batch.index", index))
+	inputs := make([]*aaliflowkitgrpc.FunctionInput, len(call.Inputs))
+	for i, input := range call.Inputs {
+		inputs[i] = &aaliflowkitgrpc.FunctionInput{Name: input.Name, Value: input.Value, GoType: input.GoType}
+	}
+	output, err := s.functions.RunFunction(ctx, &aaliflowkitgrpc.FunctionInputs{Name: call.Name, Inputs: inputs})
+	tracing.End(span, err)
+	if err != nil {
+		result.Status = status.Convert(err).Proto()
+		return result
+	}
+
+	for _, out := range output.Outputs {
+		result.Outputs = append(result.Outputs, &batchgrpc.FunctionCallOutput{Name: out.Name, GoType: out.GoType, Value: out.Value})
+	}
+	result.Status = status.New(codes.OK, "").Proto()
+	return result
+}
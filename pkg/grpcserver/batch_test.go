@@ -0,0 +1,131 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/batchgrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// functionCall creates a batch call of a function from its input values
+func functionCall(function string, values ...string) *batchgrpc.FunctionCall {
+	call := &batchgrpc.FunctionCall{Name: function}
+	for _, value := range values {
+		call.Inputs = append(call.Inputs, &batchgrpc.FunctionCallInput{Value: value})
+	}
+	return call
+}
+
+func TestRunFunctionsBatch(t *testing.T) {
+	loadRegistry(t)
+
+	// serve the batch service over an in-memory connection
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	batchgrpc.RegisterExternalFunctionsBatchServer(s, &batchServer{functions: &server{}, maxConcurrency: 2, maxCalls: 4})
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := batchgrpc.NewExternalFunctionsBatchClient(conn)
+
+	// failing calls do not abort the batch and the results keep the order of the calls
+	response, err := client.RunFunctionsBatch(context.Background(), &batchgrpc.RunFunctionsBatchRequest{
+		Calls: []*batchgrpc.FunctionCall{
+			functionCall("StringConcat", "aali", "flowkit", "-"),
+			functionCall("UnknownFunction"),
+			functionCall("AppendMessageHistory", "hello", "admin", "[]"),
+			functionCall("StringConcat", "flow", "kit", ""),
+		},
+		Concurrency: 8,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 4)
+
+	expected := []struct {
+		name   string
+		code   codes.Code
+		output string
+	}{
+		{"StringConcat", codes.OK, "aali-flowkit"},
+		{"UnknownFunction", codes.NotFound, ""},
+		{"AppendMessageHistory", codes.InvalidArgument, ""},
+		{"StringConcat", codes.OK, "flowkit"},
+	}
+	for i, result := range response.Results {
+		assert.Equal(t, expected[i].name, result.Name, i)
+		assert.Equal(t, expected[i].code, status.FromProto(result.Status).Code(), i)
+		if expected[i].code == codes.OK {
+			require.Len(t, result.Outputs, 1, i)
+			assert.Equal(t, expected[i].output, result.Outputs[0].Value, i)
+		} else {
+			assert.Empty(t, result.Outputs, i)
+		}
+	}
+
+	// the batch itself is rejected if it exceeds the limits
+	_, err = client.RunFunctionsBatch(context.Background(), &batchgrpc.RunFunctionsBatchRequest{
+		Calls: make([]*batchgrpc.FunctionCall, 5),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.RunFunctionsBatch(context.Background(), &batchgrpc.RunFunctionsBatchRequest{
+		Calls:       []*batchgrpc.FunctionCall{functionCall("StringConcat", "a", "b", "")},
+		Concurrency: -1,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRunFunctionsBatchCancelled(t *testing.T) {
+	loadRegistry(t)
+
+	// calls of a cancelled request are not started
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	batch := &batchServer{functions: &server{}, maxConcurrency: 2, maxCalls: 10}
+	response, err := batch.RunFunctionsBatch(ctx, &batchgrpc.RunFunctionsBatchRequest{
+		Calls: []*batchgrpc.FunctionCall{
+			functionCall("StringConcat", "a", "b", ""),
+			functionCall("StringConcat", "c", "d", ""),
+		},
+	})
+	require.NoError(t, err)
+	for _, result := range response.Results {
+		assert.Equal(t, codes.Canceled, status.FromProto(result.Status).Code())
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+)
+
+// functionLimits limits the concurrent executions of functions, nil if no limits are configured
+var functionLimits *concurrencyLimits
+
+// concurrencyLimitsFile is the structure of the concurrency limits file
+//
+// Example:
+//
+//	functions:
+//	  GenerateDocumentTree:
+//	    maxConcurrent: 4
+//	    maxQueued: 16
+//	categories:
+//	  llm_handler:
+//	    maxConcurrent: 32
+//	    maxQueued: 128
+type concurrencyLimitsFile struct {
+	Functions  map[string]concurrencyLimit `yaml:"functions"`
+	Categories map[string]concurrencyLimit `yaml:"categories"`
+}
+
+// concurrencyLimit is the limit of a function or category
+type concurrencyLimit struct {
+	MaxConcurrent int `yaml:"maxConcurrent"`
+	MaxQueued     int `yaml:"maxQueued"`
+}
+
+// concurrencyLimits holds the limiters of the functions and categories
+// The limiter of a function takes precedence over the limiter of its category,
+// the functions of a category share the category limiter
+type concurrencyLimits struct {
+	functions  map[string]*concurrencyLimiter
+	categories map[string]*concurrencyLimiter
+}
+
+// concurrencyLimiter limits the concurrent executions of a function or category
+// Calls exceeding the limit wait in a queue, calls exceeding the queue depth are rejected
+type concurrencyLimiter struct {
+	name      string
+	slots     chan struct{}
+	maxQueued int
+	mutex     sync.Mutex
+	queued    int
+}
+
+// loadConcurrencyLimits loads the concurrency limits from a YAML file
+//
+// Parameters:
+// - path: the path to the limits file
+//
+// Returns:
+// - *concurrencyLimits: the loaded limits
+// - error: an error if the file cannot be read or is invalid
+func loadConcurrencyLimits(path string) (*concurrencyLimits, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read concurrency limits file: %v", err)
+	}
+
+	var file concurrencyLimitsFile
+	err = yaml.UnmarshalStrict(content, &file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse concurrency limits file: %v", err)
+	}
+
+	limits := &concurrencyLimits{functions: map[string]*concurrencyLimiter{}, categories: map[string]*concurrencyLimiter{}}
+	for function, limit := range file.Functions {
+		limits.functions[function], err = newConcurrencyLimiter("function "+function, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for category, limit := range file.Categories {
+		limits.categories[category], err = newConcurrencyLimiter("category "+category, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limits.warnUnknownEntries()
+	return limits, nil
+}
+
+// newConcurrencyLimiter creates the limiter of a function or category
+//
+// Parameters:
+// - name: the name of the limited function or category, used in messages
+// - limit: the limit
+//
+// Returns:
+// - *concurrencyLimiter: the limiter
+// - error: an error if the limit is invalid
+func newConcurrencyLimiter(name string, limit concurrencyLimit) (*concurrencyLimiter, error) {
+	if limit.MaxConcurrent < 1 {
+		return nil, fmt.Errorf("maxConcurrent of %s must be at least 1, got %d", name, limit.MaxConcurrent)
+	}
+	if limit.MaxQueued < 0 {
+		return nil, fmt.Errorf("maxQueued of %s must not be negative, got %d", name, limit.MaxQueued)
+	}
+	return &concurrencyLimiter{name: name, slots: make(chan struct{}, limit.MaxConcurrent), maxQueued: limit.MaxQueued}, nil
+}
+
+// warnUnknownEntries logs a warning for functions and categories of the limits that are not available
+func (limits *concurrencyLimits) warnUnknownEntries() {
+	categories := map[string]bool{}
+	for _, function := range internalstates.AvailableFunctions {
+		categories[function.Category] = true
+	}
+
+	for function := range limits.functions {
+		if _, exists := internalstates.AvailableFunctions[function]; !exists {
+			redact.Log.Warnf(&logging.ContextMap{}, "concurrency limits reference unknown function '%s'", function)
+		}
+	}
+	for category := range limits.categories {
+		if !categories[category] {
+			redact.Log.Warnf(&logging.ContextMap{}, "concurrency limits reference unknown category '%s'", category)
+		}
+	}
+}
+
+// acquire waits for a free execution slot of a function
+// Functions without limit get a slot immediately
+//
+// Parameters:
+// - ctx: the context of the request, waiting stops when it is done
+// - functionDefinition: the definition of the function
+//
+// Returns:
+// - func(): releases the slot, must be called once the function returns
+// - error: ResourceExhausted if the queue is full, or the error of the context
+func (limits *concurrencyLimits) acquire(ctx context.Context, functionDefinition *aaliflowkitgrpc.FunctionDefinition) (func(), error) {
+	limiter, ok := limits.functions[functionDefinition.Name]
+	if !ok {
+		limiter, ok = limits.categories[functionDefinition.Category]
+	}
+	if !ok {
+		return func() {}, nil
+	}
+
+	release := func() { <-limiter.slots }
+	select {
+	case limiter.slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	// all slots are taken, queue the call if the queue has room
+	limiter.mutex.Lock()
+	if limiter.queued >= limiter.maxQueued {
+		limiter.mutex.Unlock()
+		metrics.ObserveQueueRejection(functionDefinition.Name, functionDefinition.Category)
+		return nil, status.Errorf(codes.ResourceExhausted, "too many calls of %s: %d running and %d queued", limiter.name, cap(limiter.slots), limiter.maxQueued)
+	}
+	limiter.queued++
+	limiter.mutex.Unlock()
+	defer func() {
+		limiter.mutex.Lock()
+		limiter.queued--
+		limiter.mutex.Unlock()
+	}()
+
+	start := time.Now()
+	select {
+	case limiter.slots <- struct{}{}:
+		wait := time.Since(start)
+		metrics.ObserveQueueWait(functionDefinition.Name, functionDefinition.Category, wait)
+		redact.Log.Infof(&logging.ContextMap{}, "call of function %s waited %v for a free slot of %s", functionDefinition.Name, wait, limiter.name)
+		return release, nil
+	case <-ctx.Done():
+		metrics.ObserveQueueWait(functionDefinition.Name, functionDefinition.Category, time.Since(start))
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
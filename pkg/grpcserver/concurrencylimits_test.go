@@ -0,0 +1,141 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConcurrencyLimits(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+
+	limitsFile := filepath.Join(t.TempDir(), "concurrency_limits.yaml")
+	err := os.WriteFile(limitsFile, []byte(`
+functions:
+  PerformVectorEmbeddingRequest:
+    maxConcurrent: 1
+    maxQueued: 1
+categories:
+  llm_handler:
+    maxConcurrent: 2
+    maxQueued: 0
+`), 0o600)
+	require.NoError(err)
+
+	limits, err := loadConcurrencyLimits(limitsFile)
+	require.NoError(err)
+
+	embeddingFunction := &aaliflowkitgrpc.FunctionDefinition{Name: "PerformVectorEmbeddingRequest", Category: "llm_handler"}
+	generalFunction := &aaliflowkitgrpc.FunctionDefinition{Name: "PerformGeneralRequest", Category: "llm_handler"}
+	dataFunction := &aaliflowkitgrpc.FunctionDefinition{Name: "AddDataRequest", Category: "knowledge_db"}
+
+	// function limit takes precedence over the category limit
+	release, err := limits.acquire(context.Background(), embeddingFunction)
+	require.NoError(err)
+
+	// second call waits in the queue until the first one is released
+	acquired := make(chan func())
+	go func() {
+		queuedRelease, err := limits.acquire(context.Background(), embeddingFunction)
+		assert.NoError(err)
+		acquired <- queuedRelease
+	}()
+	require.Eventually(func() bool {
+		limiter := limits.functions["PerformVectorEmbeddingRequest"]
+		limiter.mutex.Lock()
+		defer limiter.mutex.Unlock()
+		return limiter.queued == 1
+	}, time.Second, time.Millisecond)
+
+	// third call is rejected because the queue is full
+	_, err = limits.acquire(context.Background(), embeddingFunction)
+	assert.Equal(codes.ResourceExhausted, status.Code(err))
+
+	release()
+	queuedRelease := <-acquired
+	queuedRelease()
+
+	// functions of the category share the category limit and have no queue
+	firstRelease, err := limits.acquire(context.Background(), generalFunction)
+	require.NoError(err)
+	secondRelease, err := limits.acquire(context.Background(), generalFunction)
+	require.NoError(err)
+	_, err = limits.acquire(context.Background(), generalFunction)
+	assert.Equal(codes.ResourceExhausted, status.Code(err))
+	firstRelease()
+	secondRelease()
+
+	// functions without limit are not limited
+	for i := 0; i < 10; i++ {
+		_, err = limits.acquire(context.Background(), dataFunction)
+		require.NoError(err)
+	}
+}
+
+func TestConcurrencyLimitsCancelled(t *testing.T) {
+	limiter, err := newConcurrencyLimiter("function PerformVectorEmbeddingRequest", concurrencyLimit{MaxConcurrent: 1, MaxQueued: 1})
+	require.NoError(t, err)
+	limits := &concurrencyLimits{functions: map[string]*concurrencyLimiter{"PerformVectorEmbeddingRequest": limiter}}
+	function := &aaliflowkitgrpc.FunctionDefinition{Name: "PerformVectorEmbeddingRequest", Category: "llm_handler"}
+
+	release, err := limits.acquire(context.Background(), function)
+	require.NoError(t, err)
+	defer release()
+
+	// queued call stops waiting when its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limits.acquire(ctx, function)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, 0, limiter.queued)
+}
+
+func TestConcurrencyLimitsInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"zero concurrency": "functions:\n  AddDataRequest:\n    maxConcurrent: 0\n",
+		"negative queue":   "categories:\n  llm_handler:\n    maxConcurrent: 1\n    maxQueued: -1\n",
+		"unknown field":    "functions:\n  AddDataRequest:\n    maxParallel: 1\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			limitsFile := filepath.Join(t.TempDir(), "concurrency_limits.yaml")
+			require.NoError(t, os.WriteFile(limitsFile, []byte(content), 0o600))
+
+			_, err := loadConcurrencyLimits(limitsFile)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// callFunction calls an external function with the request inputs
+// The generated adapter of the function is used if there is one, reflection otherwise
+//
+// Parameters:
+// - ctx: the context of the request
+// - method: the name of the RPC method, e.g. "RunFunction"
+// - functionDefinition: the definition of the called function
+// - inputs: the inputs of the request
+//
+// Returns:
+// - []interface{}: the outputs of the function, without the trailing error
+// - error: a gRPC status error if the inputs are invalid or the function fails
+func callFunction(ctx context.Context, method string, functionDefinition *aaliflowkitgrpc.FunctionDefinition, inputs []*aaliflowkitgrpc.FunctionInput) ([]interface{}, error) {
+	inputs, err := resolveSecretReferences(ctx, functionDefinition, inputs)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := newInputDecoder(ctx, functionDefinition, inputs)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return invokeFunction(ctx, method, decoder)
+}
+
+// invokeFunction calls an external function with the inputs of a decoder
+// The outputs of functions tagged with @cache are served from the result cache if it is enabled
+//
+// Parameters:
+// - ctx: the context of the request
+// - method: the name of the RPC method, e.g. "RunFunction"
+// - decoder: the decoder of the function inputs
+//
+// Returns:
+// - []interface{}: the outputs of the function, without the trailing error
+// - error: a gRPC status error if the inputs are invalid or the function fails
+func invokeFunction(ctx context.Context, method string, decoder *inputDecoder) ([]interface{}, error) {
+	if functionResultCache != nil && decoder.functionMetadata.CacheTTL > 0 {
+		return functionResultCache.invoke(ctx, method, decoder)
+	}
+	return invokeFunctionUncached(ctx, method, decoder)
+}
+
+// invokeFunctionUncached calls an external function with the inputs of a decoder
+// The generated adapter of the function is used if there is one, reflection otherwise
+// The execution slot of a concurrency limited function is held until the function returns
+//
+// Parameters:
+// - ctx: the context of the request
+// - method: the name of the RPC method, e.g. "RunFunction"
+// - decoder: the decoder of the function inputs
+//
+// Returns:
+// - []interface{}: the outputs of the function, without the trailing error
+// - error: a gRPC status error if the inputs are invalid or the function fails
+func invokeFunctionUncached(ctx context.Context, method string, decoder *inputDecoder) ([]interface{}, error) {
+	functionDefinition := decoder.functionDefinition
+
+	// wait for a free slot if the function is concurrency limited
+	if functionLimits != nil {
+		release, err := functionLimits.acquire(ctx, functionDefinition)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	adapter, ok := externalfunctions.FunctionAdapters[functionDefinition.Name]
+	if !ok {
+		return invokeFunctionByReflection(ctx, method, decoder)
+	}
+
+	outputs, err := adapter(metrics.WithFunction(ctx, functionDefinition.Name), decoder)
+	if decoder.err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", decoder.err)
+	}
+	if err != nil {
+		return nil, functionError(method, functionDefinition.Name, err)
+	}
+	return outputs, nil
+}
+
+// invokeFunctionByReflection calls an external function of externalfunctions.ExternalFunctionsMap by reflection
+//
+// Parameters:
+// - ctx: the context of the request
+// - method: the name of the RPC method, e.g. "RunFunction"
+// - decoder: the decoder of the function inputs
+//
+// Returns:
+// - []interface{}: the outputs of the function, without the trailing error
+// - error: a gRPC status error if the inputs are invalid or the function fails
+func invokeFunctionByReflection(ctx context.Context, method string, decoder *inputDecoder) ([]interface{}, error) {
+	functionDefinition := decoder.functionDefinition
+
+	// get externalfunctions package and the function
+	function, exists := externalfunctions.ExternalFunctionsMap[functionDefinition.Name]
+	if !exists {
+		return nil, status.Errorf(codes.Unimplemented, "function %s not found in externalfunctions package", functionDefinition.Name)
+	}
+	funcValue := reflect.ValueOf(function)
+	if !funcValue.IsValid() {
+		return nil, status.Errorf(codes.Unimplemented, "function %s not found in externalfunctions package", functionDefinition.Name)
+	}
+
+	// convert and validate the inputs, omitted inputs are replaced by their defaults
+	inputValues, err := prepareInputs(decoder, funcValue)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Prepare arguments for the function
+	args := []reflect.Value{}
+	if acceptsContext(funcValue) {
+		args = append(args, reflect.ValueOf(metrics.WithFunction(ctx, functionDefinition.Name)))
+	}
+	args = append(args, inputValues...)
+
+	// Call the function
+	results, funcErr := splitErrorResult(funcValue, funcValue.Call(args))
+	if funcErr != nil {
+		return nil, functionError(method, functionDefinition.Name, funcErr)
+	}
+
+	outputs := make([]interface{}, len(results))
+	for i, result := range results {
+		outputs[i] = result.Interface()
+	}
+	return outputs, nil
+}
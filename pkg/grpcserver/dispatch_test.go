@@ -0,0 +1,236 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/batchgrpc"
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// loadRegistry loads the generated function registry into the internal states
+func loadRegistry(tb testing.TB) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	internalstates.InitializeInternalStates()
+	require.NoError(tb, functiondefinitions.LoadRegistry(externalfunctions.FunctionDefinitions, externalfunctions.FunctionsMetadata))
+}
+
+// functionInputs creates the request inputs of a function from their string values
+func functionInputs(values ...string) []*aaliflowkitgrpc.FunctionInput {
+	inputs := []*aaliflowkitgrpc.FunctionInput{}
+	for _, value := range values {
+		inputs = append(inputs, &aaliflowkitgrpc.FunctionInput{Value: value})
+	}
+	return inputs
+}
+
+// callFunctionByReflection calls a function by reflection, bypassing its generated adapter
+func callFunctionByReflection(ctx context.Context, method string, functionDefinition *aaliflowkitgrpc.FunctionDefinition, inputs []*aaliflowkitgrpc.FunctionInput) ([]interface{}, error) {
+	decoder, err := newInputDecoder(ctx, functionDefinition, inputs)
+	if err != nil {
+		return nil, err
+	}
+	return invokeFunctionByReflection(ctx, method, decoder)
+}
+
+// callFunctionAdapter calls a function through its generated adapter only
+func callFunctionAdapter(ctx context.Context, method string, functionDefinition *aaliflowkitgrpc.FunctionDefinition, inputs []*aaliflowkitgrpc.FunctionInput) ([]interface{}, error) {
+	decoder, err := newInputDecoder(ctx, functionDefinition, inputs)
+	if err != nil {
+		return nil, err
+	}
+	return externalfunctions.FunctionAdapters[functionDefinition.Name](ctx, decoder)
+}
+
+func TestCallFunction(t *testing.T) {
+	loadRegistry(t)
+
+	tests := []struct {
+		function string
+		inputs   []string
+		outputs  []interface{}
+	}{
+		{"StringConcat", []string{"aali", "flowkit", "-"}, []interface{}{"aali-flowkit"}},
+		{"CastAnyToString", []string{`"aali"`}, []interface{}{"aali"}},
+		{"AppendMessageHistory", []string{"hello", "user", "[]"}, []interface{}{[]sharedtypes.HistoricMessage{{Role: "user", Content: "hello"}}}},
+	}
+
+	// the generated adapters and reflection return the same outputs
+	for _, test := range tests {
+		functionDefinition := internalstates.AvailableFunctions[test.function]
+		require.NotNil(t, functionDefinition, test.function)
+
+		outputs, err := callFunction(context.Background(), "RunFunction", functionDefinition, functionInputs(test.inputs...))
+		require.NoError(t, err, test.function)
+		assert.Equal(t, test.outputs, outputs, test.function)
+
+		outputs, err = callFunctionByReflection(context.Background(), "RunFunction", functionDefinition, functionInputs(test.inputs...))
+		require.NoError(t, err, test.function)
+		assert.Equal(t, test.outputs, outputs, test.function)
+	}
+
+	// invalid inputs are reported as invalid argument
+	_, err := callFunction(context.Background(), "RunFunction", internalstates.AvailableFunctions["AppendMessageHistory"], functionInputs("hello", "admin", "[]"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "invalid input 'role' of function 'AppendMessageHistory'")
+}
+
+// registerWaitFunction registers a function waiting for the end of its request context
+// The error of the context is sent to the returned channel. Without adapter the function is called by reflection.
+func registerWaitFunction(t *testing.T, name string, withAdapter bool) chan error {
+	observed := make(chan error, 1)
+	function := func(ctx context.Context) error {
+		<-ctx.Done()
+		observed <- ctx.Err()
+		return ctx.Err()
+	}
+
+	registerFunction(t, &aaliflowkitgrpc.FunctionDefinition{Name: name, Category: "generic"}, function)
+	if withAdapter {
+		externalfunctions.FunctionAdapters[name] = func(ctx context.Context, decode externalfunctions.InputDecoder) ([]interface{}, error) {
+			return []interface{}{}, function(ctx)
+		}
+	}
+	return observed
+}
+
+// registerFunction registers a function that is not part of the generated registry, it is called by reflection
+func registerFunction(t *testing.T, functionDefinition *aaliflowkitgrpc.FunctionDefinition, function interface{}) {
+	name := functionDefinition.Name
+	internalstates.AvailableFunctions[name] = functionDefinition
+	externalfunctions.ExternalFunctionsMap[name] = function
+	t.Cleanup(func() {
+		delete(internalstates.AvailableFunctions, name)
+		delete(externalfunctions.ExternalFunctionsMap, name)
+		delete(externalfunctions.FunctionAdapters, name)
+	})
+}
+
+func TestCallFunctionCancelled(t *testing.T) {
+	loadRegistry(t)
+
+	// the request context reaches the function through the adapter and through reflection
+	for name, withAdapter := range map[string]bool{"WaitWithAdapter": true, "WaitByReflection": false} {
+		observed := registerWaitFunction(t, name, withAdapter)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			_, err := (&server{}).RunFunction(ctx, &aaliflowkitgrpc.FunctionInputs{Name: name})
+			done <- err
+		}()
+		cancel()
+
+		select {
+		case err := <-observed:
+			assert.ErrorIs(t, err, context.Canceled, name)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%v did not observe the cancellation", name)
+		}
+		assert.Equal(t, codes.Canceled, status.Code(<-done), name)
+	}
+}
+
+func TestCallFunctionDeadline(t *testing.T) {
+	loadRegistry(t)
+	observed := registerWaitFunction(t, "WaitForDeadline", true)
+
+	// serve the batch service, which runs its calls with the RPC context, over an in-memory connection
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	batchgrpc.RegisterExternalFunctionsBatchServer(s, &batchServer{functions: &server{}, maxConcurrency: 1, maxCalls: 1})
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the deadline of the client interrupts the function on the server
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = batchgrpc.NewExternalFunctionsBatchClient(conn).RunFunctionsBatch(ctx, &batchgrpc.RunFunctionsBatchRequest{
+		Calls: []*batchgrpc.FunctionCall{functionCall("WaitForDeadline")},
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// the server may see the deadline or the cancellation of the client, whichever comes first
+	select {
+	case err := <-observed:
+		assert.True(t, errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled), err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the function did not observe the deadline")
+	}
+}
+
+func BenchmarkCallFunction(b *testing.B) {
+	loadRegistry(b)
+
+	benchmarks := []struct {
+		function string
+		inputs   []*aaliflowkitgrpc.FunctionInput
+	}{
+		{"CastAnyToString", functionInputs(`"aali"`)},
+		{"StringConcat", functionInputs("aali", "flowkit", "-")},
+	}
+	calls := map[string]func(context.Context, string, *aaliflowkitgrpc.FunctionDefinition, []*aaliflowkitgrpc.FunctionInput) ([]interface{}, error){
+		"adapter":    callFunctionAdapter,
+		"reflection": callFunctionByReflection,
+	}
+
+	for _, benchmark := range benchmarks {
+		functionDefinition := internalstates.AvailableFunctions[benchmark.function]
+		for _, path := range []string{"adapter", "reflection"} {
+			call := calls[path]
+			b.Run(benchmark.function+"/"+path, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_, err := call(context.Background(), "RunFunction", functionDefinition, benchmark.inputs)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorType is the reflect type of the built-in error interface
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// errorCode maps an error returned by an external function to a gRPC status code
+//
+// Parameters:
+// - err: the error returned by the function
+//
+// Returns:
+// - codes.Code: the gRPC status code matching the error class
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, externalfunctions.ErrInvalidInput):
+		return codes.InvalidArgument
+	case errors.Is(err, externalfunctions.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, externalfunctions.ErrUpstreamUnavailable):
+		return codes.Unavailable
+	case errors.Is(err, externalfunctions.ErrQuotaExceeded):
+		return codes.ResourceExhausted
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+
+	// keep the code of errors coming from gRPC dependencies (e.g. Qdrant)
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	return codes.Unknown
+}
+
+// functionError converts an error returned by an external function to a gRPC status error
+//
+// Parameters:
+// - method: the name of the RPC method, e.g. "RunFunction"
+// - functionName: the name of the external function
+// - err: the error returned by the function
+//
+// Returns:
+// - error: the gRPC status error
+func functionError(method string, functionName string, err error) error {
+	return status.Errorf(errorCode(err), "error occured in gRPC server aali-flowkit during %s of '%v': %v", method, functionName, err)
+}
+
+// panicError converts a panic recovered during an external function call to a gRPC status error
+//
+// Parameters:
+// - method: the name of the RPC method, e.g. "RunFunction"
+// - functionName: the name of the external function
+// - r: the recovered value
+//
+// Returns:
+// - error: the gRPC status error
+func panicError(method string, functionName string, r interface{}) error {
+	if err, ok := r.(error); ok {
+		return functionError(method, functionName, err)
+	}
+	return status.Errorf(codes.Internal, "error occured in gRPC server aali-flowkit during %s of '%v': %v", method, functionName, r)
+}
+
+// splitErrorResult separates the trailing error from the results of a function call
+//
+// Parameters:
+// - funcValue: the reflect value of the called function
+// - results: the results of the function call
+//
+// Returns:
+// - []reflect.Value: the results without the trailing error
+// - error: the trailing error, nil if the function does not return one or succeeded
+func splitErrorResult(funcValue reflect.Value, results []reflect.Value) ([]reflect.Value, error) {
+	funcType := funcValue.Type()
+	if funcType.NumOut() == 0 || funcType.Out(funcType.NumOut()-1) != errorType {
+		return results, nil
+	}
+
+	last := results[len(results)-1]
+	results = results[:len(results)-1]
+	if last.IsNil() {
+		return results, nil
+	}
+	return results, last.Interface().(error)
+}
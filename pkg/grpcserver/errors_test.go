@@ -0,0 +1,73 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCode(t *testing.T) {
+	testCases := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("%w: bad field type", externalfunctions.ErrInvalidInput), codes.InvalidArgument},
+		{fmt.Errorf("%w: prompt", externalfunctions.ErrNotFound), codes.NotFound},
+		{fmt.Errorf("%w: qdrant", externalfunctions.ErrUpstreamUnavailable), codes.Unavailable},
+		{fmt.Errorf("%w: tokens", externalfunctions.ErrQuotaExceeded), codes.ResourceExhausted},
+		{fmt.Errorf("read: %w", context.Canceled), codes.Canceled},
+		{fmt.Errorf("read: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{fmt.Errorf("upsert: %w", status.Error(codes.FailedPrecondition, "collection")), codes.FailedPrecondition},
+		{errors.New("something else"), codes.Unknown},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, errorCode(tc.err), tc.err.Error())
+	}
+}
+
+func TestSplitErrorResult(t *testing.T) {
+	withError := func() (string, error) { return "out", externalfunctions.ErrNotFound }
+	results, err := splitErrorResult(reflect.ValueOf(withError), reflect.ValueOf(withError).Call(nil))
+	assert.Len(t, results, 1)
+	assert.ErrorIs(t, err, externalfunctions.ErrNotFound)
+
+	withNilError := func() (string, error) { return "out", nil }
+	results, err = splitErrorResult(reflect.ValueOf(withNilError), reflect.ValueOf(withNilError).Call(nil))
+	assert.Len(t, results, 1)
+	assert.NoError(t, err)
+
+	withoutError := func() (string, int) { return "out", 1 }
+	results, err = splitErrorResult(reflect.ValueOf(withoutError), reflect.ValueOf(withoutError).Call(nil))
+	assert.Len(t, results, 2)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys of the ListFunctions filters
+// The ListFunctionsRequest message has no fields, so the filters are passed as request metadata
+const (
+	filterCategoryKey   = "x-function-category"
+	filterTagKey        = "x-function-tag"
+	filterDeprecatedKey = "x-function-deprecated"
+)
+
+// functionFilter selects the functions returned by ListFunctions
+type functionFilter struct {
+	categories map[string]bool
+	tags       []string
+	deprecated *bool
+}
+
+// functionFilterFromContext reads the ListFunctions filters from the request metadata
+// Several categories may be given to list the functions of any of them,
+// several tags to list the functions having all of them
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - *functionFilter: the filter, matching all functions if no filter is given
+// - error: an error if the deprecation filter is not a boolean
+func functionFilterFromContext(ctx context.Context) (*functionFilter, error) {
+	filter := &functionFilter{categories: map[string]bool{}}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return filter, nil
+	}
+
+	for _, category := range md[filterCategoryKey] {
+		filter.categories[category] = true
+	}
+	filter.tags = md[filterTagKey]
+
+	if values := md[filterDeprecatedKey]; len(values) > 0 {
+		deprecated, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' of %s, expected 'true' or 'false'", values[0], filterDeprecatedKey)
+		}
+		filter.deprecated = &deprecated
+	}
+	return filter, nil
+}
+
+// matches checks if a function is selected by the filter
+//
+// Parameters:
+// - function: the definition of the function
+//
+// Returns:
+// - bool: true if the function is selected
+func (filter *functionFilter) matches(function *aaliflowkitgrpc.FunctionDefinition) bool {
+	if len(filter.categories) > 0 && !filter.categories[function.Category] {
+		return false
+	}
+
+	functionMetadata, ok := internalstates.AvailableFunctionsMetadata[function.Name]
+	if !ok {
+		functionMetadata = &internalstates.FunctionMetadata{}
+	}
+	for _, tag := range filter.tags {
+		if !functionMetadata.HasTag(tag) {
+			return false
+		}
+	}
+	if filter.deprecated != nil && functionMetadata.Deprecated != *filter.deprecated {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestListFunctionsFilter(t *testing.T) {
+	require := require.New(t)
+	internalstates.InitializeInternalStates()
+	internalstates.AvailableFunctions["AnsysGPTPerformLLMRephraseRequest"] = &aaliflowkitgrpc.FunctionDefinition{Name: "AnsysGPTPerformLLMRephraseRequest", Category: "ansys_gpt"}
+	internalstates.AvailableFunctionsMetadata["AnsysGPTPerformLLMRephraseRequest"] = &internalstates.FunctionMetadata{Deprecated: true, Tags: []string{"llm"}}
+	internalstates.AvailableFunctions["AnsysGPTPerformLLMRephraseRequestNew"] = &aaliflowkitgrpc.FunctionDefinition{Name: "AnsysGPTPerformLLMRephraseRequestNew", Category: "ansys_gpt"}
+	internalstates.AvailableFunctionsMetadata["AnsysGPTPerformLLMRephraseRequestNew"] = &internalstates.FunctionMetadata{Tags: []string{"llm", "rephrase"}}
+	internalstates.AvailableFunctions["SendRestAPICall"] = &aaliflowkitgrpc.FunctionDefinition{Name: "SendRestAPICall", Category: "generic"}
+	internalstates.AvailableFunctionsMetadata["SendRestAPICall"] = &internalstates.FunctionMetadata{}
+
+	list := func(pairs ...string) []string {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+		resp, err := (&server{}).ListFunctions(ctx, &aaliflowkitgrpc.ListFunctionsRequest{})
+		require.NoError(err)
+		names := []string{}
+		for name := range resp.Functions {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	assert.Len(t, list(), 3)
+	assert.ElementsMatch(t, []string{"SendRestAPICall"}, list("x-function-category", "generic"))
+	assert.ElementsMatch(t, []string{"AnsysGPTPerformLLMRephraseRequest", "AnsysGPTPerformLLMRephraseRequestNew"}, list("x-function-tag", "llm"))
+	assert.ElementsMatch(t, []string{"AnsysGPTPerformLLMRephraseRequestNew"}, list("x-function-tag", "llm", "x-function-tag", "rephrase"))
+	assert.ElementsMatch(t, []string{"AnsysGPTPerformLLMRephraseRequest"}, list("x-function-deprecated", "true"))
+	assert.ElementsMatch(t, []string{"AnsysGPTPerformLLMRephraseRequestNew"}, list("x-function-category", "ansys_gpt", "x-function-deprecated", "false"))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-function-deprecated", "maybe"))
+	_, err := (&server{}).ListFunctions(ctx, &aaliflowkitgrpc.ListFunctionsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys of the JSON Schema catalogue of ListFunctions
+// The FunctionDefinition message has no field for the schemas, so the catalogue
+// is requested by request metadata and returned as binary response header
+const (
+	schemaRequestKey = "x-function-schema"
+	schemaHeaderKey  = "x-function-schema-bin"
+)
+
+// schemaRequested checks if the JSON Schema catalogue is requested in the request metadata
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - bool: true if the catalogue is requested
+// - error: an error if the request value is not a boolean
+func schemaRequested(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[schemaRequestKey]) == 0 {
+		return false, nil
+	}
+
+	requested, err := strconv.ParseBool(md[schemaRequestKey][0])
+	if err != nil {
+		return false, fmt.Errorf("invalid value '%s' of %s, expected 'true' or 'false'", md[schemaRequestKey][0], schemaRequestKey)
+	}
+	return requested, nil
+}
+
+// sendFunctionSchemas sends the JSON Schema catalogue of the given functions as response header
+//
+// Parameters:
+// - ctx: the context of the request
+// - functions: the listed functions
+//
+// Returns:
+// - error: an error if the catalogue cannot be marshalled or sent
+func sendFunctionSchemas(ctx context.Context, functions map[string]*aaliflowkitgrpc.FunctionDefinition) error {
+	catalogue := functiondefinitions.GenerateCatalogue(functions, externalfunctions.ExternalFunctionsMap)
+	data, err := json.Marshal(catalogue)
+	if err != nil {
+		return fmt.Errorf("error marshalling function schemas: %v", err)
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(schemaHeaderKey, string(data)))
+}
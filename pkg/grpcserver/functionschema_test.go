@@ -0,0 +1,88 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// headerRecorder is a server transport stream that records the response header
+type headerRecorder struct {
+	header metadata.MD
+}
+
+func (r *headerRecorder) Method() string { return "/aaliflowkitgrpc.ExternalFunctions/ListFunctions" }
+func (r *headerRecorder) SetHeader(md metadata.MD) error {
+	r.header = metadata.Join(r.header, md)
+	return nil
+}
+func (r *headerRecorder) SendHeader(md metadata.MD) error { return r.SetHeader(md) }
+func (r *headerRecorder) SetTrailer(md metadata.MD) error { return nil }
+
+func TestListFunctionsSchema(t *testing.T) {
+	require := require.New(t)
+	internalstates.InitializeInternalStates()
+	internalstates.AvailableFunctions["AssignStringToString"] = &aaliflowkitgrpc.FunctionDefinition{
+		Name:     "AssignStringToString",
+		Category: "generic",
+		Input:    []*aaliflowkitgrpc.FunctionInputDefinition{{Name: "inputString", Type: "string", GoType: "string"}},
+		Output:   []*aaliflowkitgrpc.FunctionOutputDefinition{{Name: "outputString", Type: "string", GoType: "string"}},
+	}
+
+	listFunctions := func(pairs ...string) (*headerRecorder, error) {
+		recorder := &headerRecorder{}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+		ctx = grpc.NewContextWithServerTransportStream(ctx, recorder)
+		_, err := (&server{}).ListFunctions(ctx, &aaliflowkitgrpc.ListFunctionsRequest{})
+		return recorder, err
+	}
+
+	// the catalogue is only sent on request
+	recorder, err := listFunctions()
+	require.NoError(err)
+	assert.Empty(t, recorder.header.Get(schemaHeaderKey))
+
+	recorder, err = listFunctions(schemaRequestKey, "true")
+	require.NoError(err)
+	values := recorder.header.Get(schemaHeaderKey)
+	require.Len(values, 1)
+	catalogue := &functiondefinitions.Catalogue{}
+	require.NoError(json.Unmarshal([]byte(values[0]), catalogue))
+	require.Contains(catalogue.Functions, "AssignStringToString")
+	assert.Equal(t, []string{"inputString"}, catalogue.Functions["AssignStringToString"].Inputs.Required)
+
+	_, err = listFunctions(schemaRequestKey, "yes")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
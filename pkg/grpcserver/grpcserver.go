@@ -26,19 +26,34 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
+	"os/signal"
 	"reflect"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/ansys/aali-flowkit/pkg/batchgrpc"
+	"github.com/ansys/aali-flowkit/pkg/cachegrpc"
 	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-flowkit/pkg/pipelinegrpc"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
 	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/typeconverters"
 
 	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
 
@@ -54,33 +69,92 @@ func StartServer() {
 	// Get webserver address
 	webserverAddress, err := config.HandleLegacyPortDefinition(config.GlobalConfig.FLOWKIT_ADDRESS, config.GlobalConfig.EXTERNALFUNCTIONS_GRPC_PORT)
 	if err != nil {
-		logging.Log.Fatalf(&logging.ContextMap{}, "Error getting webserver address: %v", err)
+		redact.Log.Fatalf(&logging.ContextMap{}, "Error getting webserver address: %v", err)
 	}
 
 	// Create listener on the specified address
 	lis, err := net.Listen("tcp", webserverAddress)
 	if err != nil {
-		logging.Log.Fatalf(&logging.ContextMap{}, "failed to listen: %v", err)
+		redact.Log.Fatalf(&logging.ContextMap{}, "failed to listen: %v", err)
 	}
 
 	// Check if SSL is enabled and load the server's certificate and private key
+	// Client certificates are verified if a client CA bundle is configured (mTLS)
 	var opts []grpc.ServerOption
 	if config.GlobalConfig.USE_SSL {
-		creds, err := credentials.NewServerTLSFromFile(
+		var allowedSubjects []string
+		if subjects := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_TLS_ALLOWED_CLIENT_SUBJECTS"]; subjects != "" {
+			allowedSubjects = strings.Split(subjects, ",")
+		}
+		reloader, err := newTLSReloader(
 			config.GlobalConfig.SSL_CERT_PUBLIC_KEY_FILE,
 			config.GlobalConfig.SSL_CERT_PRIVATE_KEY_FILE,
+			config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_TLS_CLIENT_CA_FILE"],
+			allowedSubjects,
 		)
 		if err != nil {
-			logging.Log.Fatalf(&logging.ContextMap{}, "failed to load SSL certificates: %v", err)
+			redact.Log.Fatalf(&logging.ContextMap{}, "failed to load SSL certificates: %v", err)
+		}
+
+		// Reload the certificates when they are rotated
+		reloadInterval, err := durationConfigVariable("FLOWKIT_TLS_RELOAD_INTERVAL", time.Minute)
+		if err != nil {
+			redact.Log.Fatalf(&logging.ContextMap{}, "invalid TLS reload interval: %v", err)
+		}
+		stopReloader := make(chan struct{})
+		defer close(stopReloader)
+		go reloader.watch(reloadInterval, stopReloader)
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.tlsConfig())))
+	}
+
+	// Load the per-function authorization policy if a policy file is provided
+	var policy *authPolicy
+	if policyFile := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_AUTH_POLICY_FILE"]; policyFile != "" {
+		policy, err = loadAuthPolicy(policyFile)
+		if err != nil {
+			redact.Log.Fatalf(&logging.ContextMap{}, "failed to load authorization policy: %v", err)
 		}
-		opts = append(opts, grpc.Creds(creds))
 	}
 
-	// Add API key authentication interceptor if an API key is provided
-	if config.GlobalConfig.FLOWKIT_API_KEY != "" {
-		opts = append(opts, grpc.UnaryInterceptor(apiKeyAuthInterceptor(config.GlobalConfig.FLOWKIT_API_KEY)))
+	// Mask the secret inputs of the calls and the secrets of the configuration in the logs and returned errors
+	keepConfigSecrets()
+	opts = append(opts, grpc.ChainUnaryInterceptor(redactErrorInterceptor()))
+	opts = append(opts, grpc.ChainStreamInterceptor(redactErrorStreamInterceptor()))
+
+	// Add API key authentication interceptors if an API key or a policy is provided
+	if config.GlobalConfig.FLOWKIT_API_KEY != "" || policy != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(apiKeyAuthInterceptor(config.GlobalConfig.FLOWKIT_API_KEY, policy)))
+		opts = append(opts, grpc.ChainStreamInterceptor(apiKeyStreamAuthInterceptor(config.GlobalConfig.FLOWKIT_API_KEY, policy)))
 	}
 
+	// Add rate limiting interceptors after the authentication if a rate limit or quota is configured
+	// The calls of the batches and pipelines are charged one by one
+	limiter, err := newRateLimiter(policy)
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid rate limit configuration: %v", err)
+	}
+	callRateLimiter = limiter
+	if limiter != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(rateLimitInterceptor(limiter)))
+		opts = append(opts, grpc.ChainStreamInterceptor(rateLimitStreamInterceptor(limiter)))
+	}
+
+	// Start the traces of the calls from the trace context in the request metadata
+	// The spans are exported if a trace exporter is configured
+	shutdownTracing, err := setupTracing()
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "failed to set up tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			redact.Log.Errorf(&logging.ContextMap{}, "failed to flush traces: %v", err)
+		}
+	}()
+	opts = append(opts, grpc.StatsHandler(tracing.ServerHandler()))
+
 	// Set gRPC message size limits
 	opts = append(opts, grpc.MaxRecvMsgSize(1024*1024*1024)) // 1 GB receive limit
 	opts = append(opts, grpc.MaxSendMsgSize(1024*1024*1024)) // 1 GB send limit
@@ -88,36 +162,211 @@ func StartServer() {
 	// Create the gRPC server with the options
 	s := grpc.NewServer(opts...)
 	aaliflowkitgrpc.RegisterExternalFunctionsServer(s, &server{})
-	logging.Log.Infof(&logging.ContextMap{}, "Aali FlowKit started successfully; gRPC server listening on address '%s'...\n", webserverAddress)
+
+	// Register the batch service running several functions in one call
+	batch, err := newBatchServer(&server{})
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid batch configuration: %v", err)
+	}
+	batchgrpc.RegisterExternalFunctionsBatchServer(s, batch)
+
+	// Record the function invocations if an audit sink is configured
+	functionAudit, err = newAuditLog()
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid audit configuration: %v", err)
+	}
+	if functionAudit != nil {
+		defer functionAudit.sink.Close()
+	}
+
+	// Limit the concurrent executions of functions if a limits file is provided
+	if limitsFile := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_CONCURRENCY_LIMITS_FILE"]; limitsFile != "" {
+		functionLimits, err = loadConcurrencyLimits(limitsFile)
+		if err != nil {
+			redact.Log.Fatalf(&logging.ContextMap{}, "failed to load concurrency limits: %v", err)
+		}
+	}
+
+	// Cache the outputs of the functions tagged with @cache and register the service managing the cache
+	functionResultCache, err = newResultCache()
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid cache configuration: %v", err)
+	}
+	cachegrpc.RegisterFunctionCacheServer(s, &cacheServer{})
+
+	// Resolve the secret references of the function inputs from the configured providers
+	secretResolver, err = newSecretResolver()
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid secrets configuration: %v", err)
+	}
+
+	// Register the pipeline service running chained functions on the server
+	pipeline, err := newPipelineServer()
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid pipeline configuration: %v", err)
+	}
+	pipelinegrpc.RegisterExternalFunctionsPipelineServer(s, pipeline)
+
+	// Register the health service and check the dependencies periodically
+	healthCheckInterval, err := durationConfigVariable("FLOWKIT_HEALTH_CHECK_INTERVAL", 30*time.Second)
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid health check interval: %v", err)
+	}
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	stopHealthChecks := make(chan struct{})
+	defer close(stopHealthChecks)
+	go watchDependencyHealth(healthServer, healthCheckInterval, stopHealthChecks)
+
+	// Register the reflection service if enabled
+	if config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_ENABLE_REFLECTION"] == "true" {
+		reflection.Register(s)
+	}
+
+	// Expose the Prometheus metrics if a metrics address is provided
+	if metricsAddress := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_METRICS_ADDRESS"]; metricsAddress != "" {
+		metricsServer := metrics.StartServer(metricsAddress)
+		defer metricsServer.Close()
+	}
+
+	// Close the pooled connections to aali-llm once the server is drained
+	defer externalfunctions.CloseLLMClients()
+
+	// Drain the server gracefully on SIGTERM or SIGINT
+	shutdownTimeout, err := durationConfigVariable("FLOWKIT_SHUTDOWN_TIMEOUT", 30*time.Second)
+	if err != nil {
+		redact.Log.Fatalf(&logging.ContextMap{}, "invalid shutdown timeout: %v", err)
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(signals)
+	go gracefulShutdown(s, healthServer, signals, shutdownTimeout)
+
+	redact.Log.Infof(&logging.ContextMap{}, "Aali FlowKit started successfully; gRPC server listening on address '%s'...\n", webserverAddress)
 	if err := s.Serve(lis); err != nil {
-		logging.Log.Fatalf(&logging.ContextMap{}, "failed to serve: %v", err)
+		redact.Log.Fatalf(&logging.ContextMap{}, "failed to serve: %v", err)
+	}
+}
+
+// gracefulShutdown waits for a shutdown signal and drains the gRPC server
+// Running calls, including StreamFunction streams, may finish within the timeout before the server is stopped
+//
+// Parameters:
+// - s: the gRPC server
+// - healthServer: the gRPC health server, set to NOT_SERVING on shutdown
+// - signals: the channel receiving the shutdown signals
+// - timeout: the maximum time to wait for running calls
+func gracefulShutdown(s *grpc.Server, healthServer *health.Server, signals <-chan os.Signal, timeout time.Duration) {
+	sig := <-signals
+	redact.Log.Infof(&logging.ContextMap{}, "received %v, shutting down gRPC server gracefully...", sig)
+	healthServer.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		redact.Log.Infof(&logging.ContextMap{}, "gRPC server stopped gracefully")
+	case <-time.After(timeout):
+		redact.Log.Warnf(&logging.ContextMap{}, "running calls did not finish within %v, stopping gRPC server", timeout)
+		s.Stop()
+	}
+}
+
+// setupTracing sets up the tracing from the workflow config variables
+//
+// Returns:
+// - func(context.Context) error: flushes the pending spans and shuts down the exporter
+// - error: an error if the tracing configuration is invalid
+func setupTracing() (func(context.Context) error, error) {
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+	sampleRatio, err := tracing.ParseSampleRatio(variables["FLOWKIT_TRACING_SAMPLE_RATIO"])
+	if err != nil {
+		return nil, err
+	}
+	return tracing.Setup(context.Background(), tracing.Options{
+		ServiceName:  config.GlobalConfig.SERVICE_NAME,
+		Exporter:     variables["FLOWKIT_TRACING_EXPORTER"],
+		OTLPEndpoint: variables["FLOWKIT_TRACING_OTLP_ENDPOINT"],
+		OTLPInsecure: variables["FLOWKIT_TRACING_OTLP_INSECURE"] == "true",
+		File:         variables["FLOWKIT_TRACING_FILE"],
+		SampleRatio:  sampleRatio,
+	})
+}
+
+// durationConfigVariable reads a duration from the workflow config variables
+//
+// Parameters:
+// - key: the name of the config variable
+// - defaultValue: the value used if the variable is not set
+//
+// Returns:
+// - time.Duration: the duration
+// - error: an error if the variable is not a valid duration
+func durationConfigVariable(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES[key]
+	if value == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", key, err)
 	}
+	return duration, nil
+}
+
+// intConfigVariable reads a positive integer from the workflow config variables
+//
+// Parameters:
+// - key: the name of the config variable
+// - defaultValue: the value used if the variable is not set
+//
+// Returns:
+// - int: the integer
+// - error: an error if the variable is not a positive integer
+func intConfigVariable(key string, defaultValue int) (int, error) {
+	value := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES[key]
+	if value == "" {
+		return defaultValue, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", key, err)
+	}
+	if number < 1 {
+		return 0, fmt.Errorf("%s: must be at least 1, got %d", key, number)
+	}
+	return number, nil
 }
 
 // apiKeyAuthInterceptor is a gRPC server interceptor that checks for a valid API key in the metadata of the request
 // The API key is passed as a string parameter
+// The health service is not authenticated, so probes can check the server without a key
 //
 // Parameters:
 // - apiKey: a string containing the API key
+// - policy: the authorization policy, may be nil
 //
 // Returns:
 // - grpc.UnaryServerInterceptor: a gRPC server interceptor
-func apiKeyAuthInterceptor(apiKey string) grpc.UnaryServerInterceptor {
+func apiKeyAuthInterceptor(apiKey string, policy *authPolicy) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Extract API key from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
 		}
 
-		receivedApiKeys := md["x-api-key"]
-		if len(receivedApiKeys) == 0 || receivedApiKeys[0] != apiKey {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+		// Check the API key of the request
+		ctx, err := authenticate(ctx, apiKey, policy)
+		if err != nil {
+			return nil, err
 		}
 
 		// Continue handling the request
@@ -125,7 +374,89 @@ func apiKeyAuthInterceptor(apiKey string) grpc.UnaryServerInterceptor {
 	}
 }
 
+// apiKeyStreamAuthInterceptor is a gRPC stream server interceptor that checks for a valid API key in the metadata of the stream
+// The health service is not authenticated, so probes can watch the server without a key
+//
+// Parameters:
+// - apiKey: a string containing the API key
+// - policy: the authorization policy, may be nil
+//
+// Returns:
+// - grpc.StreamServerInterceptor: a gRPC stream server interceptor
+func apiKeyStreamAuthInterceptor(apiKey string, policy *authPolicy) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(srv, stream)
+		}
+
+		// Check the API key of the stream
+		ctx, err := authenticate(stream.Context(), apiKey, policy)
+		if err != nil {
+			return err
+		}
+
+		// Continue handling the stream
+		return handler(srv, &serverStreamWithContext{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticate checks the API key in the metadata of the request
+// Keys listed in the policy get their permissions stored in the returned context,
+// the global API key grants access to all functions
+//
+// Parameters:
+// - ctx: the context of the request
+// - apiKey: a string containing the global API key
+// - policy: the authorization policy, may be nil
+//
+// Returns:
+// - context.Context: the context of the request with the permissions of the caller
+// - error: an error if the API key is missing or invalid
+func authenticate(ctx context.Context, apiKey string, policy *authPolicy) (context.Context, error) {
+	// Extract API key from metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
+
+	receivedApiKeys := md["x-api-key"]
+	if len(receivedApiKeys) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+
+	if policy != nil {
+		if permissions, exists := policy.keys[receivedApiKeys[0]]; exists {
+			return context.WithValue(ctx, permissionsContextKey{}, permissions), nil
+		}
+	}
+
+	if apiKey == "" || receivedApiKeys[0] != apiKey {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	return ctx, nil
+}
+
+// serverStreamWithContext wraps a grpc.ServerStream to replace its context
+type serverStreamWithContext struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream
+func (s *serverStreamWithContext) Context() context.Context {
+	return s.ctx
+}
+
 // ListFunctions lists all available function from the external functions package
+// The functions can be filtered by category, tag and deprecation status with the
+// x-function-category, x-function-tag and x-function-deprecated request metadata
+// With x-function-schema set to true, the JSON Schema catalogue of the listed functions
+// is returned in the x-function-schema-bin response header
 //
 // Parameters:
 // - ctx: the context of the request
@@ -135,9 +466,31 @@ func apiKeyAuthInterceptor(apiKey string) grpc.UnaryServerInterceptor {
 // - aaliflowkitgrpc.ListOfFunctions: a list of all available functions
 // - error: an error if the function fails
 func (s *server) ListFunctions(ctx context.Context, req *aaliflowkitgrpc.ListFunctionsRequest) (*aaliflowkitgrpc.ListFunctionsResponse, error) {
+	filter, err := functionFilterFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid function filter: %v", err)
+	}
+	withSchema, err := schemaRequested(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid function schema request: %v", err)
+	}
+
+	// return all available functions the caller is allowed to call
+	functions := map[string]*aaliflowkitgrpc.FunctionDefinition{}
+	for name, function := range internalstates.AvailableFunctions {
+		if authorizeFunction(ctx, function) && filter.matches(function) {
+			functions[name] = function
+		}
+	}
 
-	// return all available functions
-	return &aaliflowkitgrpc.ListFunctionsResponse{Functions: internalstates.AvailableFunctions}, nil
+	// send the JSON Schema catalogue of the listed functions if requested
+	if withSchema {
+		err = sendFunctionSchemas(ctx, functions)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+	return &aaliflowkitgrpc.ListFunctionsResponse{Functions: functions}, nil
 }
 
 // RunFunction runs a function from the external functions package
@@ -152,66 +505,40 @@ func (s *server) ListFunctions(ctx context.Context, req *aaliflowkitgrpc.ListFun
 // - aaliflowkitgrpc.FunctionOutputs: the outputs of the function
 // - error: an error if the function fails
 func (s *server) RunFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs) (output *aaliflowkitgrpc.FunctionOutputs, err error) {
+	start := time.Now()
 	defer func() {
 		r := recover()
 		if r != nil {
-			err = fmt.Errorf("error occured in gRPC server aali-flowkit during RunFunction of '%v': %v", req.Name, r)
+			err = panicError("RunFunction", req.Name, r)
 		}
+		observeFunctionCall(req.Name, "RunFunction", start, r != nil, err)
+		functionAudit.record(ctx, "RunFunction", req.Name, functionAudit.inputs(req.Name, req.Inputs), start, err)
 	}()
 
 	// get function definition from available functions
 	functionDefinition, ok := internalstates.AvailableFunctions[req.Name]
 	if !ok {
-		return nil, fmt.Errorf("function with name %s not found", req.Name)
-	}
-
-	// create input slice
-	inputs := make([]interface{}, len(functionDefinition.Input))
-
-	// unmarshal json string values for each input into the correct type
-	for i, input := range req.Inputs {
-		var err error
-		inputs[i], err = typeconverters.ConvertStringToGivenType(input.Value, functionDefinition.Input[i].GoType)
-		if err != nil {
-			return nil, fmt.Errorf("error converting input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-		}
-
-		// check for option sets and convert values
-		if len(functionDefinition.Input[i].Options) > 0 {
-			// convert value to correct type
-			inputs[i], err = convertOptionSetValues(functionDefinition.Name, input.Name, inputs[i])
-			if err != nil {
-				return nil, fmt.Errorf("error converting option set input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-			}
-		}
-	}
-
-	// get externalfunctions package and the function
-	function, exists := externalfunctions.ExternalFunctionsMap[functionDefinition.Name]
-	if !exists {
-		return nil, fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
-	}
-	funcValue := reflect.ValueOf(function)
-	if !funcValue.IsValid() {
-		return nil, fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
+		return nil, status.Errorf(codes.NotFound, "function with name %s not found", req.Name)
 	}
-
-	// Prepare arguments for the function
-	args := []reflect.Value{}
-	for _, input := range inputs {
-		args = append(args, reflect.ValueOf(input))
+	if !authorizeFunction(ctx, functionDefinition) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to call function %s", req.Name)
 	}
+	tracing.SetFunction(ctx, req.Name, functionDefinition.Category)
+	warnIfDeprecated(req.Name)
 
 	// Call the function
-	results := funcValue.Call(args)
+	results, err := callFunction(ctx, "RunFunction", functionDefinition, req.Inputs)
+	if err != nil {
+		return nil, err
+	}
 
 	// create output slice
 	outputs := []*aaliflowkitgrpc.FunctionOutput{}
 	for i, result := range results {
 		// marshal value to json string
-		value, err := typeconverters.ConvertGivenTypeToString(result.Interface(), functionDefinition.Output[i].GoType)
+		value, err := typeconverters.ConvertGivenTypeToString(result, functionDefinition.Output[i].GoType)
 		if err != nil {
-			return nil, fmt.Errorf("error converting output %s to string: %v", functionDefinition.Output[i].Name, err)
+			return nil, status.Errorf(codes.Internal, "error converting output %s to string: %v", functionDefinition.Output[i].Name, err)
 		}
 
 		// append output to slice
@@ -237,67 +564,49 @@ func (s *server) RunFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionI
 // Returns:
 // - error: an error if the function fails
 func (s *server) StreamFunction(req *aaliflowkitgrpc.FunctionInputs, stream aaliflowkitgrpc.ExternalFunctions_StreamFunctionServer) (err error) {
+	start := time.Now()
 	defer func() {
 		r := recover()
 		if r != nil {
-			err = fmt.Errorf("error occured in gRPC server aali-flowkit during StreamFunction of '%v': %v", req.Name, r)
+			err = panicError("StreamFunction", req.Name, r)
 		}
+		observeFunctionCall(req.Name, "StreamFunction", start, r != nil, err)
+		functionAudit.record(stream.Context(), "StreamFunction", req.Name, functionAudit.inputs(req.Name, req.Inputs), start, err)
 	}()
 
 	// get function definition from available functions
 	functionDefinition, ok := internalstates.AvailableFunctions[req.Name]
 	if !ok {
-		return fmt.Errorf("function with id %s not found", req.Name)
+		return status.Errorf(codes.NotFound, "function with id %s not found", req.Name)
 	}
-
-	// create input slice
-	inputs := make([]interface{}, len(functionDefinition.Input))
-
-	// unmarshal json string values for each input into the correct type
-	for i, input := range req.Inputs {
-		var err error
-		inputs[i], err = typeconverters.ConvertStringToGivenType(input.Value, functionDefinition.Input[i].GoType)
-		if err != nil {
-			return fmt.Errorf("error converting input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-		}
-
-		// check for option sets and convert values
-		if len(functionDefinition.Input[i].Options) > 0 {
-			// convert value to correct type
-			inputs[i], err = convertOptionSetValues(functionDefinition.Name, input.Name, inputs[i])
-			if err != nil {
-				return fmt.Errorf("error converting option set input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-			}
-		}
-	}
-
-	// get externalfunctions package and the function
-	function, exists := externalfunctions.ExternalFunctionsMap[functionDefinition.Name]
-	if !exists {
-		return fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
-	}
-	funcValue := reflect.ValueOf(function)
-	if !funcValue.IsValid() {
-		return fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
-	}
-
-	// Prepare arguments for the function
-	args := []reflect.Value{}
-	for _, input := range inputs {
-		args = append(args, reflect.ValueOf(input))
+	if !authorizeFunction(stream.Context(), functionDefinition) {
+		return status.Errorf(codes.PermissionDenied, "not allowed to call function %s", req.Name)
 	}
+	tracing.SetFunction(stream.Context(), req.Name, functionDefinition.Category)
+	warnIfDeprecated(req.Name)
 
 	// Call the function
-	results := funcValue.Call(args)
+	results, err := callFunction(stream.Context(), "StreamFunction", functionDefinition, req.Inputs)
+	if err != nil {
+		return err
+	}
 
 	// get stream channel from results
 	var streamChannel *chan string
 	for i, output := range functionDefinition.Output {
 		if output.GoType == "*chan string" {
-			streamChannel = results[i].Interface().(*chan string)
+			streamChannel = results[i].(*chan string)
 		}
 	}
 
+	// drain the stream if sending fails, so the function can end and the error it ends with is forgotten
+	drained := false
+	defer func() {
+		if !drained {
+			go drainStream(streamChannel)
+		}
+	}()
+
 	// listen to channel and send to stream
 	var counter int32
 	var previousOutput *aaliflowkitgrpc.StreamOutput
@@ -315,6 +624,7 @@ func (s *server) StreamFunction(req *aaliflowkitgrpc.FunctionInputs, stream aali
 			if err != nil {
 				return err
 			}
+			metrics.ObserveStreamMessage(req.Name, functionDefinition.Category)
 		}
 
 		// save output to previous output
@@ -323,6 +633,12 @@ func (s *server) StreamFunction(req *aaliflowkitgrpc.FunctionInputs, stream aali
 		// increment counter
 		counter++
 	}
+	drained = true
+
+	// the function failed if its stream ended with an error
+	if streamErr := externalfunctions.StreamError(streamChannel); streamErr != nil {
+		return functionError("StreamFunction", req.Name, streamErr)
+	}
 
 	// send last message
 	output := &aaliflowkitgrpc.StreamOutput{
@@ -334,10 +650,58 @@ func (s *server) StreamFunction(req *aaliflowkitgrpc.FunctionInputs, stream aali
 	if err != nil {
 		return err
 	}
+	metrics.ObserveStreamMessage(req.Name, functionDefinition.Category)
 
 	return nil
 }
 
+// observeFunctionCall records the metrics of a finished function call
+// Calls of unknown functions are not recorded to keep the label values bounded
+//
+// Parameters:
+// - functionName: the name of the called function
+// - method: the gRPC method, e.g. "RunFunction"
+// - start: the time the call started
+// - panicked: true if the function panicked
+// - err: the error returned to the client
+func observeFunctionCall(functionName string, method string, start time.Time, panicked bool, err error) {
+	functionDefinition, ok := internalstates.AvailableFunctions[functionName]
+	if !ok {
+		return
+	}
+
+	result := metrics.ResultOk
+	if panicked {
+		result = metrics.ResultPanic
+	} else if err != nil {
+		result = metrics.ResultError
+	}
+	metrics.ObserveFunctionCall(functionName, functionDefinition.Category, method, start, result)
+}
+
+// warnIfDeprecated logs a warning if the called function is deprecated
+//
+// Parameters:
+// - functionName: the name of the called function
+func warnIfDeprecated(functionName string) {
+	functionMetadata, ok := internalstates.AvailableFunctionsMetadata[functionName]
+	if ok && functionMetadata.Deprecated {
+		redact.Log.Warnf(&logging.ContextMap{}, "deprecated function %s called: %s", functionName, functionMetadata.DeprecationMessage)
+	}
+}
+
+// acceptsContext checks if the first parameter of the given function is a context.Context
+//
+// Parameters:
+// - funcValue: the reflect value of the function
+//
+// Returns:
+// - bool: true if the function expects the request context as first argument
+func acceptsContext(funcValue reflect.Value) bool {
+	funcType := funcValue.Type()
+	return funcType.NumIn() > 0 && funcType.In(0) == reflect.TypeOf((*context.Context)(nil)).Elem()
+}
+
 // convertOptionSetValues converts the option set values for the given function and input
 //
 // Parameters:
@@ -352,7 +716,7 @@ func convertOptionSetValues(functionName string, inputName string, inputValue in
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic occured in convertOptionSetValues: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic occured in convertOptionSetValues: %v", r)
 		}
 	}()
 
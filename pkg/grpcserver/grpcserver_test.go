@@ -0,0 +1,245 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestGracefulShutdown(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+
+	s := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+
+	signals := make(chan os.Signal, 1)
+	go gracefulShutdown(s, healthServer, signals, time.Second)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- s.Serve(lis)
+	}()
+
+	// the server is healthy while running
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(err)
+	defer conn.Close()
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(err)
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	// the server stops after a SIGTERM
+	signals <- syscall.SIGTERM
+	select {
+	case err := <-served:
+		assert.NoError(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not stop after SIGTERM")
+	}
+}
+
+func TestGracefulShutdownDrainsStreams(t *testing.T) {
+	loadRegistry(t)
+
+	// the stream function sends its last message once it is released
+	release := make(chan struct{})
+	registerFunction(t, &aaliflowkitgrpc.FunctionDefinition{
+		Name:     "SlowStream",
+		Category: "generic",
+		Output:   []*aaliflowkitgrpc.FunctionOutputDefinition{{Name: "stream", GoType: "*chan string"}},
+	}, func(ctx context.Context) *chan string {
+		stream := make(chan string, 3)
+		stream <- "first"
+		stream <- "second"
+		go func() {
+			<-release
+			stream <- "third"
+			close(stream)
+		}()
+		return &stream
+	})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	aaliflowkitgrpc.RegisterExternalFunctionsServer(s, &server{})
+	signals := make(chan os.Signal, 1)
+	shutdown := make(chan struct{})
+	go func() {
+		gracefulShutdown(s, healthServer, signals, 5*time.Second)
+		close(shutdown)
+	}()
+	go s.Serve(lis)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	stream, err := aaliflowkitgrpc.NewExternalFunctionsClient(conn).StreamFunction(context.Background(), &aaliflowkitgrpc.FunctionInputs{Name: "SlowStream"})
+	require.NoError(t, err)
+	output, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "first", output.Value)
+
+	// the running stream holds the shutdown back
+	signals <- syscall.SIGTERM
+	select {
+	case <-shutdown:
+		t.Fatal("the server shut down before the running stream completed")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// the stream completes, then the shutdown returns
+	close(release)
+	values := []string{}
+	for {
+		output, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		values = append(values, output.Value)
+		assert.Equal(t, output.Value == "third", output.IsLast)
+	}
+	assert.Equal(t, []string{"second", "third"}, values)
+	select {
+	case <-shutdown:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the server did not shut down after the stream completed")
+	}
+}
+
+// failingFunctionStream is a StreamFunction stream whose sends fail
+type failingFunctionStream struct {
+	grpc.ServerStream
+}
+
+func (s *failingFunctionStream) Context() context.Context { return context.Background() }
+func (s *failingFunctionStream) Send(output *aaliflowkitgrpc.StreamOutput) error {
+	return io.EOF
+}
+
+func TestStreamFunctionSendFails(t *testing.T) {
+	loadRegistry(t)
+
+	// the function streams more messages than the stream holds and ends with an error
+	ended := make(chan struct{})
+	registerFunction(t, &aaliflowkitgrpc.FunctionDefinition{
+		Name:     "UnreadStream",
+		Category: "generic",
+		Output:   []*aaliflowkitgrpc.FunctionOutputDefinition{{Name: "stream", GoType: "*chan string"}},
+	}, func(ctx context.Context) *chan string {
+		stream := make(chan string)
+		go func() {
+			for _, message := range []string{"aali", "flowkit", "stream"} {
+				stream <- message
+			}
+			externalfunctions.CloseStream(stream, errors.New("not sent"))
+			close(ended)
+		}()
+		return &stream
+	})
+
+	// the stream is drained once sending fails, so the function can end
+	err := (&server{}).StreamFunction(&aaliflowkitgrpc.FunctionInputs{Name: "UnreadStream"}, &failingFunctionStream{})
+	assert.ErrorIs(t, err, io.EOF)
+	select {
+	case <-ended:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream was not drained after sending failed")
+	}
+}
+
+func TestHealthWithoutAPIKey(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(apiKeyAuthInterceptor("secret-key", nil)),
+		grpc.ChainStreamInterceptor(apiKeyStreamAuthInterceptor("secret-key", nil)),
+	)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	aaliflowkitgrpc.RegisterExternalFunctionsServer(s, &server{})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// probes check and watch the health without API key
+	healthClient := healthpb.NewHealthClient(conn)
+	resp, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	watch, err := healthClient.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	resp, err = watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	// the functions still require it
+	_, err = aaliflowkitgrpc.NewExternalFunctionsClient(conn).ListFunctions(context.Background(), &aaliflowkitgrpc.ListFunctionsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestDurationConfigVariable(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"TIMEOUT": "5s", "INVALID": "five"}}
+
+	duration, err := durationConfigVariable("TIMEOUT", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Second, duration)
+
+	duration, err = durationConfigVariable("MISSING", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, duration)
+
+	_, err = durationConfigVariable("INVALID", time.Minute)
+	assert.Error(t, err)
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/privatefunctions/graphdb"
+	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"nhooyr.io/websocket"
+)
+
+// healthCheckTimeout is the timeout of a single dependency health check
+const healthCheckTimeout = 5 * time.Second
+
+// dependencyCheck checks that a dependency of aali-flowkit is reachable
+type dependencyCheck func(ctx context.Context) error
+
+// dependencyChecks returns the health checks of the configured dependencies, keyed by their health service name
+//
+// Returns:
+// - map[string]dependencyCheck: the health checks
+func dependencyChecks() map[string]dependencyCheck {
+	checks := map[string]dependencyCheck{}
+	if config.GlobalConfig.LLM_HANDLER_ENDPOINT != "" {
+		checks["aali-llm"] = checkLLMHandler
+	}
+	if config.GlobalConfig.QDRANT_HOST != "" {
+		checks["qdrant"] = checkQdrant
+	}
+	if config.GlobalConfig.GRAPHDB_ADDRESS != "" {
+		checks["graphdb"] = checkGraphDb
+	}
+	return checks
+}
+
+// checkLLMHandler checks that the aali-llm WebSocket endpoint accepts connections
+//
+// Parameters:
+// - ctx: the context of the check
+//
+// Returns:
+// - error: an error if aali-llm is not reachable
+func checkLLMHandler(ctx context.Context) error {
+	c, _, err := websocket.Dial(ctx, config.GlobalConfig.LLM_HANDLER_ENDPOINT, nil)
+	if err != nil {
+		return err
+	}
+	return c.Close(websocket.StatusNormalClosure, "health check")
+}
+
+// checkQdrant checks that the Qdrant database is reachable
+//
+// Parameters:
+// - ctx: the context of the check
+//
+// Returns:
+// - error: an error if Qdrant is not reachable
+func checkQdrant(ctx context.Context) error {
+	client, err := qdrant_utils.QdrantClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	_, err = client.HealthCheck(ctx)
+	return err
+}
+
+// checkGraphDb checks that the graph database is reachable
+//
+// Parameters:
+// - ctx: the context of the check
+//
+// Returns:
+// - error: an error if the graph database is not reachable
+func checkGraphDb(ctx context.Context) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- graphdb.CheckHealth(config.GlobalConfig.GRAPHDB_ADDRESS)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// updateDependencyHealth runs the dependency checks and updates their status in the health server
+//
+// Parameters:
+// - healthServer: the gRPC health server
+// - checks: the dependency health checks
+func updateDependencyHealth(healthServer *health.Server, checks map[string]dependencyCheck) {
+	for service, check := range checks {
+		ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+		err := check(ctx)
+		cancel()
+
+		if err != nil {
+			redact.Log.Warnf(&logging.ContextMap{}, "health check of %s failed: %v", service, err)
+			healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+		} else {
+			healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+		}
+	}
+}
+
+// watchDependencyHealth periodically updates the health status of the dependencies
+// The overall status of the server ("") is not affected by the dependencies
+//
+// Parameters:
+// - healthServer: the gRPC health server
+// - interval: the interval between two checks
+// - stop: a channel that stops the watcher when closed
+func watchDependencyHealth(healthServer *health.Server, interval time.Duration, stop <-chan struct{}) {
+	checks := dependencyChecks()
+	updateDependencyHealth(healthServer, checks)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			updateDependencyHealth(healthServer, checks)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/typeconverters"
+)
+
+// inputDecoder decodes the request inputs of a function call
+// Omitted inputs are replaced by their default value or, if optional, by nil
+// Each input is checked against the constraints annotated in the function definition
+type inputDecoder struct {
+	functionDefinition *aaliflowkitgrpc.FunctionDefinition
+	functionMetadata   *internalstates.FunctionMetadata
+	inputs             []*aaliflowkitgrpc.FunctionInput
+	// values holds inputs that are already converted, e.g. outputs of other pipeline nodes, by input index
+	values map[int]interface{}
+	// err is the first decoding error, to tell it apart from errors of the function
+	err error
+}
+
+// newInputDecoder creates a decoder for the request inputs of a function call
+// The values of secret inputs are masked in the logs and errors from now on until the request ends
+//
+// Parameters:
+// - ctx: the context of the request
+// - functionDefinition: the definition of the called function
+// - inputs: the inputs of the request, in the order of the function definition
+//
+// Returns:
+// - *inputDecoder: the decoder of the inputs
+// - error: an error if there are more inputs than defined
+func newInputDecoder(ctx context.Context, functionDefinition *aaliflowkitgrpc.FunctionDefinition, inputs []*aaliflowkitgrpc.FunctionInput) (*inputDecoder, error) {
+	if len(inputs) > len(functionDefinition.Input) {
+		return nil, fmt.Errorf("function '%s' expects at most %d inputs, got %d", functionDefinition.Name, len(functionDefinition.Input), len(inputs))
+	}
+
+	functionMetadata, ok := internalstates.AvailableFunctionsMetadata[functionDefinition.Name]
+	if !ok {
+		functionMetadata = &internalstates.FunctionMetadata{}
+	}
+	trackSecretInputs(ctx, functionDefinition, inputs)
+	return &inputDecoder{functionDefinition: functionDefinition, functionMetadata: functionMetadata, inputs: inputs}, nil
+}
+
+// Input returns the input with the given index, it implements externalfunctions.InputDecoder
+//
+// Parameters:
+// - index: the index of the input in the function definition
+//
+// Returns:
+// - *string: the string value of the input, its default if it is omitted
+// - interface{}: the value of an input that is already converted
+// - error: an error if the input does not exist or a required input is missing
+func (d *inputDecoder) Input(index int) (*string, interface{}, error) {
+	if index >= len(d.functionDefinition.Input) {
+		return nil, nil, d.fail(fmt.Errorf("function '%s' has no input %d", d.functionDefinition.Name, index))
+	}
+	inputDefinition := d.functionDefinition.Input[index]
+	if value, ok := d.values[index]; ok {
+		return nil, value, nil
+	}
+
+	// nil inputs are omitted
+	constraints := d.functionMetadata.Constraints(inputDefinition.Name)
+	switch {
+	case index < len(d.inputs) && d.inputs[index] != nil:
+		return &d.inputs[index].Value, nil, nil
+	case constraints.Default != nil:
+		return constraints.Default, nil, nil
+	case !constraints.Required:
+		return nil, nil, nil
+	default:
+		return nil, nil, d.fail(fmt.Errorf("missing required input '%s' of function '%s'", inputDefinition.Name, d.functionDefinition.Name))
+	}
+}
+
+// Constraints returns the constraints of the input with the given index, it implements externalfunctions.InputDecoder
+//
+// Parameters:
+// - index: the index of the input in the function definition
+//
+// Returns:
+// - *internalstates.InputConstraints: the constraints of the input
+func (d *inputDecoder) Constraints(index int) *internalstates.InputConstraints {
+	return d.functionMetadata.Constraints(d.functionDefinition.Input[index].Name)
+}
+
+// Invalid records and returns the error of an invalid input, it implements externalfunctions.InputDecoder
+//
+// Parameters:
+// - index: the index of the input in the function definition
+// - err: the reason the input is invalid
+//
+// Returns:
+// - error: the error naming the input and the function
+func (d *inputDecoder) Invalid(index int, err error) error {
+	return d.fail(fmt.Errorf("invalid input '%s' of function '%s': %v", d.functionDefinition.Input[index].Name, d.functionDefinition.Name, err))
+}
+
+// fail records the first decoding error
+//
+// Parameters:
+// - err: the decoding error
+//
+// Returns:
+// - error: the decoding error
+func (d *inputDecoder) fail(err error) error {
+	if d.err == nil {
+		d.err = err
+	}
+	return err
+}
+
+// decode converts the input with the given index into the type of its definition, for functions called by reflection
+//
+// Parameters:
+// - index: the index of the input in the function definition
+//
+// Returns:
+// - interface{}: the converted input value, nil for an omitted optional input
+// - error: an error describing the invalid input
+func (d *inputDecoder) decode(index int) (interface{}, error) {
+	text, value, err := d.Input(index)
+	if err != nil {
+		return nil, err
+	}
+	if text != nil {
+		inputDefinition := d.functionDefinition.Input[index]
+		value, err = typeconverters.ConvertStringToGivenType(*text, inputDefinition.GoType)
+		if err != nil {
+			return nil, d.fail(fmt.Errorf("error converting input '%s' of function '%s' to type '%s': %v", inputDefinition.Name, d.functionDefinition.Name, inputDefinition.GoType, err))
+		}
+	}
+
+	err = checkInputConstraints(value, d.Constraints(index))
+	if err != nil {
+		return nil, d.Invalid(index, err)
+	}
+	return value, nil
+}
+
+// prepareInputs decodes the request inputs into the arguments of a function call by reflection
+//
+// Parameters:
+// - decoder: the decoder of the request inputs
+// - funcValue: the reflect value of the called function
+//
+// Returns:
+// - []reflect.Value: the arguments of the function call, without the request context
+// - error: an error describing the first invalid input
+func prepareInputs(decoder *inputDecoder, funcValue reflect.Value) ([]reflect.Value, error) {
+	functionDefinition := decoder.functionDefinition
+
+	// the request context is not part of the function definition
+	funcType := funcValue.Type()
+	offset := 0
+	if acceptsContext(funcValue) {
+		offset = 1
+	}
+	if funcType.NumIn()-offset != len(functionDefinition.Input) {
+		return nil, fmt.Errorf("function '%s' takes %d inputs, but its definition has %d", functionDefinition.Name, funcType.NumIn()-offset, len(functionDefinition.Input))
+	}
+
+	args := make([]reflect.Value, len(functionDefinition.Input))
+	for i, inputDefinition := range functionDefinition.Input {
+		paramType := funcType.In(offset + i)
+		value, err := decoder.decode(i)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			args[i] = reflect.Zero(paramType)
+			continue
+		}
+
+		// check for option sets of enumerable types and convert values
+		if len(inputDefinition.Options) > 0 && paramType != reflect.TypeOf("") {
+			value, err = convertOptionSetValues(functionDefinition.Name, inputDefinition.Name, value)
+			if err != nil {
+				return nil, fmt.Errorf("error converting option set input '%s' of function '%s' to type '%s': %v", inputDefinition.Name, functionDefinition.Name, inputDefinition.GoType, err)
+			}
+		}
+		args[i] = reflect.ValueOf(value)
+	}
+
+	return args, nil
+}
+
+// checkInputConstraints checks a converted input value against its constraints
+//
+// Parameters:
+// - value: the converted input value
+// - constraints: the constraints of the input
+//
+// Returns:
+// - error: an error describing the violated constraint
+func checkInputConstraints(value interface{}, constraints *internalstates.InputConstraints) error {
+	if value == nil {
+		return nil
+	}
+	reflectValue := reflect.ValueOf(value)
+
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return constraints.CheckNumber(reflectValue.Convert(reflect.TypeOf(float64(0))).Float())
+
+	case reflect.String:
+		return constraints.CheckText(reflectValue.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPrepareInputs(t *testing.T) {
+	internalstates.InitializeInternalStates()
+	minCount, maxCount, defaultCount := 1.0, 100.0, "10"
+	functionDefinition := &aaliflowkitgrpc.FunctionDefinition{
+		Name: "Search",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "count", Type: "number", GoType: "int"},
+			{Name: "mode", Type: "string", GoType: "string", Options: []string{"fast", "exact"}},
+		},
+	}
+	internalstates.AvailableFunctionsMetadata["Search"] = &internalstates.FunctionMetadata{
+		Inputs: map[string]*internalstates.InputConstraints{
+			"query": {Required: true, Pattern: `^\w+$`},
+			"count": {Default: &defaultCount, Min: &minCount, Max: &maxCount},
+			"mode":  {Enum: []string{"fast", "exact"}},
+		},
+	}
+	require.NoError(t, internalstates.AvailableFunctionsMetadata["Search"].CompilePatterns())
+	search := reflect.ValueOf(func(ctx context.Context, query string, count int, mode string) string { return query })
+
+	prepare := func(values ...string) ([]interface{}, error) {
+		inputs := []*aaliflowkitgrpc.FunctionInput{}
+		for _, value := range values {
+			inputs = append(inputs, &aaliflowkitgrpc.FunctionInput{Value: value})
+		}
+		decoder, err := newInputDecoder(context.Background(), functionDefinition, inputs)
+		if err != nil {
+			return nil, err
+		}
+		args, err := prepareInputs(decoder, search)
+		if err != nil {
+			return nil, err
+		}
+		result := []interface{}{}
+		for _, arg := range args {
+			result = append(result, arg.Interface())
+		}
+		return result, nil
+	}
+
+	args, err := prepare("fluent", "5", "exact")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"fluent", 5, "exact"}, args)
+
+	// omitted inputs get their default or zero value
+	args, err = prepare("fluent")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"fluent", 10, ""}, args)
+
+	_, err = prepare()
+	assert.EqualError(t, err, "missing required input 'query' of function 'Search'")
+
+	_, err = prepare("fluent", "0")
+	assert.EqualError(t, err, "invalid input 'count' of function 'Search': must be at least 1, got 0")
+
+	_, err = prepare("fluent", "101")
+	assert.EqualError(t, err, "invalid input 'count' of function 'Search': must be at most 100, got 101")
+
+	_, err = prepare("fluent", "5", "slow")
+	assert.EqualError(t, err, "invalid input 'mode' of function 'Search': must be one of [fast exact], got 'slow'")
+
+	_, err = prepare("fluent mesh")
+	assert.EqualError(t, err, `invalid input 'query' of function 'Search': must match pattern '^\w+$', got 'fluent mesh'`)
+
+	_, err = prepare("fluent", "5", "exact", "extra")
+	assert.EqualError(t, err, "function 'Search' expects at most 3 inputs, got 4")
+}
+
+func TestRunFunctionMissingInput(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	internalstates.InitializeInternalStates()
+	internalstates.AvailableFunctions["AssignStringToString"] = &aaliflowkitgrpc.FunctionDefinition{
+		Name:   "AssignStringToString",
+		Input:  []*aaliflowkitgrpc.FunctionInputDefinition{{Name: "inputString", Type: "string", GoType: "string"}},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{{Name: "outputString", Type: "string", GoType: "string"}},
+	}
+
+	_, err := (&server{}).RunFunction(context.Background(), &aaliflowkitgrpc.FunctionInputs{Name: "AssignStringToString"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "missing required input 'inputString' of function 'AssignStringToString'")
+}
@@ -0,0 +1,455 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/audit"
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-flowkit/pkg/pipelinegrpc"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/typeconverters"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pipelineServer implements the ExternalFunctionsPipeline service
+type pipelineServer struct {
+	pipelinegrpc.UnimplementedExternalFunctionsPipelineServer
+	maxNodes int
+}
+
+// newPipelineServer creates the pipeline service with the limits of the workflow config variables
+//
+// Returns:
+// - *pipelineServer: the pipeline service
+// - error: an error if a limit is invalid
+func newPipelineServer() (*pipelineServer, error) {
+	maxNodes, err := intConfigVariable("FLOWKIT_PIPELINE_MAX_NODES", 32)
+	if err != nil {
+		return nil, err
+	}
+	return &pipelineServer{maxNodes: maxNodes}, nil
+}
+
+// pipelineNode is a node of a pipeline checked against the function definitions
+type pipelineNode struct {
+	id                 string
+	functionDefinition *aaliflowkitgrpc.FunctionDefinition
+	// inputs holds the request values by input index, nil for omitted and referenced inputs
+	inputs []*aaliflowkitgrpc.FunctionInput
+	// references holds the outputs of other nodes passed to the inputs, by input index
+	references map[int]pipelineReference
+}
+
+// pipelineReference is an output of a pipeline node, identified by its index
+type pipelineReference struct {
+	node   string
+	output int
+}
+
+// RunPipeline runs a DAG of function calls and sends the outputs of the output node
+// The nodes run in dependency order, the first failing node aborts the pipeline
+// Once the pipeline ends, its context is cancelled and the remaining streams are drained so their producers exit
+//
+// Parameters:
+// - req: the nodes of the pipeline and the output node
+// - stream: the stream to send the outputs
+//
+// Returns:
+// - error: an error if the pipeline is invalid or a node fails
+func (s *pipelineServer) RunPipeline(req *pipelinegrpc.PipelineRequest, stream grpc.ServerStreamingServer[pipelinegrpc.PipelineOutput]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	nodes, outputNode, err := planPipeline(ctx, req, s.maxNodes)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	// the streams that are not drained yet when the pipeline ends
+	var pending []*chan string
+	defer func() {
+		cancel()
+		for _, channel := range pending {
+			go drainStream(channel)
+		}
+	}()
+
+	// the outputs of the nodes by node id
+	results := map[string][]interface{}{}
+	referenced := map[pipelineReference]bool{}
+	for _, node := range nodes {
+		for _, reference := range node.references {
+			referenced[reference] = true
+		}
+	}
+	for _, node := range nodes {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		outputs, err := runPipelineNode(ctx, node, results)
+		if err != nil {
+			return err
+		}
+		results[node.id] = outputs
+
+		// drain the unused streams of intermediate nodes so their producers do not block
+		for i, output := range node.functionDefinition.Output {
+			channel, ok := outputs[i].(*chan string)
+			if output.GoType != "*chan string" || !ok || channel == nil {
+				continue
+			}
+			if node != outputNode && !referenced[pipelineReference{node: node.id, output: i}] {
+				go drainStream(channel)
+			} else {
+				pending = append(pending, channel)
+			}
+		}
+	}
+
+	return sendPipelineOutputs(stream, outputNode, results[outputNode.id])
+}
+
+// planPipeline checks the nodes of a pipeline request and sorts them in dependency order
+// The inputs referencing outputs of other nodes must have the type of the output
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the pipeline request
+// - maxNodes: the maximum number of nodes of a pipeline
+//
+// Returns:
+// - []*pipelineNode: the nodes in dependency order
+// - *pipelineNode: the node whose outputs are returned
+// - error: a gRPC status error if the pipeline is invalid
+func planPipeline(ctx context.Context, req *pipelinegrpc.PipelineRequest, maxNodes int) ([]*pipelineNode, *pipelineNode, error) {
+	if len(req.Nodes) == 0 {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline has no nodes")
+	}
+	if len(req.Nodes) > maxNodes {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline of %d nodes exceeds the limit of %d nodes", len(req.Nodes), maxNodes)
+	}
+
+	// resolve the functions of the nodes
+	nodes := map[string]*pipelineNode{}
+	for _, requestNode := range req.Nodes {
+		if requestNode.Id == "" {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline node of function %s has no id", requestNode.Function)
+		}
+		if _, exists := nodes[requestNode.Id]; exists {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "duplicate pipeline node %s", requestNode.Id)
+		}
+		functionDefinition, ok := internalstates.AvailableFunctions[requestNode.Function]
+		if !ok {
+			return nil, nil, status.Errorf(codes.NotFound, "function with name %s of pipeline node %s not found", requestNode.Function, requestNode.Id)
+		}
+		if !authorizeFunction(ctx, functionDefinition) {
+			return nil, nil, status.Errorf(codes.PermissionDenied, "not allowed to call function %s", requestNode.Function)
+		}
+		nodes[requestNode.Id] = &pipelineNode{
+			id:                 requestNode.Id,
+			functionDefinition: functionDefinition,
+			inputs:             make([]*aaliflowkitgrpc.FunctionInput, len(functionDefinition.Input)),
+			references:         map[int]pipelineReference{},
+		}
+	}
+
+	// resolve the inputs of the nodes and check the types of the references
+	dependents := map[string][]string{}
+	for _, requestNode := range req.Nodes {
+		node := nodes[requestNode.Id]
+		for _, input := range requestNode.Inputs {
+			index := inputIndex(node.functionDefinition, input.Name)
+			if index < 0 {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline node %s: function %s has no input %s", node.id, node.functionDefinition.Name, input.Name)
+			}
+			if node.inputs[index] != nil {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline node %s: duplicate input %s", node.id, input.Name)
+			}
+			if _, exists := node.references[index]; exists {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline node %s: duplicate input %s", node.id, input.Name)
+			}
+			if input.Reference == nil {
+				node.inputs[index] = &aaliflowkitgrpc.FunctionInput{Name: input.Name, Value: input.Value, GoType: node.functionDefinition.Input[index].GoType}
+				continue
+			}
+
+			source, ok := nodes[input.Reference.Node]
+			if !ok {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline node %s: input %s references unknown node %s", node.id, input.Name, input.Reference.Node)
+			}
+			output := outputIndex(source.functionDefinition, input.Reference.Output)
+			if output < 0 {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline node %s: input %s references unknown output %s of node %s", node.id, input.Name, input.Reference.Output, source.id)
+			}
+			inputType := node.functionDefinition.Input[index].GoType
+			outputType := source.functionDefinition.Output[output].GoType
+			if inputType != outputType && inputType != "interface{}" && inputType != "any" {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline node %s: input %s of type %s cannot take output %s of node %s of type %s", node.id, input.Name, inputType, input.Reference.Output, source.id, outputType)
+			}
+			node.references[index] = pipelineReference{node: source.id, output: output}
+			dependents[source.id] = append(dependents[source.id], node.id)
+		}
+	}
+
+	// sort the nodes in dependency order, keeping the request order of independent nodes
+	dependencies := map[string]int{}
+	for _, node := range nodes {
+		dependencies[node.id] = len(node.references)
+	}
+	sorted := []*pipelineNode{}
+	ready := []string{}
+	for _, requestNode := range req.Nodes {
+		if dependencies[requestNode.Id] == 0 {
+			ready = append(ready, requestNode.Id)
+		}
+	}
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, nodes[id])
+		for _, dependent := range dependents[id] {
+			dependencies[dependent]--
+			if dependencies[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if len(sorted) != len(nodes) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline has a cycle")
+	}
+
+	// the output node defaults to the only node no other node references
+	if req.OutputNode != "" {
+		outputNode, ok := nodes[req.OutputNode]
+		if !ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "unknown pipeline output node %s", req.OutputNode)
+		}
+		return sorted, outputNode, nil
+	}
+	var outputNode *pipelineNode
+	for _, node := range sorted {
+		if len(dependents[node.id]) > 0 {
+			continue
+		}
+		if outputNode != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "pipeline has several terminal nodes (%s, %s), set the output node", outputNode.id, node.id)
+		}
+		outputNode = node
+	}
+	return sorted, outputNode, nil
+}
+
+// runPipelineNode calls the function of a pipeline node in its own span
+// Each node is charged to the rate limit and quota of the API key
+//
+// Parameters:
+// - ctx: the context of the request
+// - node: the pipeline node
+// - results: the outputs of the nodes that already ran, by node id
+//
+// Returns:
+// - []interface{}: the outputs of the function
+// - error: a gRPC status error naming the node if the function fails
+func runPipelineNode(ctx context.Context, node *pipelineNode, results map[string][]interface{}) (outputs []interface{}, err error) {
+	functionName := node.functionDefinition.Name
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "RunPipeline/"+node.id, attribute.String("flowkit.pipeline.node", node.id))
+	defer func() {
+		r := recover()
+		if r != nil {
+			err = panicError("RunPipeline", functionName, r)
+		}
+		observeFunctionCall(functionName, "RunPipeline", start, r != nil, err)
+		functionAudit.record(ctx, "RunPipeline", functionName, node.auditInputs(), start, err)
+		tracing.End(span, err)
+		if err != nil {
+			err = status.Errorf(status.Code(err), "pipeline node %s: %s", node.id, status.Convert(err).Message())
+		}
+	}()
+	tracing.SetFunction(ctx, functionName, node.functionDefinition.Category)
+	warnIfDeprecated(functionName)
+
+	if err := callRateLimiter.checkCall(ctx); err != nil {
+		return nil, err
+	}
+	inputs, err := resolveSecretReferences(ctx, node.functionDefinition, node.inputs)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := newInputDecoder(ctx, node.functionDefinition, inputs)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	decoder.values = map[int]interface{}{}
+	for index, reference := range node.references {
+		decoder.values[index] = results[reference.node][reference.output]
+	}
+	return invokeFunction(ctx, "RunPipeline", decoder)
+}
+
+// auditInputs returns the audit inputs of a pipeline node, naming the node of referenced inputs
+//
+// Returns:
+// - []audit.Input: the audit inputs, nil if auditing is disabled
+func (node *pipelineNode) auditInputs() []audit.Input {
+	inputs := functionAudit.inputs(node.functionDefinition.Name, node.inputs)
+	if inputs == nil {
+		return nil
+	}
+	for index, input := range node.functionDefinition.Input {
+		if reference, ok := node.references[index]; ok {
+			inputs = append(inputs, audit.Input{Name: input.Name, Reference: reference.node})
+		}
+	}
+	return inputs
+}
+
+// sendPipelineOutputs sends the outputs of the output node of a pipeline
+// A *chan string output is streamed after the first message, the last chunk is marked as last
+//
+// Parameters:
+// - stream: the stream to send the outputs
+// - node: the output node
+// - results: the outputs of the output node
+//
+// Returns:
+// - error: an error if an output cannot be converted, the stream reports an error or sending fails
+func sendPipelineOutputs(stream grpc.ServerStreamingServer[pipelinegrpc.PipelineOutput], node *pipelineNode, results []interface{}) error {
+	functionDefinition := node.functionDefinition
+	first := &pipelinegrpc.PipelineOutput{}
+	var streamChannel *chan string
+
+	// drain the stream on every early exit, so the function can end and the error it ends with is forgotten
+	drained := false
+	defer func() {
+		if streamChannel != nil && !drained {
+			go drainStream(streamChannel)
+		}
+	}()
+
+	for i, outputDefinition := range functionDefinition.Output {
+		if outputDefinition.GoType == "*chan string" {
+			streamChannel, _ = results[i].(*chan string)
+			continue
+		}
+		value, err := typeconverters.ConvertGivenTypeToString(results[i], outputDefinition.GoType)
+		if err != nil {
+			return status.Errorf(codes.Internal, "error converting output %s of pipeline node %s to string: %v", outputDefinition.Name, node.id, err)
+		}
+		first.Outputs = append(first.Outputs, &pipelinegrpc.PipelineFunctionOutput{
+			Name:   outputDefinition.Name,
+			GoType: outputDefinition.GoType,
+			Value:  value,
+		})
+	}
+
+	first.IsLast = streamChannel == nil
+	err := stream.Send(first)
+	if err != nil || streamChannel == nil {
+		return err
+	}
+
+	// listen to channel and send to stream, holding back a chunk to mark the last one
+	var counter int32
+	var previous *pipelinegrpc.PipelineOutput
+	for message := range *streamChannel {
+		if previous != nil {
+			err := stream.Send(previous)
+			if err != nil {
+				return err
+			}
+			metrics.ObserveStreamMessage(functionDefinition.Name, functionDefinition.Category)
+		}
+		previous = &pipelinegrpc.PipelineOutput{MessageCounter: counter, Chunk: message}
+		counter++
+	}
+	drained = true
+	if previous == nil {
+		previous = &pipelinegrpc.PipelineOutput{MessageCounter: counter}
+	}
+
+	// the function failed if its stream ended with an error
+	if streamErr := externalfunctions.StreamError(streamChannel); streamErr != nil {
+		return functionError("RunPipeline", functionDefinition.Name, streamErr)
+	}
+
+	previous.IsLast = true
+	err = stream.Send(previous)
+	if err != nil {
+		return err
+	}
+	metrics.ObserveStreamMessage(functionDefinition.Name, functionDefinition.Category)
+	return nil
+}
+
+// drainStream reads a stream channel until it is closed and discards the error it ended with
+//
+// Parameters:
+// - channel: the stream channel
+func drainStream(channel *chan string) {
+	for range *channel {
+	}
+	externalfunctions.StreamError(channel)
+}
+
+// inputIndex finds the index of an input of a function by name
+//
+// Parameters:
+// - functionDefinition: the definition of the function
+// - name: the name of the input
+//
+// Returns:
+// - int: the index of the input, -1 if the function has no such input
+func inputIndex(functionDefinition *aaliflowkitgrpc.FunctionDefinition, name string) int {
+	for i, input := range functionDefinition.Input {
+		if input.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// outputIndex finds the index of an output of a function by name
+//
+// Parameters:
+// - functionDefinition: the definition of the function
+// - name: the name of the output
+//
+// Returns:
+// - int: the index of the output, -1 if the function has no such output
+func outputIndex(functionDefinition *aaliflowkitgrpc.FunctionDefinition, name string) int {
+	for i, output := range functionDefinition.Output {
+		if output.Name == name {
+			return i
+		}
+	}
+	return -1
+}
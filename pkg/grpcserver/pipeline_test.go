@@ -0,0 +1,303 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/pipelinegrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// pipelineValue creates a pipeline input set to a value
+func pipelineValue(name string, value string) *pipelinegrpc.PipelineInput {
+	return &pipelinegrpc.PipelineInput{Name: name, Value: value}
+}
+
+// pipelineRef creates a pipeline input referencing an output of another node
+func pipelineRef(name string, node string, output string) *pipelinegrpc.PipelineInput {
+	return &pipelinegrpc.PipelineInput{Name: name, Reference: &pipelinegrpc.PipelineReference{Node: node, Output: output}}
+}
+
+// pipelineStream records the messages sent by RunPipeline
+type pipelineStream struct {
+	grpc.ServerStream
+	messages []*pipelinegrpc.PipelineOutput
+	// err is returned by Send if set
+	err error
+}
+
+func (s *pipelineStream) Context() context.Context { return context.Background() }
+func (s *pipelineStream) Send(output *pipelinegrpc.PipelineOutput) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.messages = append(s.messages, output)
+	return nil
+}
+
+func TestRunPipeline(t *testing.T) {
+	loadRegistry(t)
+
+	// serve the pipeline service over an in-memory connection
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	pipelinegrpc.RegisterExternalFunctionsPipelineServer(s, &pipelineServer{maxNodes: 8})
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pipelinegrpc.NewExternalFunctionsPipelineClient(conn)
+
+	// the nodes are given out of order and run in dependency order
+	stream, err := client.RunPipeline(context.Background(), &pipelinegrpc.PipelineRequest{
+		Nodes: []*pipelinegrpc.PipelineNode{
+			{Id: "reply", Function: "AppendMessageHistory", Inputs: []*pipelinegrpc.PipelineInput{
+				pipelineValue("newMessage", "hi"),
+				pipelineValue("role", "assistant"),
+				pipelineRef("history", "question", "updatedHistory"),
+			}},
+			{Id: "question", Function: "AppendMessageHistory", Inputs: []*pipelinegrpc.PipelineInput{
+				pipelineRef("newMessage", "suffix", "string"),
+				pipelineValue("role", "user"),
+				pipelineValue("history", "[]"),
+			}},
+			{Id: "prefix", Function: "StringConcat", Inputs: []*pipelinegrpc.PipelineInput{
+				pipelineValue("a", "aali"),
+				pipelineValue("b", "flow"),
+				pipelineValue("separator", ""),
+			}},
+			{Id: "suffix", Function: "StringConcat", Inputs: []*pipelinegrpc.PipelineInput{
+				pipelineRef("a", "prefix", "string"),
+				pipelineValue("b", "kit"),
+				pipelineValue("separator", ""),
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	output, err := stream.Recv()
+	require.NoError(t, err)
+	assert.True(t, output.IsLast)
+	require.Len(t, output.Outputs, 1)
+	assert.Equal(t, "updatedHistory", output.Outputs[0].Name)
+	history := []sharedtypes.HistoricMessage{}
+	require.NoError(t, json.Unmarshal([]byte(output.Outputs[0].Value), &history))
+	assert.Equal(t, []sharedtypes.HistoricMessage{{Role: "user", Content: "aaliflowkit"}, {Role: "assistant", Content: "hi"}}, history)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	// a failing node aborts the pipeline and is named in the error
+	stream, err = client.RunPipeline(context.Background(), &pipelinegrpc.PipelineRequest{
+		Nodes: []*pipelinegrpc.PipelineNode{
+			{Id: "question", Function: "AppendMessageHistory", Inputs: []*pipelinegrpc.PipelineInput{
+				pipelineValue("newMessage", "hello"),
+				pipelineValue("role", "admin"),
+				pipelineValue("history", "[]"),
+			}},
+		},
+	})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "pipeline node question")
+}
+
+func TestRunPipelineStopsStreams(t *testing.T) {
+	loadRegistry(t)
+
+	// the first node streams until its context is cancelled, blocking while nobody reads
+	exited := make(chan error, 1)
+	registerFunction(t, &aaliflowkitgrpc.FunctionDefinition{
+		Name:   "StreamUntilCancelled",
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{{Name: "stream", GoType: "*chan string"}},
+	}, func(ctx context.Context) *chan string {
+		channel := make(chan string)
+		go func() {
+			for ctx.Err() == nil {
+				channel <- "chunk"
+			}
+			externalfunctions.CloseStream(channel, ctx.Err())
+			exited <- ctx.Err()
+		}()
+		return &channel
+	})
+	// the second node takes the stream and fails
+	registerFunction(t, &aaliflowkitgrpc.FunctionDefinition{
+		Name:  "FailWithStream",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{{Name: "data", GoType: "interface{}"}},
+	}, func(data interface{}) error {
+		return fmt.Errorf("%w: stream rejected", externalfunctions.ErrInvalidInput)
+	})
+
+	err := (&pipelineServer{maxNodes: 8}).RunPipeline(&pipelinegrpc.PipelineRequest{
+		Nodes: []*pipelinegrpc.PipelineNode{
+			{Id: "stream", Function: "StreamUntilCancelled"},
+			{Id: "fail", Function: "FailWithStream", Inputs: []*pipelinegrpc.PipelineInput{pipelineRef("data", "stream", "stream")}},
+		},
+	}, &pipelineStream{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "pipeline node fail")
+
+	// the producer of the first node is cancelled and unblocked
+	select {
+	case err := <-exited:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the producer of the stream did not exit")
+	}
+}
+
+func TestPlanPipelineInvalid(t *testing.T) {
+	loadRegistry(t)
+
+	concat := func(id string, inputs ...*pipelinegrpc.PipelineInput) *pipelinegrpc.PipelineNode {
+		return &pipelinegrpc.PipelineNode{Id: id, Function: "StringConcat", Inputs: inputs}
+	}
+	tests := []struct {
+		name    string
+		request *pipelinegrpc.PipelineRequest
+		code    codes.Code
+		message string
+	}{
+		{"empty", &pipelinegrpc.PipelineRequest{}, codes.InvalidArgument, "pipeline has no nodes"},
+		{"too many nodes", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a"), concat("b"), concat("c")}}, codes.InvalidArgument, "exceeds the limit of 2 nodes"},
+		{"duplicate id", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a"), concat("a")}}, codes.InvalidArgument, "duplicate pipeline node a"},
+		{"unknown function", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{{Id: "a", Function: "UnknownFunction"}}}, codes.NotFound, "UnknownFunction"},
+		{"unknown input", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a", pipelineValue("c", ""))}}, codes.InvalidArgument, "function StringConcat has no input c"},
+		{"unknown node", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a", pipelineRef("a", "b", "string"))}}, codes.InvalidArgument, "references unknown node b"},
+		{"unknown output", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a"), concat("b", pipelineRef("a", "a", "text"))}}, codes.InvalidArgument, "references unknown output text of node a"},
+		{"cycle", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a", pipelineRef("a", "b", "string")), concat("b", pipelineRef("a", "a", "string"))}}, codes.InvalidArgument, "pipeline has a cycle"},
+		{"several terminal nodes", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a"), concat("b")}}, codes.InvalidArgument, "several terminal nodes (a, b)"},
+		{"unknown output node", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{concat("a")}, OutputNode: "b"}, codes.InvalidArgument, "unknown pipeline output node b"},
+		{"type mismatch", &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{
+			concat("a"),
+			{Id: "b", Function: "AppendMessageHistory", Inputs: []*pipelinegrpc.PipelineInput{pipelineRef("history", "a", "string")}},
+		}}, codes.InvalidArgument, "input history of type []HistoricMessage cannot take output string of node a of type string"},
+	}
+
+	for _, test := range tests {
+		_, _, err := planPipeline(context.Background(), test.request, 2)
+		assert.Equal(t, test.code, status.Code(err), test.name)
+		assert.ErrorContains(t, err, test.message, test.name)
+	}
+
+	// any input takes outputs of every type
+	nodes, outputNode, err := planPipeline(context.Background(), &pipelinegrpc.PipelineRequest{Nodes: []*pipelinegrpc.PipelineNode{
+		{Id: "b", Function: "CastAnyToString", Inputs: []*pipelinegrpc.PipelineInput{pipelineRef("data", "a", "string")}},
+		concat("a"),
+	}}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "a", nodes[0].id)
+	assert.Equal(t, "b", outputNode.id)
+}
+
+func TestSendPipelineOutputs(t *testing.T) {
+	node := &pipelineNode{id: "answer", functionDefinition: &aaliflowkitgrpc.FunctionDefinition{
+		Name: "PerformGeneralRequest",
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", GoType: "string"},
+			{Name: "stream", GoType: "*chan string"},
+		},
+	}}
+
+	// the streamed output follows the other outputs, the last chunk is marked
+	channel := make(chan string, 2)
+	channel <- "aali"
+	channel <- "flowkit"
+	close(channel)
+	stream := &pipelineStream{}
+	require.NoError(t, sendPipelineOutputs(stream, node, []interface{}{"", &channel}))
+	require.Len(t, stream.messages, 3)
+	assert.False(t, stream.messages[0].IsLast)
+	require.Len(t, stream.messages[0].Outputs, 1)
+	assert.Equal(t, "message", stream.messages[0].Outputs[0].Name)
+	assert.Equal(t, &pipelinegrpc.PipelineOutput{MessageCounter: 0, Chunk: "aali"}, stream.messages[1])
+	assert.Equal(t, &pipelinegrpc.PipelineOutput{MessageCounter: 1, Chunk: "flowkit", IsLast: true}, stream.messages[2])
+
+	// an empty stream still ends with a last message
+	empty := make(chan string)
+	close(empty)
+	stream = &pipelineStream{}
+	require.NoError(t, sendPipelineOutputs(stream, node, []interface{}{"", &empty}))
+	require.Len(t, stream.messages, 2)
+	assert.True(t, stream.messages[1].IsLast)
+
+	// a chunk looking like the former in-band error marker is streamed as it is
+	marker := make(chan string, 1)
+	marker <- "$&$error$&$:$&$quoted$&$"
+	close(marker)
+	stream = &pipelineStream{}
+	require.NoError(t, sendPipelineOutputs(stream, node, []interface{}{"", &marker}))
+	require.Len(t, stream.messages, 2)
+	assert.Equal(t, "$&$error$&$:$&$quoted$&$", stream.messages[1].Chunk)
+
+	// the error a stream ends with fails the pipeline with the status of its class
+	failing := make(chan string, 1)
+	failing <- "aali"
+	externalfunctions.CloseStream(failing, fmt.Errorf("%w: connection lost", externalfunctions.ErrUpstreamUnavailable))
+	stream = &pipelineStream{}
+	err := sendPipelineOutputs(stream, node, []interface{}{"", &failing})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, err, "connection lost")
+	assert.Len(t, stream.messages, 1)
+	assert.NoError(t, externalfunctions.StreamError(&failing))
+
+	// the stream is drained if sending fails, so its function can end
+	unread := make(chan string)
+	ended := make(chan struct{})
+	go func() {
+		unread <- "aali"
+		unread <- "flowkit"
+		externalfunctions.CloseStream(unread, errors.New("not sent"))
+		close(ended)
+	}()
+	err = sendPipelineOutputs(&pipelineStream{err: io.EOF}, node, []interface{}{"", &unread})
+	assert.ErrorIs(t, err, io.EOF)
+	select {
+	case <-ended:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream was not drained after sending failed")
+	}
+}
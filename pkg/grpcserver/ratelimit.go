@@ -0,0 +1,295 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/batchgrpc"
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-flowkit/pkg/pipelinegrpc"
+	"github.com/ansys/aali-flowkit/pkg/ratelimit"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// healthServicePrefix is the method prefix of the gRPC health service, which is neither authenticated nor rate limited
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// callRateLimiter charges the calls of the batches and the nodes of the pipelines, nil if no limit is configured
+// The batch and pipeline requests run many functions, so each of them is charged instead of the request
+var callRateLimiter *rateLimiter
+
+// perCallMethods are the methods charged per function call by callRateLimiter instead of the interceptors
+var perCallMethods = map[string]bool{
+	batchgrpc.ExternalFunctionsBatch_RunFunctionsBatch_FullMethodName: true,
+	pipelinegrpc.ExternalFunctionsPipeline_RunPipeline_FullMethodName: true,
+}
+
+// rateLimiter applies a token bucket rate limit and a daily call quota to each API key
+// Requests without API key share one bucket and quota
+type rateLimiter struct {
+	store ratelimit.Store
+	// limit is the limit of keys without own limit
+	limit ratelimit.Limit
+	// keys holds the limits of the keys of the authorization policy, overriding the server limit
+	keys map[string]ratelimit.Limit
+}
+
+// newRateLimiter creates the rate limiter from the workflow config variables and the authorization policy
+//
+// Parameters:
+// - policy: the authorization policy, may be nil
+//
+// Returns:
+// - *rateLimiter: the rate limiter, nil if no limit is configured
+// - error: an error if the configuration is invalid
+func newRateLimiter(policy *authPolicy) (*rateLimiter, error) {
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+
+	var limit ratelimit.Limit
+	if value := variables["FLOWKIT_RATE_LIMIT"]; value != "" {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("FLOWKIT_RATE_LIMIT: %v", err)
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf("FLOWKIT_RATE_LIMIT: must be positive, got %v", rate)
+		}
+		limit.Rate = rate
+	}
+	var err error
+	limit.Burst, err = intConfigVariable("FLOWKIT_RATE_LIMIT_BURST", 0)
+	if err != nil {
+		return nil, err
+	}
+	limit.DailyQuota, err = intConfigVariable("FLOWKIT_DAILY_QUOTA", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter := &rateLimiter{limit: limit, keys: map[string]ratelimit.Limit{}}
+	if policy != nil {
+		for key, keyLimit := range policy.limits {
+			limiter.keys[key] = keyLimit
+		}
+	}
+	if limit.Rate == 0 && limit.DailyQuota == 0 && len(limiter.keys) == 0 {
+		return nil, nil
+	}
+
+	switch backend := variables["FLOWKIT_RATE_LIMIT_BACKEND"]; backend {
+	case "", "memory":
+		limiter.store = ratelimit.NewMemoryStore()
+	case "redis":
+		redis, err := newRedisStore("FLOWKIT_RATE_LIMIT_REDIS_NAMESPACE", "aali-flowkit:ratelimit:")
+		if err != nil {
+			return nil, err
+		}
+		limiter.store = ratelimit.NewRedisStore(redis)
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend %q, expected \"memory\" or \"redis\"", backend)
+	}
+	return limiter, nil
+}
+
+// limitOf returns the limit of an API key
+// The rate limit, burst and daily quota of the key replace the server limit if set,
+// the burst defaults to one second of calls
+//
+// Parameters:
+// - apiKey: the API key
+//
+// Returns:
+// - ratelimit.Limit: the limit of the key
+func (l *rateLimiter) limitOf(apiKey string) ratelimit.Limit {
+	limit := l.limit
+	if keyLimit, ok := l.keys[apiKey]; ok {
+		if keyLimit.Rate > 0 {
+			limit.Rate = keyLimit.Rate
+			limit.Burst = keyLimit.Burst
+		} else if keyLimit.Burst > 0 {
+			limit.Burst = keyLimit.Burst
+		}
+		if keyLimit.DailyQuota > 0 {
+			limit.DailyQuota = keyLimit.DailyQuota
+		}
+	}
+	if limit.Burst == 0 {
+		limit.Burst = int(math.Max(1, math.Ceil(limit.Rate)))
+	}
+	return limit
+}
+
+// check takes a token and counts the call of the API key of the request
+// The calls are allowed if the store fails, so an unavailable Redis server does not stop the service
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - time.Duration: the time the caller should wait before retrying if the request is rejected
+// - error: a ResourceExhausted status error with retry information if the limit or quota is exceeded
+func (l *rateLimiter) check(ctx context.Context) (time.Duration, error) {
+	apiKey := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["x-api-key"]) > 0 {
+		apiKey = md["x-api-key"][0]
+	}
+	limit := l.limitOf(apiKey)
+	keyID := ratelimit.KeyID(apiKey)
+
+	if limit.Rate > 0 {
+		allowed, retryAfter, err := l.store.TakeToken(ctx, keyID, limit.Rate, limit.Burst)
+		if err != nil {
+			redact.Log.Errorf(&logging.ContextMap{}, "rate limit check failed: %v", err)
+		} else if !allowed {
+			metrics.ObserveRateLimitRejection("rate")
+			return retryAfter, rateLimitError(retryAfter, "rate limit of %v calls per second exceeded", limit.Rate)
+		}
+	}
+
+	if limit.DailyQuota > 0 {
+		allowed, retryAfter, err := l.store.CountCall(ctx, keyID, limit.DailyQuota)
+		if err != nil {
+			redact.Log.Errorf(&logging.ContextMap{}, "daily quota check failed: %v", err)
+		} else if !allowed {
+			metrics.ObserveRateLimitRejection("quota")
+			return retryAfter, rateLimitError(retryAfter, "daily quota of %d calls exceeded", limit.DailyQuota)
+		}
+	}
+	return 0, nil
+}
+
+// checkCall charges a function call of a batch or pipeline
+// The calls are not limited if no limiter is configured
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - error: a ResourceExhausted status error with retry information if the limit or quota is exceeded
+func (l *rateLimiter) checkCall(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	_, err := l.check(ctx)
+	return err
+}
+
+// rateLimitError creates the ResourceExhausted error of a rejected request with its retry delay
+//
+// Parameters:
+// - retryAfter: the time the caller should wait before retrying
+// - format: the format of the error message
+// - args: the arguments of the format
+//
+// Returns:
+// - error: the status error
+func rateLimitError(retryAfter time.Duration, format string, args ...interface{}) error {
+	st := status.Newf(codes.ResourceExhausted, format, args...)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// retryAfterHeader creates the retry-after response header in whole seconds, rounded up
+//
+// Parameters:
+// - retryAfter: the time the caller should wait before retrying
+//
+// Returns:
+// - metadata.MD: the header
+func retryAfterHeader(retryAfter time.Duration) metadata.MD {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	return metadata.Pairs("retry-after", strconv.FormatInt(seconds, 10))
+}
+
+// rateLimitInterceptor is a gRPC server interceptor that rejects requests exceeding the limits of their API key
+// The batch requests are not charged here, their calls are charged one by one
+//
+// Parameters:
+// - limiter: the rate limiter
+//
+// Returns:
+// - grpc.UnaryServerInterceptor: a gRPC server interceptor
+func rateLimitInterceptor(limiter *rateLimiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, healthServicePrefix) && !perCallMethods[info.FullMethod] {
+			retryAfter, err := limiter.check(ctx)
+			if err != nil {
+				if headerErr := grpc.SetHeader(ctx, retryAfterHeader(retryAfter)); headerErr != nil {
+					redact.Log.Warnf(&logging.ContextMap{}, "failed to set retry-after header: %v", headerErr)
+				}
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitStreamInterceptor is a gRPC stream server interceptor that rejects streams exceeding the limits of their API key
+// A stream counts as one call, the pipeline streams are not charged here but per node
+//
+// Parameters:
+// - limiter: the rate limiter
+//
+// Returns:
+// - grpc.StreamServerInterceptor: a gRPC stream server interceptor
+func rateLimitStreamInterceptor(limiter *rateLimiter) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !strings.HasPrefix(info.FullMethod, healthServicePrefix) && !perCallMethods[info.FullMethod] {
+			retryAfter, err := limiter.check(stream.Context())
+			if err != nil {
+				if headerErr := stream.SetHeader(retryAfterHeader(retryAfter)); headerErr != nil {
+					redact.Log.Warnf(&logging.ContextMap{}, "failed to set retry-after header: %v", headerErr)
+				}
+				return err
+			}
+		}
+		return handler(srv, stream)
+	}
+}
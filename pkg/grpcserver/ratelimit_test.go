@@ -0,0 +1,184 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/batchgrpc"
+	"github.com/ansys/aali-flowkit/pkg/cache"
+	"github.com/ansys/aali-flowkit/pkg/cachegrpc"
+	"github.com/ansys/aali-flowkit/pkg/pipelinegrpc"
+	"github.com/ansys/aali-flowkit/pkg/ratelimit"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestRateLimiter(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{
+		"FLOWKIT_RATE_LIMIT":  "0.5",
+		"FLOWKIT_DAILY_QUOTA": "100",
+	}}
+
+	policyFile := filepath.Join(t.TempDir(), "auth_policy.yaml")
+	err := os.WriteFile(policyFile, []byte(`
+keys:
+  - key: "limited-key"
+    categories: ["*"]
+    dailyQuota: 1
+`), 0o600)
+	require.NoError(t, err)
+	policy, err := loadAuthPolicy(policyFile)
+	require.NoError(t, err)
+
+	limiter, err := newRateLimiter(policy)
+	require.NoError(t, err)
+	require.NotNil(t, limiter)
+
+	// the burst defaults to one second of calls and the quota of the key replaces the server quota
+	assert.Equal(t, ratelimit.Limit{Rate: 0.5, Burst: 1, DailyQuota: 100}, limiter.limitOf("other-key"))
+	assert.Equal(t, ratelimit.Limit{Rate: 0.5, Burst: 1, DailyQuota: 1}, limiter.limitOf("limited-key"))
+
+	// serve the cache and health services over an in-memory connection
+	functionResultCache = &resultCache{store: cache.NewMemoryStore(100), stats: map[string]*cacheStats{}}
+	t.Cleanup(func() { functionResultCache = nil })
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(rateLimitInterceptor(limiter)),
+		grpc.ChainStreamInterceptor(rateLimitStreamInterceptor(limiter)),
+	)
+	cachegrpc.RegisterFunctionCacheServer(s, &cacheServer{})
+	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := cachegrpc.NewFunctionCacheClient(conn)
+
+	call := func(apiKey string) (metadata.MD, error) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", apiKey)
+		var header metadata.MD
+		_, err := client.GetCacheStats(ctx, &cachegrpc.CacheStatsRequest{}, grpc.Header(&header))
+		return header, err
+	}
+
+	// the second call of a key exceeds the rate limit and is told when to retry
+	_, err = call("other-key")
+	require.NoError(t, err)
+	header, err := call("other-key")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"2"}, header.Get("retry-after"))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	retryInfo, ok := details[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.InDelta(t, 2*time.Second, retryInfo.RetryDelay.AsDuration(), float64(100*time.Millisecond))
+
+	// the keys are limited independently
+	_, err = call("limited-key")
+	require.NoError(t, err)
+
+	// the health service is not limited
+	for i := 0; i < 3; i++ {
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.NoError(t, err)
+	}
+}
+
+func TestRateLimiterDailyQuota(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	limiter := &rateLimiter{store: ratelimit.NewMemoryStore(), limit: ratelimit.Limit{DailyQuota: 2}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "some-key"))
+
+	for i := 0; i < 2; i++ {
+		_, err := limiter.check(ctx)
+		require.NoError(t, err)
+	}
+	retryAfter, err := limiter.check(ctx)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Greater(t, retryAfter, time.Duration(0))
+	assert.LessOrEqual(t, retryAfter, 24*time.Hour)
+}
+
+func TestRateLimiterChargesEachCall(t *testing.T) {
+	loadRegistry(t)
+	callRateLimiter = &rateLimiter{store: ratelimit.NewMemoryStore(), limit: ratelimit.Limit{DailyQuota: 3}}
+	t.Cleanup(func() { callRateLimiter = nil })
+	registerFunction(t, &aaliflowkitgrpc.FunctionDefinition{Name: "CountedCall"}, func() {})
+
+	// the calls of a batch beyond the quota fail, the batch request itself is not charged
+	calls := make([]*batchgrpc.FunctionCall, 4)
+	for i := range calls {
+		calls[i] = &batchgrpc.FunctionCall{Name: "CountedCall"}
+	}
+	batch := &batchServer{functions: &server{}, maxConcurrency: 1, maxCalls: 10}
+	response, err := batch.RunFunctionsBatch(context.Background(), &batchgrpc.RunFunctionsBatchRequest{Calls: calls})
+	require.NoError(t, err)
+	for i, result := range response.Results[:3] {
+		assert.Equal(t, int32(codes.OK), result.Status.Code, i)
+	}
+	assert.Equal(t, int32(codes.ResourceExhausted), response.Results[3].Status.Code)
+
+	// the nodes of a pipeline are charged as well
+	err = (&pipelineServer{maxNodes: 8}).RunPipeline(&pipelinegrpc.PipelineRequest{
+		Nodes:      []*pipelinegrpc.PipelineNode{{Id: "counted", Function: "CountedCall"}},
+		OutputNode: "counted",
+	}, &pipelineStream{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.ErrorContains(t, err, "pipeline node counted: daily quota of 3 calls exceeded")
+}
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{}}
+
+	limiter, err := newRateLimiter(nil)
+	require.NoError(t, err)
+	assert.Nil(t, limiter)
+
+	config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_RATE_LIMIT"] = "-1"
+	_, err = newRateLimiter(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// isSecretInput checks if the values of a function input are masked
+// Inputs are secret if they are annotated with @secret or their name looks secret, e.g. githubAccessToken
+//
+// Parameters:
+// - functionName: the name of the function
+// - inputName: the name of the input
+//
+// Returns:
+// - bool: true if the input is secret
+func isSecretInput(functionName string, inputName string) bool {
+	if redact.IsSecretName(inputName) {
+		return true
+	}
+	functionMetadata, ok := internalstates.AvailableFunctionsMetadata[functionName]
+	if !ok {
+		return false
+	}
+	constraints, ok := functionMetadata.Inputs[inputName]
+	return ok && constraints.Secret
+}
+
+// trackSecretInputs masks the values of the secret inputs of a function call in the logs and errors of the server
+// The values are masked until the request ends
+//
+// Parameters:
+// - ctx: the context of the request
+// - functionDefinition: the definition of the called function
+// - inputs: the inputs of the request, in the order of the function definition
+func trackSecretInputs(ctx context.Context, functionDefinition *aaliflowkitgrpc.FunctionDefinition, inputs []*aaliflowkitgrpc.FunctionInput) {
+	for i, input := range inputs {
+		if input == nil || i >= len(functionDefinition.Input) {
+			continue
+		}
+		if isSecretInput(functionDefinition.Name, functionDefinition.Input[i].Name) || isSecretInput(functionDefinition.Name, input.Name) {
+			redact.Track(ctx, input.Value)
+		}
+	}
+}
+
+// keepConfigSecrets masks the API key of the server and the workflow config variables with secret names
+func keepConfigSecrets() {
+	redact.Keep(config.GlobalConfig.FLOWKIT_API_KEY)
+	for name, value := range config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES {
+		if redact.IsSecretName(name) {
+			redact.Keep(value)
+		}
+	}
+}
+
+// maskError masks the secret values in the message of an error, keeping its status code and details
+//
+// Parameters:
+// - err: the error, may be nil
+//
+// Returns:
+// - error: the masked error
+func maskError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return status.Error(st.Code(), redact.String(err.Error()))
+	}
+	masked := redact.String(st.Message())
+	if masked == st.Message() {
+		return err
+	}
+	proto := st.Proto()
+	proto.Message = masked
+	return status.ErrorProto(proto)
+}
+
+// redactErrorInterceptor is a gRPC server interceptor that masks the secret values in the returned errors
+// The secret values tracked by the request are masked until it returns
+//
+// Returns:
+// - grpc.UnaryServerInterceptor: a gRPC server interceptor
+func redactErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, end := redact.Begin(ctx)
+		defer end()
+		resp, err := handler(ctx, req)
+		return resp, maskError(err)
+	}
+}
+
+// redactErrorStreamInterceptor is a gRPC stream server interceptor that masks the secret values in the returned errors
+// The secret values tracked by the stream are masked until it ends
+//
+// Returns:
+// - grpc.StreamServerInterceptor: a gRPC stream server interceptor
+func redactErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, end := redact.Begin(stream.Context())
+		defer end()
+		return maskError(handler(srv, &serverStreamWithContext{ServerStream: stream, ctx: ctx}))
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestIsSecretInput(t *testing.T) {
+	loadRegistry(t)
+
+	// inputs are secret by annotation or by name
+	assert.True(t, isSecretInput("SendLogicAppNotificationEmail", "logicAppEndpoint"))
+	assert.True(t, isSecretInput("AisAcsSemanticHybridSearchs", "acsApiKey"))
+	assert.True(t, isSecretInput("UnknownFunction", "jwtToken"))
+	assert.False(t, isSecretInput("SendLogicAppNotificationEmail", "email"))
+	assert.False(t, isSecretInput("UnknownFunction", "maxTokens"))
+}
+
+func TestTrackSecretInputs(t *testing.T) {
+	loadRegistry(t)
+	functionDefinition := internalstates.AvailableFunctions["SendLogicAppNotificationEmail"]
+
+	ctx, end := redact.Begin(context.Background())
+	_, err := newInputDecoder(ctx, functionDefinition, functionInputs("https://logic.example.com/trigger?sig=abc123def", "user@example.com", "subject", "content"))
+	require.NoError(t, err)
+
+	// the secret input is masked in logs and errors, other inputs are kept
+	assert.Equal(t, "POST [REDACTED] failed for user@example.com", redact.String("POST https://logic.example.com/trigger?sig=abc123def failed for user@example.com"))
+
+	err = maskError(status.Errorf(codes.Unavailable, "request to https://logic.example.com/trigger?sig=abc123def failed"))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, "request to [REDACTED] failed", status.Convert(err).Message())
+
+	// the secret input is no longer masked once the request ended
+	end()
+	assert.Equal(t, "POST https://logic.example.com/trigger?sig=abc123def failed", redact.String("POST https://logic.example.com/trigger?sig=abc123def failed"))
+}
+
+func TestRedactErrorInterceptor(t *testing.T) {
+	loadRegistry(t)
+	functionDefinition := internalstates.AvailableFunctions["SendLogicAppNotificationEmail"]
+
+	// the secret inputs of the request are masked in its error, but not after it
+	_, err := redactErrorInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, err := newInputDecoder(ctx, functionDefinition, functionInputs("https://logic.example.com/trigger?sig=interceptor", "user@example.com", "subject", "content"))
+		require.NoError(t, err)
+		return nil, status.Errorf(codes.Unavailable, "request to https://logic.example.com/trigger?sig=interceptor failed")
+	})
+	assert.Equal(t, "request to [REDACTED] failed", status.Convert(err).Message())
+	assert.Equal(t, "https://logic.example.com/trigger?sig=interceptor", redact.String("https://logic.example.com/trigger?sig=interceptor"))
+}
+
+func TestMaskError(t *testing.T) {
+	ctx, end := redact.Begin(context.Background())
+	defer end()
+	redact.Track(ctx, "mask-error-secret")
+	assert.NoError(t, maskError(nil))
+
+	// the details of status errors are kept
+	st, err := status.New(codes.ResourceExhausted, "quota of mask-error-secret exceeded").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+	require.NoError(t, err)
+	masked := status.Convert(maskError(st.Err()))
+	assert.Equal(t, codes.ResourceExhausted, masked.Code())
+	assert.Equal(t, "quota of [REDACTED] exceeded", masked.Message())
+	assert.Len(t, masked.Details(), 1)
+
+	// plain errors become unknown status errors
+	masked = status.Convert(maskError(errors.New("panic with mask-error-secret")))
+	assert.Equal(t, codes.Unknown, masked.Code())
+	assert.Equal(t, "panic with [REDACTED]", masked.Message())
+
+	// errors without secret values are returned unchanged
+	original := status.Error(codes.NotFound, "function not found")
+	assert.Equal(t, original, maskError(original))
+}
@@ -0,0 +1,398 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/cache"
+	"github.com/ansys/aali-flowkit/pkg/cachegrpc"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/typeconverters"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// functionResultCache caches the outputs of the functions tagged with @cache, nil if caching is disabled
+var functionResultCache *resultCache
+
+// resultCache caches the outputs of function calls keyed by the function and its converted inputs
+// The outputs are stored in their string format, so they can be kept in any store
+type resultCache struct {
+	store cache.Store
+	mutex sync.Mutex
+	// stats holds the hits and misses by function name
+	stats map[string]*cacheStats
+}
+
+// cacheStats holds the cache hits and misses of a function
+type cacheStats struct {
+	hits   int64
+	misses int64
+}
+
+// newResultCache creates the result cache with the store of the workflow config variables
+//
+// Returns:
+// - *resultCache: the result cache, nil if caching is disabled
+// - error: an error if the cache configuration is invalid
+func newResultCache() (*resultCache, error) {
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+	switch backend := variables["FLOWKIT_CACHE_BACKEND"]; backend {
+	case "", "memory":
+		maxEntries, err := intConfigVariable("FLOWKIT_CACHE_MAX_ENTRIES", 10000)
+		if err != nil {
+			return nil, err
+		}
+		return &resultCache{store: cache.NewMemoryStore(maxEntries), stats: map[string]*cacheStats{}}, nil
+
+	case "redis":
+		store, err := newRedisStore("FLOWKIT_CACHE_REDIS_NAMESPACE", "aali-flowkit:cache:")
+		if err != nil {
+			return nil, err
+		}
+		return &resultCache{store: store, stats: map[string]*cacheStats{}}, nil
+
+	case "none":
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unknown cache backend %q, expected \"memory\", \"redis\" or \"none\"", backend)
+	}
+}
+
+// newRedisStore connects to the Redis server configured by the FLOWKIT_CACHE_REDIS_* variables
+// The server is shared by the result cache and the rate limiter, which use different namespaces
+//
+// Parameters:
+// - namespaceVariable: the config variable holding the namespace of the keys
+// - defaultNamespace: the namespace used if the variable is not set
+//
+// Returns:
+// - *cache.RedisStore: the store
+// - error: an error if the configuration is invalid
+func newRedisStore(namespaceVariable string, defaultNamespace string) (*cache.RedisStore, error) {
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+	address := variables["FLOWKIT_CACHE_REDIS_ADDRESS"]
+	if address == "" {
+		return nil, fmt.Errorf("FLOWKIT_CACHE_REDIS_ADDRESS is required by the redis backend")
+	}
+	database := 0
+	if value := variables["FLOWKIT_CACHE_REDIS_DB"]; value != "" {
+		var err error
+		database, err = strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("FLOWKIT_CACHE_REDIS_DB: %v", err)
+		}
+	}
+	namespace, ok := variables[namespaceVariable]
+	if !ok {
+		namespace = defaultNamespace
+	}
+	timeout, err := durationConfigVariable("FLOWKIT_CACHE_REDIS_TIMEOUT", 0)
+	if err != nil {
+		return nil, err
+	}
+	var tlsConfig *tls.Config
+	if enabled, _ := strconv.ParseBool(variables["FLOWKIT_CACHE_REDIS_TLS"]); enabled {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		if file := variables["FLOWKIT_CACHE_REDIS_TLS_CA_FILE"]; file != "" {
+			certificates, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("FLOWKIT_CACHE_REDIS_TLS_CA_FILE: %v", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(certificates) {
+				return nil, fmt.Errorf("FLOWKIT_CACHE_REDIS_TLS_CA_FILE: no certificate found in %q", file)
+			}
+		}
+	}
+	return cache.NewRedisStore(cache.RedisOptions{
+		Address:   address,
+		Username:  variables["FLOWKIT_CACHE_REDIS_USERNAME"],
+		Password:  variables["FLOWKIT_CACHE_REDIS_PASSWORD"],
+		Database:  database,
+		Namespace: namespace,
+		Timeout:   timeout,
+		TLS:       tlsConfig,
+	}), nil
+}
+
+// invoke calls a cacheable function, returning the cached outputs of an earlier call with the same inputs
+// Store errors are logged and the function is called as if the cache was empty
+//
+// Parameters:
+// - ctx: the context of the request
+// - method: the name of the RPC method, e.g. "RunFunction"
+// - decoder: the decoder of the function inputs
+//
+// Returns:
+// - []interface{}: the outputs of the function
+// - error: a gRPC status error if the inputs are invalid or the function fails
+func (c *resultCache) invoke(ctx context.Context, method string, decoder *inputDecoder) ([]interface{}, error) {
+	functionDefinition := decoder.functionDefinition
+
+	// the key is built from the converted inputs, so defaults and equivalent values share an entry
+	inputs := make([]interface{}, len(functionDefinition.Input))
+	for i := range functionDefinition.Input {
+		value, err := decoder.decode(i)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		inputs[i] = value
+	}
+	if decoder.values == nil {
+		decoder.values = map[int]interface{}{}
+	}
+	for i, value := range inputs {
+		decoder.values[i] = value
+	}
+	key, err := cache.Key(functionDefinition.Name, inputs)
+	if err != nil {
+		redact.Log.Debugf(&logging.ContextMap{}, "inputs of function %s cannot be cached: %v", functionDefinition.Name, err)
+		return invokeFunctionUncached(ctx, method, decoder)
+	}
+
+	outputs, hit := c.lookup(ctx, functionDefinition, key)
+	c.record(functionDefinition, hit)
+	if hit {
+		return outputs, nil
+	}
+
+	outputs, err = invokeFunctionUncached(ctx, method, decoder)
+	if err != nil {
+		return nil, err
+	}
+	c.save(ctx, functionDefinition, key, outputs, decoder.functionMetadata.CacheTTL)
+	return outputs, nil
+}
+
+// lookup reads the cached outputs of a function call
+//
+// Parameters:
+// - ctx: the context of the request
+// - functionDefinition: the definition of the function
+// - key: the cache key of the call
+//
+// Returns:
+// - []interface{}: the cached outputs
+// - bool: true if valid outputs were found
+func (c *resultCache) lookup(ctx context.Context, functionDefinition *aaliflowkitgrpc.FunctionDefinition, key string) ([]interface{}, bool) {
+	value, ok, err := c.store.Get(ctx, key)
+	if err != nil {
+		redact.Log.Warnf(&logging.ContextMap{}, "error reading cached outputs of function %s: %v", functionDefinition.Name, err)
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+
+	encoded := []string{}
+	err = json.Unmarshal(value, &encoded)
+	if err != nil || len(encoded) != len(functionDefinition.Output) {
+		redact.Log.Warnf(&logging.ContextMap{}, "invalid cached outputs of function %s", functionDefinition.Name)
+		return nil, false
+	}
+	outputs := make([]interface{}, len(encoded))
+	for i, output := range functionDefinition.Output {
+		outputs[i], err = typeconverters.ConvertStringToGivenType(encoded[i], output.GoType)
+		if err != nil {
+			redact.Log.Warnf(&logging.ContextMap{}, "error converting cached output %s of function %s: %v", output.Name, functionDefinition.Name, err)
+			return nil, false
+		}
+	}
+	return outputs, true
+}
+
+// save stores the outputs of a function call
+//
+// Parameters:
+// - ctx: the context of the request
+// - functionDefinition: the definition of the function
+// - key: the cache key of the call
+// - outputs: the outputs of the function
+// - ttl: the time the outputs are cached for
+func (c *resultCache) save(ctx context.Context, functionDefinition *aaliflowkitgrpc.FunctionDefinition, key string, outputs []interface{}, ttl time.Duration) {
+	encoded := make([]string, len(outputs))
+	for i, output := range functionDefinition.Output {
+		value, err := typeconverters.ConvertGivenTypeToString(outputs[i], output.GoType)
+		if err != nil {
+			redact.Log.Warnf(&logging.ContextMap{}, "error converting output %s of function %s for the cache: %v", output.Name, functionDefinition.Name, err)
+			return
+		}
+		encoded[i] = value
+	}
+
+	value, err := json.Marshal(encoded)
+	if err == nil {
+		err = c.store.Set(ctx, key, value, ttl)
+	}
+	if err != nil {
+		redact.Log.Warnf(&logging.ContextMap{}, "error caching outputs of function %s: %v", functionDefinition.Name, err)
+	}
+}
+
+// record counts a cache lookup of a function
+//
+// Parameters:
+// - functionDefinition: the definition of the function
+// - hit: true if the outputs were found in the cache
+func (c *resultCache) record(functionDefinition *aaliflowkitgrpc.FunctionDefinition, hit bool) {
+	metrics.ObserveCacheLookup(functionDefinition.Name, functionDefinition.Category, hit)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	stats, ok := c.stats[functionDefinition.Name]
+	if !ok {
+		stats = &cacheStats{}
+		c.stats[functionDefinition.Name] = stats
+	}
+	if hit {
+		stats.hits++
+	} else {
+		stats.misses++
+	}
+}
+
+// cacheServer implements the FunctionCache service
+type cacheServer struct {
+	cachegrpc.UnimplementedFunctionCacheServer
+}
+
+// InvalidateCache removes the cached outputs of the selected functions
+// The caller must be allowed to call every selected function
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the functions and categories to invalidate
+//
+// Returns:
+// - *cachegrpc.InvalidateCacheResponse: the number of removed entries
+// - error: an error if the cache is disabled, a function is unknown or not allowed
+func (s *cacheServer) InvalidateCache(ctx context.Context, req *cachegrpc.InvalidateCacheRequest) (*cachegrpc.InvalidateCacheResponse, error) {
+	if functionResultCache == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "result cache is disabled")
+	}
+	if len(req.Functions) == 0 && len(req.Categories) == 0 && !req.All {
+		return nil, status.Errorf(codes.InvalidArgument, "no functions or categories to invalidate")
+	}
+
+	// select the functions, the categories and all only select cacheable functions
+	selected := map[string]*aaliflowkitgrpc.FunctionDefinition{}
+	for _, name := range req.Functions {
+		functionDefinition, ok := internalstates.AvailableFunctions[name]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "function with name %s not found", name)
+		}
+		selected[name] = functionDefinition
+	}
+	for name, functionDefinition := range internalstates.AvailableFunctions {
+		if !isCacheable(name) {
+			continue
+		}
+		if req.All || slices.Contains(req.Categories, functionDefinition.Category) {
+			selected[name] = functionDefinition
+		}
+	}
+	for name, functionDefinition := range selected {
+		if !authorizeFunction(ctx, functionDefinition) {
+			return nil, status.Errorf(codes.PermissionDenied, "not allowed to call function %s", name)
+		}
+	}
+
+	var removed int64
+	for name := range selected {
+		count, err := functionResultCache.store.DeletePrefix(ctx, cache.FunctionPrefix(name))
+		removed += int64(count)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "error invalidating cached outputs of function %s: %v", name, err)
+		}
+	}
+	redact.Log.Infof(&logging.ContextMap{}, "invalidated %d cached outputs of %d functions", removed, len(selected))
+	return &cachegrpc.InvalidateCacheResponse{Removed: removed}, nil
+}
+
+// GetCacheStats returns the cache hits and misses of the cacheable functions
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the request
+//
+// Returns:
+// - *cachegrpc.CacheStatsResponse: the statistics by function, sorted by name
+// - error: an error if the cache is disabled
+func (s *cacheServer) GetCacheStats(ctx context.Context, req *cachegrpc.CacheStatsRequest) (*cachegrpc.CacheStatsResponse, error) {
+	if functionResultCache == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "result cache is disabled")
+	}
+
+	functionResultCache.mutex.Lock()
+	defer functionResultCache.mutex.Unlock()
+	response := &cachegrpc.CacheStatsResponse{}
+	for name, functionDefinition := range internalstates.AvailableFunctions {
+		if !isCacheable(name) {
+			continue
+		}
+		stats := &cachegrpc.FunctionCacheStats{
+			Function:   name,
+			Category:   functionDefinition.Category,
+			TtlSeconds: int64(internalstates.AvailableFunctionsMetadata[name].CacheTTL.Seconds()),
+		}
+		if counts, ok := functionResultCache.stats[name]; ok {
+			stats.Hits = counts.hits
+			stats.Misses = counts.misses
+		}
+		response.Functions = append(response.Functions, stats)
+	}
+	sort.Slice(response.Functions, func(i, j int) bool {
+		return response.Functions[i].Function < response.Functions[j].Function
+	})
+	return response, nil
+}
+
+// isCacheable checks if the outputs of a function are cached
+//
+// Parameters:
+// - functionName: the name of the function
+//
+// Returns:
+// - bool: true if the function is tagged with @cache
+func isCacheable(functionName string) bool {
+	functionMetadata, ok := internalstates.AvailableFunctionsMetadata[functionName]
+	return ok && functionMetadata.CacheTTL > 0
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/cache"
+	"github.com/ansys/aali-flowkit/pkg/cachegrpc"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// enableCache caches the outputs of the given functions in memory for the duration of the test
+func enableCache(t *testing.T, functions ...string) {
+	for _, function := range functions {
+		functionMetadata := *internalstates.AvailableFunctionsMetadata[function]
+		functionMetadata.CacheTTL = time.Minute
+		internalstates.AvailableFunctionsMetadata[function] = &functionMetadata
+	}
+	functionResultCache = &resultCache{store: cache.NewMemoryStore(100), stats: map[string]*cacheStats{}}
+	t.Cleanup(func() { functionResultCache = nil })
+}
+
+func TestResultCache(t *testing.T) {
+	loadRegistry(t)
+	enableCache(t, "StringConcat", "AppendMessageHistory")
+	ctx := context.Background()
+	appendMessage := internalstates.AvailableFunctions["AppendMessageHistory"]
+
+	// the second call with equal inputs is served from the cache
+	expected := []interface{}{[]sharedtypes.HistoricMessage{{Role: "user", Content: "hello"}}}
+	for range 2 {
+		outputs, err := callFunction(ctx, "RunFunction", appendMessage, functionInputs("hello", "user", "[]"))
+		require.NoError(t, err)
+		assert.Equal(t, expected, outputs)
+	}
+	outputs, err := callFunction(ctx, "RunFunction", appendMessage, functionInputs("hello", "user", "  [ ]"))
+	require.NoError(t, err)
+	assert.Equal(t, expected, outputs)
+	outputs, err = callFunction(ctx, "RunFunction", appendMessage, functionInputs("hi", "user", "[]"))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]sharedtypes.HistoricMessage{{Role: "user", Content: "hi"}}}, outputs)
+
+	// invalid inputs are rejected before the cache is read
+	_, err = callFunction(ctx, "RunFunction", appendMessage, functionInputs("hello", "admin", "[]"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = callFunction(ctx, "RunFunction", internalstates.AvailableFunctions["StringConcat"], functionInputs("aali", "flowkit", "-"))
+	require.NoError(t, err)
+
+	server := &cacheServer{}
+	stats, err := server.GetCacheStats(ctx, &cachegrpc.CacheStatsRequest{})
+	require.NoError(t, err)
+	counts := map[string][2]int64{}
+	for _, functionStats := range stats.Functions {
+		counts[functionStats.Function] = [2]int64{functionStats.Hits, functionStats.Misses}
+	}
+	assert.Equal(t, [2]int64{2, 2}, counts["AppendMessageHistory"])
+	assert.Equal(t, [2]int64{0, 1}, counts["StringConcat"])
+	assert.Contains(t, counts, "PerformVectorEmbeddingRequest")
+
+	// the cached outputs are removed by function and by category
+	response, err := server.InvalidateCache(ctx, &cachegrpc.InvalidateCacheRequest{Functions: []string{"AppendMessageHistory"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), response.Removed)
+	response, err = server.InvalidateCache(ctx, &cachegrpc.InvalidateCacheRequest{Categories: []string{"generic"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), response.Removed)
+
+	_, err = server.InvalidateCache(ctx, &cachegrpc.InvalidateCacheRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.InvalidateCache(ctx, &cachegrpc.InvalidateCacheRequest{Functions: []string{"UnknownFunction"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the service fails if the cache is disabled
+	functionResultCache = nil
+	_, err = server.InvalidateCache(ctx, &cachegrpc.InvalidateCacheRequest{All: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
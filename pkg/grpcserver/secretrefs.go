@@ -0,0 +1,141 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-flowkit/pkg/secrets"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// secretResolver resolves the secret references of the function inputs, nil if no provider is configured
+var secretResolver *secrets.Resolver
+
+// newSecretResolver creates the resolver of the providers listed in FLOWKIT_SECRETS_PROVIDERS
+//
+// Returns:
+// - *secrets.Resolver: the resolver, nil if no provider is configured
+// - error: an error if a provider is unknown or misconfigured
+func newSecretResolver() (*secrets.Resolver, error) {
+	variables := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES
+	providers := []secrets.Provider{}
+	for _, name := range strings.Split(variables["FLOWKIT_SECRETS_PROVIDERS"], ",") {
+		switch name = strings.TrimSpace(name); name {
+		case "":
+			continue
+		case "env":
+			prefix, ok := variables["FLOWKIT_SECRETS_ENV_PREFIX"]
+			if !ok {
+				prefix = "FLOWKIT_SECRET_"
+			}
+			providers = append(providers, secrets.NewEnvProvider(prefix))
+		case "directory":
+			directory := variables["FLOWKIT_SECRETS_DIRECTORY"]
+			if directory == "" {
+				return nil, fmt.Errorf("FLOWKIT_SECRETS_DIRECTORY is required by the directory secret provider")
+			}
+			providers = append(providers, secrets.NewDirectoryProvider(directory))
+		case "file":
+			path, keyPath := variables["FLOWKIT_SECRETS_FILE"], variables["FLOWKIT_SECRETS_KEY_FILE"]
+			if path == "" || keyPath == "" {
+				return nil, fmt.Errorf("FLOWKIT_SECRETS_FILE and FLOWKIT_SECRETS_KEY_FILE are required by the file secret provider")
+			}
+			key, err := secrets.LoadKey(keyPath)
+			if err != nil {
+				return nil, err
+			}
+			provider, err := secrets.NewEncryptedFileProvider(path, key)
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, provider)
+		default:
+			return nil, fmt.Errorf("unknown secret provider %q, expected \"env\", \"directory\" or \"file\"", name)
+		}
+	}
+
+	if len(providers) == 0 {
+		return nil, nil
+	}
+	return secrets.NewResolver(providers...), nil
+}
+
+// resolveSecretReferences replaces the secret references in the inputs of a function call by the secret values
+// References are only accepted by secret string inputs, so a function cannot return a resolved value as plain output
+// The request inputs are not changed, so the audit log records the references
+//
+// Parameters:
+// - ctx: the context of the request
+// - functionDefinition: the definition of the called function
+// - inputs: the inputs of the request, in the order of the function definition
+//
+// Returns:
+// - []*aaliflowkitgrpc.FunctionInput: the inputs with the resolved values
+// - error: a gRPC status error if a reference is not allowed or cannot be resolved
+func resolveSecretReferences(ctx context.Context, functionDefinition *aaliflowkitgrpc.FunctionDefinition, inputs []*aaliflowkitgrpc.FunctionInput) ([]*aaliflowkitgrpc.FunctionInput, error) {
+	var resolved []*aaliflowkitgrpc.FunctionInput
+	for i, input := range inputs {
+		if input == nil || i >= len(functionDefinition.Input) {
+			continue
+		}
+		name, ok := secrets.ParseReference(input.Value)
+		if !ok {
+			continue
+		}
+
+		inputDefinition := functionDefinition.Input[i]
+		if inputDefinition.GoType != "string" || !isSecretInput(functionDefinition.Name, inputDefinition.Name) {
+			return nil, status.Errorf(codes.InvalidArgument, "input %s of function %s does not accept secret references, only secret string inputs do", inputDefinition.Name, functionDefinition.Name)
+		}
+		if secretResolver == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "input %s references a secret, but no secret provider is configured", inputDefinition.Name)
+		}
+
+		value, err := secretResolver.Resolve(ctx, name)
+		if errors.Is(err, secrets.ErrInvalidInput) || errors.Is(err, secrets.ErrNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "input %s: %v", inputDefinition.Name, err)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "input %s: %v", inputDefinition.Name, err)
+		}
+		redact.Track(ctx, value)
+
+		if resolved == nil {
+			resolved = append([]*aaliflowkitgrpc.FunctionInput{}, inputs...)
+		}
+		resolved[i] = &aaliflowkitgrpc.FunctionInput{Name: input.Name, Value: value, GoType: input.GoType}
+	}
+
+	if resolved == nil {
+		return inputs, nil
+	}
+	return resolved, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-flowkit/pkg/secrets"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewSecretResolver(t *testing.T) {
+	directory := t.TempDir()
+	key := make([]byte, secrets.KeySize)
+	keyFile := filepath.Join(directory, "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("0000000000000000000000000000000000000000000000000000000000000000"), 0o600))
+	content, err := secrets.Encrypt(map[string]string{"db-url": "mongodb://from-file"}, key)
+	require.NoError(t, err)
+	secretsFile := filepath.Join(directory, "secrets.enc")
+	require.NoError(t, os.WriteFile(secretsFile, content, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "api-key"), []byte("from-directory\n"), 0o600))
+	t.Setenv("TEST_SECRET_DB_URL", "mongodb://from-env")
+
+	// no provider configured
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{}}
+	resolver, err := newSecretResolver()
+	require.NoError(t, err)
+	assert.Nil(t, resolver)
+
+	// providers are tried in the configured order
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{
+		"FLOWKIT_SECRETS_PROVIDERS":  "file, env, directory",
+		"FLOWKIT_SECRETS_ENV_PREFIX": "TEST_SECRET_",
+		"FLOWKIT_SECRETS_DIRECTORY":  directory,
+		"FLOWKIT_SECRETS_FILE":       secretsFile,
+		"FLOWKIT_SECRETS_KEY_FILE":   keyFile,
+	}}
+	resolver, err = newSecretResolver()
+	require.NoError(t, err)
+	value, err := resolver.Resolve(context.Background(), "db-url")
+	require.NoError(t, err)
+	assert.Equal(t, "mongodb://from-file", value)
+	value, err = resolver.Resolve(context.Background(), "api-key")
+	require.NoError(t, err)
+	assert.Equal(t, "from-directory", value)
+
+	// invalid configurations
+	for _, variables := range []map[string]string{
+		{"FLOWKIT_SECRETS_PROVIDERS": "vault"},
+		{"FLOWKIT_SECRETS_PROVIDERS": "directory"},
+		{"FLOWKIT_SECRETS_PROVIDERS": "file", "FLOWKIT_SECRETS_FILE": secretsFile},
+	} {
+		config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: variables}
+		_, err = newSecretResolver()
+		assert.Error(t, err, variables)
+	}
+}
+
+func TestResolveSecretReferences(t *testing.T) {
+	loadRegistry(t)
+	functionDefinition := internalstates.AvailableFunctions["SendLogicAppNotificationEmail"]
+	t.Setenv("FLOWKIT_SECRET_LOGIC_APP", "https://logic.example.com/trigger?sig=resolved-signature")
+	defer func() { secretResolver = nil }()
+
+	// references are rejected if no provider is configured
+	secretResolver = nil
+	inputs := functionInputs("secret://logic-app", "user@example.com", "subject", "content")
+	_, err := resolveSecretReferences(context.Background(), functionDefinition, inputs)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the reference of the secret input is resolved without changing the request
+	secretResolver = secrets.NewResolver(secrets.NewEnvProvider("FLOWKIT_SECRET_"))
+	ctx, end := redact.Begin(context.Background())
+	defer end()
+	resolved, err := resolveSecretReferences(ctx, functionDefinition, inputs)
+	require.NoError(t, err)
+	assert.Equal(t, "https://logic.example.com/trigger?sig=resolved-signature", resolved[0].Value)
+	assert.Equal(t, "secret://logic-app", inputs[0].Value)
+	assert.Equal(t, "calling [REDACTED]", redact.String("calling https://logic.example.com/trigger?sig=resolved-signature"))
+
+	// inputs without references are returned as they are
+	plain := functionInputs("https://logic.example.com/trigger", "user@example.com", "subject", "content")
+	resolved, err = resolveSecretReferences(context.Background(), functionDefinition, plain)
+	require.NoError(t, err)
+	assert.Equal(t, plain, resolved)
+
+	// unknown secrets are invalid arguments
+	_, err = resolveSecretReferences(context.Background(), functionDefinition, functionInputs("secret://missing", "user@example.com", "subject", "content"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// malformed references are invalid arguments, not retryable failures
+	_, err = resolveSecretReferences(context.Background(), functionDefinition, functionInputs("secret://../logic-app", "user@example.com", "subject", "content"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "invalid secret name")
+
+	// inputs which are not secret do not accept references
+	_, err = resolveSecretReferences(context.Background(), functionDefinition, functionInputs("https://logic.example.com/trigger", "secret://logic-app", "subject", "content"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+)
+
+// tlsReloader serves the TLS configuration of the gRPC server and reloads the
+// certificate files when they change on disk. New handshakes use the reloaded
+// files, established connections are kept.
+type tlsReloader struct {
+	certFile        string
+	keyFile         string
+	clientCAFile    string
+	allowedSubjects map[string]bool
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	modTimes    map[string]time.Time
+}
+
+// newTLSReloader creates a TLS reloader and loads the certificate files
+//
+// Parameters:
+// - certFile: the path to the server certificate
+// - keyFile: the path to the server private key
+// - clientCAFile: the path to the CA bundle used to verify client certificates; mTLS is disabled if empty
+// - allowedSubjects: the client subjects (common name or SAN) that are allowed to connect; all verified clients are allowed if empty
+//
+// Returns:
+// - *tlsReloader: the TLS reloader
+// - error: an error if the files cannot be loaded
+func newTLSReloader(certFile string, keyFile string, clientCAFile string, allowedSubjects []string) (*tlsReloader, error) {
+	r := &tlsReloader{
+		certFile:        certFile,
+		keyFile:         keyFile,
+		clientCAFile:    clientCAFile,
+		allowedSubjects: map[string]bool{},
+		modTimes:        map[string]time.Time{},
+	}
+	for _, subject := range allowedSubjects {
+		if subject = strings.TrimSpace(subject); subject != "" {
+			r.allowedSubjects[subject] = true
+		}
+	}
+
+	err := r.reload()
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload loads the certificate files and replaces the current ones
+//
+// Returns:
+// - error: an error if the files cannot be loaded, the current files are kept in that case
+func (r *tlsReloader) reload() error {
+	modTimes := map[string]time.Time{}
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %v", file, err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load server certificate: %v", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.clientCAFile != "" {
+		caBundle, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA bundle: %v", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caBundle) {
+			return fmt.Errorf("no certificates found in client CA bundle %s", r.clientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.certificate = &certificate
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return nil
+}
+
+// files returns the files watched by the reloader
+//
+// Returns:
+// - []string: the paths of the watched files
+func (r *tlsReloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+	return files
+}
+
+// changed checks if any of the watched files has been modified since the last reload
+//
+// Returns:
+// - bool: true if a file has been modified
+func (r *tlsReloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			// the file may be in the middle of being replaced, check again later
+			continue
+		}
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// watch checks the certificate files for changes in the given interval and reloads them
+//
+// Parameters:
+// - interval: the interval between two checks
+// - stop: a channel that stops the watcher when closed
+func (r *tlsReloader) watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			err := r.reload()
+			if err != nil {
+				redact.Log.Errorf(&logging.ContextMap{}, "failed to reload TLS certificates, keeping the current ones: %v", err)
+				continue
+			}
+			redact.Log.Infof(&logging.ContextMap{}, "reloaded TLS certificates")
+		}
+	}
+}
+
+// tlsConfig returns the base TLS configuration of the gRPC server
+// The configuration used for each handshake is provided by getConfigForClient
+//
+// Returns:
+// - *tls.Config: the TLS configuration
+func (r *tlsReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: r.getConfigForClient,
+	}
+}
+
+// getConfigForClient returns the TLS configuration with the current certificates for a new handshake
+//
+// Parameters:
+// - hello: the client hello message
+//
+// Returns:
+// - *tls.Config: the TLS configuration
+// - error: always nil
+func (r *tlsReloader) getConfigForClient(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*r.certificate},
+		NextProtos:   []string{"h2"},
+	}
+	if r.clientCAs != nil {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = r.clientCAs
+		config.VerifyPeerCertificate = r.verifyClientSubject
+	}
+	return config, nil
+}
+
+// verifyClientSubject checks that the verified client certificate has an allowed subject
+// The common name, DNS names and URIs of the certificate are matched against the allowed subjects
+//
+// Parameters:
+// - rawCerts: the raw certificates sent by the client
+// - verifiedChains: the verified certificate chains
+//
+// Returns:
+// - error: an error if the subject of the client is not allowed
+func (r *tlsReloader) verifyClientSubject(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	if len(r.allowedSubjects) == 0 {
+		return nil
+	}
+
+	for _, chain := range verifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		leaf := chain[0]
+		if r.allowedSubjects[leaf.Subject.CommonName] {
+			return nil
+		}
+		for _, dnsName := range leaf.DNSNames {
+			if r.allowedSubjects[dnsName] {
+				return nil
+			}
+		}
+		for _, uri := range leaf.URIs {
+			if r.allowedSubjects[uri.String()] {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("client certificate subject is not allowed")
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCertificate writes a self-signed certificate and its key to the given files
+func writeTestCertificate(t *testing.T, certFile string, keyFile string, commonName string, modTime time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestTLSReloader(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	writeTestCertificate(t, certFile, keyFile, "server-1", time.Now().Add(-time.Minute))
+
+	reloader, err := newTLSReloader(certFile, keyFile, certFile, []string{"aali-agent"})
+	require.NoError(err)
+
+	config, err := reloader.getConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(err)
+	assert.Equal(tls.RequireAndVerifyClientCert, config.ClientAuth)
+	assert.Equal([]string{"h2"}, config.NextProtos)
+	firstCertificate := config.Certificates[0].Certificate[0]
+
+	// rotate the certificate
+	assert.False(reloader.changed())
+	writeTestCertificate(t, certFile, keyFile, "server-2", time.Now())
+	assert.True(reloader.changed())
+	require.NoError(reloader.reload())
+
+	config, err = reloader.getConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(err)
+	assert.NotEqual(firstCertificate, config.Certificates[0].Certificate[0])
+	assert.False(reloader.changed())
+}
+
+func TestVerifyClientSubject(t *testing.T) {
+	dir := t.TempDir()
+	agentCert := writeTestCertificate(t, filepath.Join(dir, "agent.crt"), filepath.Join(dir, "agent.key"), "aali-agent", time.Now())
+	otherCert := writeTestCertificate(t, filepath.Join(dir, "other.crt"), filepath.Join(dir, "other.key"), "other-client", time.Now())
+
+	reloader := &tlsReloader{allowedSubjects: map[string]bool{"aali-agent": true}}
+	assert.NoError(t, reloader.verifyClientSubject(nil, [][]*x509.Certificate{{agentCert}}))
+	assert.Error(t, reloader.verifyClientSubject(nil, [][]*x509.Certificate{{otherCert}}))
+
+	// all verified clients are allowed without subject restriction
+	reloader = &tlsReloader{allowedSubjects: map[string]bool{}}
+	assert.NoError(t, reloader.verifyClientSubject(nil, [][]*x509.Certificate{{otherCert}}))
+}
@@ -23,15 +23,185 @@
 package internalstates
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
 	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
 )
 
 // Global variables
 var AvailableFunctions map[string]*aaliflowkitgrpc.FunctionDefinition
+var AvailableFunctionsMetadata map[string]*FunctionMetadata
+
+// FunctionMetadata holds the registry metadata of an external function
+// that is parsed from the tags of its doc comment
+type FunctionMetadata struct {
+	// Version is the version of the function (@version)
+	Version string
+	// Since is the aali-flowkit version the function was added in (@since)
+	Since string
+	// Deprecated is true if the function is deprecated (@deprecated)
+	Deprecated bool
+	// DeprecationMessage explains the deprecation, e.g. the function superseding it
+	DeprecationMessage string
+	// Tags are the free-form tags of the function (@tags, comma-separated)
+	Tags []string
+	// Examples are the usage examples of the function (@example, may be repeated)
+	Examples []string
+	// CacheTTL is the time the outputs of the function are cached for, 0 if they are not cached (@cache)
+	CacheTTL time.Duration
+	// Inputs holds the constraints of the function inputs by input name
+	Inputs map[string]*InputConstraints
+}
+
+// InputConstraints holds the constraints of a function input
+// that are parsed from the annotations following the input in the doc comment
+type InputConstraints struct {
+	// Required is false if the input may be omitted (@optional or @default)
+	Required bool
+	// Default is the string value used if the input is omitted (@default)
+	Default *string
+	// Min is the inclusive lower bound of a number input (@min)
+	Min *float64
+	// Max is the inclusive upper bound of a number input (@max)
+	Max *float64
+	// Pattern is the regular expression a string input must match (@pattern)
+	Pattern string
+	// Enum is the set of allowed values of a string input (@enum, comma-separated)
+	Enum []string
+	// Secret is true if the values of the input are masked in logs and error messages (@secret)
+	Secret bool
+	// pattern is the compiled Pattern, set by CompilePattern
+	pattern *regexp.Regexp
+}
+
+// CompilePattern compiles the pattern of the input, so the requests do not compile it again
+//
+// Returns:
+//   - error: an error if the pattern is not a valid regular expression
+func (c *InputConstraints) CompilePattern() error {
+	if c.Pattern == "" {
+		c.pattern = nil
+		return nil
+	}
+	pattern, err := regexp.Compile(c.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid @pattern value '%s': %v", c.Pattern, err)
+	}
+	c.pattern = pattern
+	return nil
+}
+
+// MatchPattern checks if a string input matches the pattern of the input
+//
+// Parameters:
+//   - text: the string input
+//
+// Returns:
+//   - bool: true if the input matches the pattern or there is no pattern
+//   - error: an error if the pattern was not compiled with CompilePattern
+func (c *InputConstraints) MatchPattern(text string) (bool, error) {
+	if c.Pattern == "" {
+		return true, nil
+	}
+	if c.pattern == nil {
+		return false, fmt.Errorf("pattern '%s' is not compiled", c.Pattern)
+	}
+	return c.pattern.MatchString(text), nil
+}
+
+// CheckNumber checks a number input against the bounds of the input
+//
+// Parameters:
+//   - number: the number input
+//
+// Returns:
+//   - error: an error describing the violated bound
+func (c *InputConstraints) CheckNumber(number float64) error {
+	if c.Min != nil && number < *c.Min {
+		return fmt.Errorf("must be at least %v, got %v", *c.Min, number)
+	}
+	if c.Max != nil && number > *c.Max {
+		return fmt.Errorf("must be at most %v, got %v", *c.Max, number)
+	}
+	return nil
+}
+
+// CheckText checks a string input against the allowed values and the pattern of the input
+//
+// Parameters:
+//   - text: the string input
+//
+// Returns:
+//   - error: an error describing the violated constraint
+func (c *InputConstraints) CheckText(text string) error {
+	if len(c.Enum) > 0 && !slices.Contains(c.Enum, text) {
+		return fmt.Errorf("must be one of %v, got '%s'", c.Enum, text)
+	}
+	matches, err := c.MatchPattern(text)
+	if err != nil {
+		return err
+	}
+	if !matches {
+		return fmt.Errorf("must match pattern '%s', got '%s'", c.Pattern, text)
+	}
+	return nil
+}
+
+// HasTag checks if the function has the given tag
+//
+// Parameters:
+//   - tag: the tag to look for
+//
+// Returns:
+//   - bool: true if the function has the tag
+func (m *FunctionMetadata) HasTag(tag string) bool {
+	for _, t := range m.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// requiredInput holds the constraints of the inputs without annotations
+var requiredInput = &InputConstraints{Required: true}
+
+// Constraints returns the constraints of the given input of the function
+// If the input has no annotations, it is required without further constraints
+// The constraints are shared by the requests and must not be modified.
+//
+// Parameters:
+//   - inputName: the name of the input
+//
+// Returns:
+//   - *InputConstraints: the constraints of the input
+func (m *FunctionMetadata) Constraints(inputName string) *InputConstraints {
+	if constraints, ok := m.Inputs[inputName]; ok {
+		return constraints
+	}
+	return requiredInput
+}
+
+// CompilePatterns compiles the patterns of all inputs of the function
+//
+// Returns:
+//   - error: an error naming the first input with an invalid pattern
+func (m *FunctionMetadata) CompilePatterns() error {
+	for name, constraints := range m.Inputs {
+		if err := constraints.CompilePattern(); err != nil {
+			return fmt.Errorf("input %s: %v", name, err)
+		}
+	}
+	return nil
+}
 
 // InitializeInternalStates initializes the internal states of the agent
 // This function should be called at the beginning of the agent
 // to initialize the internal states of the agent
 func InitializeInternalStates() {
 	AvailableFunctions = map[string]*aaliflowkitgrpc.FunctionDefinition{}
+	AvailableFunctionsMetadata = map[string]*FunctionMetadata{}
 }
@@ -0,0 +1,682 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package jsonschema validates JSON values against JSON Schemas.
+// It implements the validation keywords of JSON Schema draft 2020-12 that describe the structure of data;
+// annotations such as title, description or format are accepted and ignored, other keywords are rejected
+// when the schema is compiled, so a schema is never silently weaker than written.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// annotations are the keywords without effect on the validation
+var annotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "$anchor": true,
+	"title": true, "description": true, "default": true, "examples": true,
+	"format": true, "readOnly": true, "writeOnly": true, "deprecated": true,
+	"contentEncoding": true, "contentMediaType": true, "x-stream": true,
+}
+
+// types are the type names of JSON Schema
+var types = map[string]bool{"null": true, "boolean": true, "object": true, "array": true, "number": true, "integer": true, "string": true}
+
+// Schema is a compiled JSON Schema
+type Schema struct {
+	root *node
+}
+
+// ValidationError is a violation of a schema by a value
+type ValidationError struct {
+	// Path is the JSON Pointer of the invalid part of the value, empty for the value itself
+	Path string
+	// Message describes the violation
+	Message string
+}
+
+// Error returns the path and the message of the violation
+func (e ValidationError) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "/"
+	}
+	return path + ": " + e.Message
+}
+
+// node is a compiled schema or subschema
+type node struct {
+	// never is true for the schema false, which no value matches
+	never                bool
+	ref                  *node
+	types                []string
+	enum                 []interface{}
+	constant             *interface{}
+	properties           map[string]*node
+	required             []string
+	additionalProperties *node
+	minProperties        *int
+	maxProperties        *int
+	prefixItems          []*node
+	items                *node
+	minItems             *int
+	maxItems             *int
+	uniqueItems          bool
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	minimum              *float64
+	maximum              *float64
+	exclusiveMinimum     *float64
+	exclusiveMaximum     *float64
+	multipleOf           *float64
+	allOf                []*node
+	anyOf                []*node
+	oneOf                []*node
+	not                  *node
+}
+
+// compiler compiles the subschemas of a document, sharing the nodes of the referenced subschemas
+type compiler struct {
+	document interface{}
+	refs     map[string]*node
+}
+
+// Compile compiles a JSON Schema document
+//
+// Parameters:
+//   - document: the JSON text of the schema
+//
+// Returns:
+//   - *Schema: the compiled schema
+//   - error: an error if the document is not JSON, or uses an invalid or unsupported keyword
+func Compile(document []byte) (*Schema, error) {
+	var value interface{}
+	err := json.Unmarshal(document, &value)
+	if err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %v", err)
+	}
+	c := &compiler{document: value, refs: map[string]*node{}}
+	root, err := c.compile(value, "")
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{root: root}, nil
+}
+
+// compile compiles a subschema
+//
+// Parameters:
+//   - value: the decoded subschema
+//   - path: the JSON Pointer of the subschema in the document, for the error messages
+//
+// Returns:
+//   - *node: the compiled subschema
+//   - error: an error if a keyword is invalid or unsupported
+func (c *compiler) compile(value interface{}, path string) (*node, error) {
+	switch value := value.(type) {
+	case bool:
+		return &node{never: !value}, nil
+	case map[string]interface{}:
+		n := &node{}
+		// the keywords are compiled in order, so errors do not depend on the order of the map
+		keywords := make([]string, 0, len(value))
+		for keyword := range value {
+			keywords = append(keywords, keyword)
+		}
+		sort.Strings(keywords)
+		for _, keyword := range keywords {
+			err := c.keyword(n, keyword, value[keyword], path+"/"+escape(keyword))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("schema %v must be an object or a boolean", pointer(path))
+}
+
+// keyword compiles a keyword of a subschema into its node
+//
+// Parameters:
+//   - n: the node of the subschema
+//   - keyword: the keyword
+//   - value: the value of the keyword
+//   - path: the JSON Pointer of the keyword
+//
+// Returns:
+//   - error: an error if the value is invalid or the keyword is unsupported
+func (c *compiler) keyword(n *node, keyword string, value interface{}, path string) error {
+	var err error
+	switch keyword {
+	case "$defs", "definitions":
+		// the definitions are compiled when they are referenced
+		_, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v must be an object", pointer(path))
+		}
+	case "$ref":
+		ref, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v must be a string", pointer(path))
+		}
+		n.ref, err = c.resolve(ref)
+	case "type":
+		n.types, err = typeNames(value, path)
+	case "enum":
+		values, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%v must be an array", pointer(path))
+		}
+		n.enum = values
+	case "const":
+		n.constant = &value
+	case "properties":
+		n.properties, err = c.schemaMap(value, path)
+	case "required":
+		n.required, err = stringArray(value, path)
+	case "additionalProperties":
+		n.additionalProperties, err = c.compile(value, path)
+	case "minProperties":
+		n.minProperties, err = count(value, path)
+	case "maxProperties":
+		n.maxProperties, err = count(value, path)
+	case "prefixItems":
+		n.prefixItems, err = c.schemaArray(value, path)
+	case "items":
+		n.items, err = c.compile(value, path)
+	case "minItems":
+		n.minItems, err = count(value, path)
+	case "maxItems":
+		n.maxItems, err = count(value, path)
+	case "uniqueItems":
+		unique, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%v must be a boolean", pointer(path))
+		}
+		n.uniqueItems = unique
+	case "minLength":
+		n.minLength, err = count(value, path)
+	case "maxLength":
+		n.maxLength, err = count(value, path)
+	case "pattern":
+		pattern, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v must be a string", pointer(path))
+		}
+		n.pattern, err = regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%v is not a valid regular expression: %v", pointer(path), err)
+		}
+	case "minimum":
+		n.minimum, err = number(value, path)
+	case "maximum":
+		n.maximum, err = number(value, path)
+	case "exclusiveMinimum":
+		n.exclusiveMinimum, err = number(value, path)
+	case "exclusiveMaximum":
+		n.exclusiveMaximum, err = number(value, path)
+	case "multipleOf":
+		n.multipleOf, err = number(value, path)
+		if err == nil && *n.multipleOf <= 0 {
+			return fmt.Errorf("%v must be greater than 0", pointer(path))
+		}
+	case "allOf":
+		n.allOf, err = c.schemaArray(value, path)
+	case "anyOf":
+		n.anyOf, err = c.schemaArray(value, path)
+	case "oneOf":
+		n.oneOf, err = c.schemaArray(value, path)
+	case "not":
+		n.not, err = c.compile(value, path)
+	default:
+		if !annotations[keyword] {
+			return fmt.Errorf("unsupported keyword %v", pointer(path))
+		}
+	}
+	return err
+}
+
+// resolve compiles the subschema a reference points to
+// Only references within the document are supported, e.g. "#" or "#/$defs/item".
+//
+// Parameters:
+//   - ref: the reference
+//
+// Returns:
+//   - *node: the node of the referenced subschema, shared by all references to it
+//   - error: an error if the reference does not point to a subschema of the document
+func (c *compiler) resolve(ref string) (*node, error) {
+	if n, ok := c.refs[ref]; ok {
+		return n, nil
+	}
+	path, ok := strings.CutPrefix(ref, "#")
+	if !ok || (path != "" && !strings.HasPrefix(path, "/")) {
+		return nil, fmt.Errorf("unsupported reference %q, only references within the schema are supported", ref)
+	}
+
+	value := c.document
+	if path != "" {
+		for _, token := range strings.Split(path[1:], "/") {
+			token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+			switch parent := value.(type) {
+			case map[string]interface{}:
+				value, ok = parent[token]
+			case []interface{}:
+				index, err := strconv.Atoi(token)
+				ok = err == nil && index >= 0 && index < len(parent)
+				if ok {
+					value = parent[index]
+				}
+			default:
+				ok = false
+			}
+			if !ok {
+				return nil, fmt.Errorf("reference %q does not point to a subschema", ref)
+			}
+		}
+	}
+
+	// the node is registered before it is compiled, so recursive references end at it
+	n := &node{}
+	c.refs[ref] = n
+	compiled, err := c.compile(value, path)
+	if err != nil {
+		return nil, err
+	}
+	*n = *compiled
+	return n, nil
+}
+
+// schemaMap compiles an object of subschemas, e.g. properties
+func (c *compiler) schemaMap(value interface{}, path string) (map[string]*node, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v must be an object", pointer(path))
+	}
+	nodes := make(map[string]*node, len(object))
+	for name, subschema := range object {
+		n, err := c.compile(subschema, path+"/"+escape(name))
+		if err != nil {
+			return nil, err
+		}
+		nodes[name] = n
+	}
+	return nodes, nil
+}
+
+// schemaArray compiles an array of subschemas, e.g. anyOf
+func (c *compiler) schemaArray(value interface{}, path string) ([]*node, error) {
+	array, ok := value.([]interface{})
+	if !ok || len(array) == 0 {
+		return nil, fmt.Errorf("%v must be a non-empty array", pointer(path))
+	}
+	nodes := make([]*node, len(array))
+	for i, subschema := range array {
+		n, err := c.compile(subschema, fmt.Sprintf("%v/%d", path, i))
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = n
+	}
+	return nodes, nil
+}
+
+// typeNames returns the type names of the type keyword, a name or an array of names
+func typeNames(value interface{}, path string) ([]string, error) {
+	names := []string{}
+	switch value := value.(type) {
+	case string:
+		names = append(names, value)
+	case []interface{}:
+		var err error
+		names, err = stringArray(value, path)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%v must be a string or an array of strings", pointer(path))
+	}
+	for _, name := range names {
+		if !types[name] {
+			return nil, fmt.Errorf("%v has the unknown type %q", pointer(path), name)
+		}
+	}
+	return names, nil
+}
+
+// stringArray returns the strings of an array keyword, e.g. required
+func stringArray(value interface{}, path string) ([]string, error) {
+	array, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v must be an array of strings", pointer(path))
+	}
+	strs := make([]string, len(array))
+	for i, element := range array {
+		strs[i], ok = element.(string)
+		if !ok {
+			return nil, fmt.Errorf("%v must be an array of strings", pointer(path))
+		}
+	}
+	return strs, nil
+}
+
+// number returns the value of a numeric keyword, e.g. minimum
+func number(value interface{}, path string) (*float64, error) {
+	f, ok := value.(float64)
+	if !ok {
+		return nil, fmt.Errorf("%v must be a number", pointer(path))
+	}
+	return &f, nil
+}
+
+// count returns the value of a keyword counting items, properties or characters
+func count(value interface{}, path string) (*int, error) {
+	f, ok := value.(float64)
+	if !ok || f < 0 || f != math.Trunc(f) {
+		return nil, fmt.Errorf("%v must be a non-negative integer", pointer(path))
+	}
+	i := int(f)
+	return &i, nil
+}
+
+// escape escapes a token of a JSON Pointer
+func escape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// pointer formats a JSON Pointer for the error messages
+func pointer(path string) string {
+	if path == "" {
+		return `"/"`
+	}
+	return strconv.Quote(path)
+}
+
+// Validate validates a decoded JSON value, as returned by json.Unmarshal into an interface{}
+//
+// Parameters:
+//   - value: the value
+//
+// Returns:
+//   - []ValidationError: the violations of the schema, empty if the value is valid
+func (s *Schema) Validate(value interface{}) []ValidationError {
+	return s.root.validate(value, "")
+}
+
+// validate validates a value against a node
+//
+// Parameters:
+//   - value: the value
+//   - path: the JSON Pointer of the value
+//
+// Returns:
+//   - []ValidationError: the violations
+func (n *node) validate(value interface{}, path string) []ValidationError {
+	if n.never {
+		return []ValidationError{{Path: path, Message: "no value is allowed here"}}
+	}
+	errs := []ValidationError{}
+	fail := func(message string, args ...interface{}) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(message, args...)})
+	}
+
+	if n.ref != nil {
+		errs = append(errs, n.ref.validate(value, path)...)
+	}
+	if len(n.types) > 0 && !hasType(value, n.types) {
+		fail("expected %v, got %v", strings.Join(n.types, " or "), typeName(value))
+		// the other keywords would only repeat the type mismatch
+		return errs
+	}
+	if n.enum != nil && !containsValue(n.enum, value) {
+		fail("value must be one of %v", format(n.enum))
+	}
+	if n.constant != nil && !equal(*n.constant, value) {
+		fail("value must be %v", format(*n.constant))
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		errs = append(errs, n.validateObject(value, path)...)
+	case []interface{}:
+		errs = append(errs, n.validateArray(value, path)...)
+	case string:
+		length := utf8.RuneCountInString(value)
+		if n.minLength != nil && length < *n.minLength {
+			fail("string must have at least %d characters", *n.minLength)
+		}
+		if n.maxLength != nil && length > *n.maxLength {
+			fail("string must have at most %d characters", *n.maxLength)
+		}
+		if n.pattern != nil && !n.pattern.MatchString(value) {
+			fail("string must match the pattern %q", n.pattern.String())
+		}
+	case float64:
+		if n.minimum != nil && value < *n.minimum {
+			fail("number must be at least %v", *n.minimum)
+		}
+		if n.maximum != nil && value > *n.maximum {
+			fail("number must be at most %v", *n.maximum)
+		}
+		if n.exclusiveMinimum != nil && value <= *n.exclusiveMinimum {
+			fail("number must be greater than %v", *n.exclusiveMinimum)
+		}
+		if n.exclusiveMaximum != nil && value >= *n.exclusiveMaximum {
+			fail("number must be less than %v", *n.exclusiveMaximum)
+		}
+		if n.multipleOf != nil {
+			quotient := value / *n.multipleOf
+			if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+				fail("number must be a multiple of %v", *n.multipleOf)
+			}
+		}
+	}
+
+	for _, subschema := range n.allOf {
+		errs = append(errs, subschema.validate(value, path)...)
+	}
+	if n.anyOf != nil {
+		matched := false
+		for _, subschema := range n.anyOf {
+			if len(subschema.validate(value, path)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			fail("value must match at least one schema of anyOf")
+		}
+	}
+	if n.oneOf != nil {
+		matches := 0
+		for _, subschema := range n.oneOf {
+			if len(subschema.validate(value, path)) == 0 {
+				matches++
+			}
+		}
+		if matches != 1 {
+			fail("value must match exactly one schema of oneOf, matches %d", matches)
+		}
+	}
+	if n.not != nil && len(n.not.validate(value, path)) == 0 {
+		fail("value must not match the schema of not")
+	}
+	return errs
+}
+
+// validateObject validates the properties of an object
+func (n *node) validateObject(object map[string]interface{}, path string) []ValidationError {
+	errs := []ValidationError{}
+	for _, name := range n.required {
+		if _, ok := object[name]; !ok {
+			errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("missing required property %q", name)})
+		}
+	}
+	if n.minProperties != nil && len(object) < *n.minProperties {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("object must have at least %d properties", *n.minProperties)})
+	}
+	if n.maxProperties != nil && len(object) > *n.maxProperties {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("object must have at most %d properties", *n.maxProperties)})
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		propertyPath := path + "/" + escape(name)
+		if property, ok := n.properties[name]; ok {
+			errs = append(errs, property.validate(object[name], propertyPath)...)
+		} else if n.additionalProperties != nil {
+			if n.additionalProperties.never {
+				errs = append(errs, ValidationError{Path: propertyPath, Message: "property is not allowed"})
+			} else {
+				errs = append(errs, n.additionalProperties.validate(object[name], propertyPath)...)
+			}
+		}
+	}
+	return errs
+}
+
+// validateArray validates the items of an array
+func (n *node) validateArray(array []interface{}, path string) []ValidationError {
+	errs := []ValidationError{}
+	if n.minItems != nil && len(array) < *n.minItems {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("array must have at least %d items", *n.minItems)})
+	}
+	if n.maxItems != nil && len(array) > *n.maxItems {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("array must have at most %d items", *n.maxItems)})
+	}
+	if n.uniqueItems {
+		for i := range array {
+			for j := 0; j < i; j++ {
+				if equal(array[i], array[j]) {
+					errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("array items %d and %d must not be equal", j, i)})
+				}
+			}
+		}
+	}
+	for i, item := range array {
+		itemPath := fmt.Sprintf("%v/%d", path, i)
+		if i < len(n.prefixItems) {
+			errs = append(errs, n.prefixItems[i].validate(item, itemPath)...)
+		} else if n.items != nil {
+			if n.items.never {
+				errs = append(errs, ValidationError{Path: itemPath, Message: "item is not allowed"})
+			} else {
+				errs = append(errs, n.items.validate(item, itemPath)...)
+			}
+		}
+	}
+	return errs
+}
+
+// hasType reports whether a value is of one of the given types
+func hasType(value interface{}, names []string) bool {
+	actual := typeName(value)
+	for _, name := range names {
+		if name == actual || (name == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// typeName returns the most specific type name of a value
+func typeName(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if value == math.Trunc(value) && !math.IsInf(value, 0) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("unsupported %T", value)
+}
+
+// containsValue reports whether a list holds a value equal to the given one
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// equal reports whether two decoded JSON values are equal
+func equal(a interface{}, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for name, value := range a {
+			other, ok := b[name]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// format returns the JSON text of a value for the error messages
+func format(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
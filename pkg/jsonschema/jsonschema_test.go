@@ -0,0 +1,169 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validate compiles a schema and validates the JSON text of a value against it
+func validate(t *testing.T, schema string, value string) []string {
+	compiled, err := Compile([]byte(schema))
+	require.NoError(t, err)
+	var decoded interface{}
+	require.NoError(t, json.Unmarshal([]byte(value), &decoded))
+	messages := []string{}
+	for _, err := range compiled.Validate(decoded) {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+const criteriaSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "Criteria",
+	"type": "object",
+	"properties": {
+		"criteria": {
+			"type": "array",
+			"minItems": 1,
+			"items": {"$ref": "#/$defs/criterion"}
+		},
+		"comment": {"type": ["string", "null"], "maxLength": 10}
+	},
+	"required": ["criteria"],
+	"additionalProperties": false,
+	"$defs": {
+		"criterion": {
+			"type": "object",
+			"properties": {
+				"attributeName": {"type": "string", "minLength": 1},
+				"confidence": {"type": "number", "minimum": 0, "maximum": 1},
+				"unit": {"enum": ["Pa", "K", null]}
+			},
+			"required": ["attributeName", "confidence"]
+		}
+	}
+}`
+
+func TestValidateObject(t *testing.T) {
+	assert.Empty(t, validate(t, criteriaSchema, `{"criteria": [{"attributeName": "Density", "confidence": 0.9, "unit": null}], "comment": null}`))
+
+	assert.Equal(t, []string{
+		`/: missing required property "criteria"`,
+		`/comment: string must have at most 10 characters`,
+		`/extra: property is not allowed`,
+	}, validate(t, criteriaSchema, `{"comment": "far too long", "extra": 1}`))
+
+	assert.Equal(t, []string{
+		`/criteria/0/confidence: number must be at most 1`,
+		`/criteria/0/unit: value must be one of ["Pa","K",null]`,
+		`/criteria/1: missing required property "confidence"`,
+		`/criteria/1/attributeName: expected string, got integer`,
+	}, validate(t, criteriaSchema, `{"criteria": [{"attributeName": "Density", "confidence": 1.5, "unit": "kg"}, {"attributeName": 3}]}`))
+
+	assert.Equal(t, []string{`/criteria: array must have at least 1 items`}, validate(t, criteriaSchema, `{"criteria": []}`))
+	assert.Equal(t, []string{`/: expected object, got array`}, validate(t, criteriaSchema, `[]`))
+}
+
+func TestValidateTypes(t *testing.T) {
+	assert.Empty(t, validate(t, `{"type": "integer"}`, `3`))
+	assert.Equal(t, []string{`/: expected integer, got number`}, validate(t, `{"type": "integer"}`, `3.5`))
+	assert.Empty(t, validate(t, `{"type": "number"}`, `3`))
+	assert.Empty(t, validate(t, `{"type": ["boolean", "null"]}`, `null`))
+	assert.Equal(t, []string{`/: expected boolean or null, got string`}, validate(t, `{"type": ["boolean", "null"]}`, `"yes"`))
+	assert.Empty(t, validate(t, `true`, `{"anything": 1}`))
+	assert.Equal(t, []string{`/: no value is allowed here`}, validate(t, `false`, `1`))
+}
+
+func TestValidateKeywords(t *testing.T) {
+	assert.Equal(t, []string{`/: string must match the pattern "^[a-z]+$"`}, validate(t, `{"pattern": "^[a-z]+$"}`, `"Mesh"`))
+	assert.Equal(t, []string{`/: number must be greater than 0`}, validate(t, `{"exclusiveMinimum": 0}`, `0`))
+	assert.Equal(t, []string{`/: number must be a multiple of 0.5`}, validate(t, `{"multipleOf": 0.5}`, `1.2`))
+	assert.Empty(t, validate(t, `{"multipleOf": 0.1}`, `0.3`))
+	assert.Equal(t, []string{`/: value must be "mesh"`}, validate(t, `{"const": "mesh"}`, `"solver"`))
+	assert.Equal(t, []string{`/: array items 0 and 2 must not be equal`}, validate(t, `{"uniqueItems": true}`, `[{"a": 1}, 2, {"a": 1}]`))
+	assert.Equal(t, []string{`/: object must have at most 1 properties`}, validate(t, `{"maxProperties": 1}`, `{"a": 1, "b": 2}`))
+	assert.Equal(t, []string{`/1: expected string, got integer`, `/2: item is not allowed`}, validate(t, `{"prefixItems": [{"type": "integer"}, {"type": "string"}], "items": false}`, `[1, 2, 3]`))
+	assert.Equal(t, []string{`/b: expected integer, got string`}, validate(t, `{"additionalProperties": {"type": "integer"}}`, `{"a": 1, "b": "2"}`))
+}
+
+func TestValidateCombinations(t *testing.T) {
+	schema := `{"anyOf": [{"type": "string"}, {"type": "integer"}]}`
+	assert.Empty(t, validate(t, schema, `"mesh"`))
+	assert.Equal(t, []string{`/: value must match at least one schema of anyOf`}, validate(t, schema, `true`))
+
+	schema = `{"oneOf": [{"type": "number"}, {"type": "integer"}]}`
+	assert.Empty(t, validate(t, schema, `1.5`))
+	assert.Equal(t, []string{`/: value must match exactly one schema of oneOf, matches 2`}, validate(t, schema, `1`))
+
+	schema = `{"allOf": [{"minimum": 0}, {"maximum": 10}], "not": {"const": 5}}`
+	assert.Empty(t, validate(t, schema, `3`))
+	assert.Equal(t, []string{`/: number must be at most 10`}, validate(t, schema, `11`))
+	assert.Equal(t, []string{`/: value must not match the schema of not`}, validate(t, schema, `5`))
+}
+
+func TestValidateRecursiveReference(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"children": {"type": "array", "items": {"$ref": "#"}}
+		},
+		"required": ["name"]
+	}`
+	assert.Empty(t, validate(t, schema, `{"name": "root", "children": [{"name": "leaf", "children": []}]}`))
+	assert.Equal(t, []string{`/children/0/children/0: missing required property "name"`}, validate(t, schema, `{"name": "root", "children": [{"name": "a", "children": [{}]}]}`))
+}
+
+func TestCompileInvalid(t *testing.T) {
+	schemas := map[string]string{
+		"not json":             `{"type": `,
+		"not a schema":         `3`,
+		"unknown type":         `{"type": "float"}`,
+		"unsupported keyword":  `{"dependentRequired": {}}`,
+		"invalid pattern":      `{"pattern": "("}`,
+		"negative count":       `{"minItems": -1}`,
+		"remote reference":     `{"$ref": "https://example.com/schema.json"}`,
+		"dangling reference":   `{"$ref": "#/$defs/missing"}`,
+		"invalid required":     `{"required": "name"}`,
+		"empty anyOf":          `{"anyOf": []}`,
+		"invalid nested":       `{"properties": {"a": {"minLength": "1"}}}`,
+		"zero multipleOf":      `{"multipleOf": 0}`,
+		"invalid definitions":  `{"$defs": []}`,
+		"invalid items schema": `{"items": "string"}`,
+	}
+	for name, schema := range schemas {
+		t.Run(name, func(t *testing.T) {
+			_, err := Compile([]byte(schema))
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := Compile([]byte(`{"properties": {"a": {"minLength": "1"}}}`))
+	assert.EqualError(t, err, `"/properties/a/minLength" must be a non-negative integer`)
+}
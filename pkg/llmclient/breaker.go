@@ -0,0 +1,109 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+)
+
+// ErrCircuitOpen is returned by Send while aali-llm is considered down
+var ErrCircuitOpen = errors.New("aali-llm circuit breaker is open")
+
+// breaker is the circuit breaker of a pool
+// It opens after consecutive failures and fails requests fast for a cooldown,
+// then lets a single trial request through and closes again if it succeeds.
+type breaker struct {
+	endpoint  string
+	threshold int
+	cooldown  time.Duration
+
+	mutex     sync.Mutex
+	failures  int
+	openUntil time.Time
+	trialAt   time.Time
+}
+
+// allow checks whether a request may be sent
+//
+// Returns:
+//   - error: ErrCircuitOpen if the breaker is open
+func (b *breaker) allow() error {
+	if b.threshold <= 0 {
+		return nil
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+	now := time.Now()
+	if now.Before(b.openUntil) {
+		return fmt.Errorf("%w, retrying after %v", ErrCircuitOpen, b.openUntil.Sub(now).Round(time.Millisecond))
+	}
+	// A single trial request per cooldown probes whether aali-llm is back
+	if !b.trialAt.IsZero() && now.Sub(b.trialAt) < b.cooldown {
+		return fmt.Errorf("%w, waiting for the trial request", ErrCircuitOpen)
+	}
+	b.trialAt = now
+	return nil
+}
+
+// record records the outcome of a request
+//
+// Parameters:
+//   - success: false if aali-llm could not be reached or answered with a retryable error
+func (b *breaker) record(success bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if success {
+		if b.failures >= b.threshold {
+			redact.Log.Infof(&logging.ContextMap{}, "aali-llm at %v is reachable again, closing the circuit breaker.", b.endpoint)
+			metrics.SetCircuitOpen("aali-llm", false)
+		}
+		b.failures = 0
+		b.trialAt = time.Time{}
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		if b.failures == b.threshold || !b.trialAt.IsZero() {
+			redact.Log.Warnf(&logging.ContextMap{}, "aali-llm at %v failed %v times in a row, failing requests fast for %v.", b.endpoint, b.failures, b.cooldown)
+		}
+		metrics.SetCircuitOpen("aali-llm", true)
+		b.openUntil = time.Now().Add(b.cooldown)
+		b.trialAt = time.Time{}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreaker(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	b := &breaker{endpoint: "ws://llm", threshold: 2, cooldown: 50 * time.Millisecond}
+
+	// consecutive failures open the breaker, a success in between resets them
+	b.record(false)
+	b.record(true)
+	b.record(false)
+	require.NoError(t, b.allow())
+	b.record(false)
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	// after the cooldown a single trial request is let through
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, b.allow())
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	// a failed trial opens the breaker again, a successful one closes it
+	b.record(false)
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, b.allow())
+	b.record(true)
+	require.NoError(t, b.allow())
+	require.NoError(t, b.allow())
+
+	// a negative threshold disables the breaker
+	disabled := &breaker{threshold: -1}
+	for range 10 {
+		disabled.record(false)
+	}
+	assert.NoError(t, disabled.allow())
+}
+
+func TestPoolFailsFastWhileDown(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	server := httptest.NewServer(http.NotFoundHandler())
+	endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+	server.Close()
+	pool := NewPool(Options{Endpoint: endpoint, Connections: 1, ReconnectDelay: time.Millisecond, BreakerThreshold: 2, BreakerCooldown: time.Hour})
+	defer pool.Close()
+
+	// failed dials are retryable until the breaker opens
+	for range 2 {
+		_, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "hello"})
+		assert.True(t, IsUnavailable(err))
+		time.Sleep(2 * time.Millisecond)
+	}
+	_, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "hello"})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.False(t, IsUnavailable(err))
+}
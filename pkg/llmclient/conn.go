@@ -0,0 +1,282 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"nhooyr.io/websocket"
+)
+
+// conn is a connection of the pool, redialed after it is lost
+type conn struct {
+	pool *Pool
+	// slots holds a token per unfinished request
+	slots chan struct{}
+
+	mutex    sync.Mutex
+	ws       *websocket.Conn
+	calls    map[string]*Call
+	dialErr  error
+	failedAt time.Time
+}
+
+// register adds a call to the connection, dialing it if needed
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - call: the call
+//
+// Returns:
+//   - *websocket.Conn: the connection to write the request to
+//   - error: an error if the connection cannot be opened
+func (c *conn) register(ctx context.Context, call *Call) (*websocket.Conn, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	select {
+	case <-c.pool.closed:
+		return nil, ErrClosed
+	default:
+	}
+	if c.ws == nil {
+		err := c.dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := c.calls[call.guid]; ok {
+		return nil, fmt.Errorf("request %v is already in flight", call.guid)
+	}
+	c.calls[call.guid] = call
+	return c.ws, nil
+}
+
+// dial opens and authenticates the connection and starts its reader and heartbeat
+// The connection is not redialed within ReconnectDelay of a failed dial.
+// The caller must hold the mutex.
+//
+// Parameters:
+//   - ctx: the context of the request triggering the dial
+//
+// Returns:
+//   - error: an error if the connection cannot be opened
+func (c *conn) dial(ctx context.Context) error {
+	options := c.pool.options
+	if c.dialErr != nil && time.Since(c.failedAt) < options.ReconnectDelay {
+		return unavailableError{fmt.Errorf("failed to connect to aali-llm: %v", c.dialErr)}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.DialTimeout)
+	defer cancel()
+	// The handshake is traced as part of the request triggering the dial
+	ws, _, err := websocket.Dial(ctx, options.Endpoint, &websocket.DialOptions{HTTPHeader: tracing.HTTPHeader(ctx)})
+	if err != nil {
+		c.dialErr, c.failedAt = err, time.Now()
+		c.pool.breaker.record(false)
+		return unavailableError{fmt.Errorf("failed to connect to aali-llm: %v", err)}
+	}
+	// Disable the read limit
+	ws.SetReadLimit(-1)
+
+	// Send apikey for authentication
+	err = ws.Write(ctx, websocket.MessageText, []byte(options.APIKey))
+	if err != nil {
+		ws.CloseNow()
+		c.dialErr, c.failedAt = err, time.Now()
+		c.pool.breaker.record(false)
+		return unavailableError{fmt.Errorf("failed to send authentication message to aali-llm: %v", err)}
+	}
+
+	redact.Log.Debugf(&logging.ContextMap{}, "Connected to aali-llm at %v.", options.Endpoint)
+	c.dialErr = nil
+	c.ws = ws
+	done := make(chan struct{})
+	go c.read(ws, done)
+	go c.heartbeat(ws, done)
+	return nil
+}
+
+// remove removes a call from the connection and frees its slot
+//
+// Parameters:
+//   - call: the call
+//
+// Returns:
+//   - bool: true if the call was unfinished
+func (c *conn) remove(call *Call) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.calls[call.guid] != call {
+		return false
+	}
+	delete(c.calls, call.guid)
+	<-c.slots
+	return true
+}
+
+// read receives the messages of a connection until it is lost, then fails its unfinished calls
+//
+// Parameters:
+//   - ws: the connection
+//   - done: closed once the connection is lost
+func (c *conn) read(ws *websocket.Conn, done chan struct{}) {
+	var err error
+	for {
+		var typ websocket.MessageType
+		var message []byte
+		typ, message, err = ws.Read(context.Background())
+		if err != nil {
+			break
+		}
+		if typ != websocket.MessageText && typ != websocket.MessageBinary {
+			redact.Log.Warnf(&logging.ContextMap{}, "Response with unsupported message type '%v' received from aali-llm. Ignoring...", typ)
+			continue
+		}
+
+		var response sharedtypes.HandlerResponse
+		err = json.Unmarshal(message, &response)
+		if err != nil {
+			if string(message) == "authentication successful" {
+				redact.Log.Debugf(&logging.ContextMap{}, "Authentication to LLM was successful.")
+			} else {
+				redact.Log.Errorf(&logging.ContextMap{}, "failed to unmarshal message from aali-llm: %v", err)
+			}
+			continue
+		}
+		if response.Type == "info" && response.InfoMessage != nil {
+			redact.Log.Infof(&logging.ContextMap{}, "Info %v: %v", response.InstructionGuid, *response.InfoMessage)
+			continue
+		}
+		c.deliver(response)
+	}
+
+	c.disconnect(ws, done, err)
+}
+
+// deliver passes a response to its call without waiting for the consumer
+// The response is dropped if the call was abandoned or cancelled
+//
+// Parameters:
+//   - response: the response
+func (c *conn) deliver(response sharedtypes.HandlerResponse) {
+	c.mutex.Lock()
+	call, ok := c.calls[response.InstructionGuid]
+	final := ok && call.Final(response)
+	if final {
+		delete(c.calls, call.guid)
+		<-c.slots
+	}
+	c.mutex.Unlock()
+	if final {
+		c.pool.breaker.record(!c.pool.options.Retry.Retryable(response))
+	}
+
+	if !ok {
+		if response.Type == "error" && response.Error != nil {
+			redact.Log.Errorf(&logging.ContextMap{}, "error from aali-llm for request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+		} else {
+			redact.Log.Debugf(&logging.ContextMap{}, "Dropping response of abandoned request %v from aali-llm.", response.InstructionGuid)
+		}
+		return
+	}
+
+	call.push(response, final)
+}
+
+// disconnect forgets a lost connection and sends an error response to its unfinished calls
+//
+// Parameters:
+//   - ws: the lost connection
+//   - done: closed to stop the heartbeat
+//   - err: the error the connection was lost with
+func (c *conn) disconnect(ws *websocket.Conn, done chan struct{}, err error) {
+	close(done)
+	ws.CloseNow()
+
+	c.mutex.Lock()
+	if c.ws == ws {
+		c.ws = nil
+	}
+	calls := c.calls
+	c.calls = map[string]*Call{}
+	for range calls {
+		<-c.slots
+	}
+	c.mutex.Unlock()
+
+	select {
+	case <-c.pool.closed:
+		redact.Log.Debugf(&logging.ContextMap{}, "Closed connection to aali-llm.")
+	default:
+		redact.Log.Warnf(&logging.ContextMap{}, "Connection to aali-llm lost, %v requests failed: %v", len(calls), err)
+		c.pool.breaker.record(false)
+	}
+
+	message := fmt.Sprintf("connection to aali-llm lost: %v", err)
+	for _, call := range calls {
+		call.push(errorResponse(call.guid, CodeUnavailable, message), true)
+	}
+}
+
+// heartbeat pings a connection and drops it if the pong does not arrive in time
+//
+// Parameters:
+//   - ws: the connection
+//   - done: closed once the connection is lost
+func (c *conn) heartbeat(ws *websocket.Conn, done chan struct{}) {
+	options := c.pool.options
+	if options.HeartbeatInterval < 0 {
+		return
+	}
+
+	ticker := time.NewTicker(options.HeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), options.HeartbeatTimeout)
+		err := ws.Ping(ctx)
+		cancel()
+		if err != nil {
+			select {
+			case <-done:
+			default:
+				redact.Log.Warnf(&logging.ContextMap{}, "aali-llm heartbeat failed, reconnecting: %v", err)
+				ws.CloseNow()
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,466 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package llmclient keeps long-lived, authenticated WebSocket connections to aali-llm.
+// Requests are multiplexed over the connections by their InstructionGuid.
+package llmclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/tracing"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/google/uuid"
+	"nhooyr.io/websocket"
+)
+
+// ErrClosed is returned by Send once the pool is closed
+var ErrClosed = errors.New("aali-llm client pool is closed")
+
+// Options configures a Pool
+type Options struct {
+	// Endpoint is the WebSocket URL of aali-llm
+	Endpoint string
+	// APIKey is sent as first message of every connection
+	APIKey string
+	// Connections is the number of connections requests are spread over
+	Connections int
+	// MaxInFlight is the number of unfinished requests per connection; Send waits while the connection is full
+	MaxInFlight int
+	// ResponseBuffer is the number of responses buffered per request, further responses are queued for a slow consumer
+	ResponseBuffer int
+	// HeartbeatInterval is the time between the pings checking a connection, no pings if negative
+	HeartbeatInterval time.Duration
+	// HeartbeatTimeout is the time a ping waits for its pong before the connection is dropped
+	HeartbeatTimeout time.Duration
+	// DialTimeout limits the connection handshake and authentication
+	DialTimeout time.Duration
+	// ReconnectDelay is the time a connection is not redialed after a failed dial
+	ReconnectDelay time.Duration
+	// Retry is the retry policy of the requests, whose retryable codes also count as failures of the circuit breaker
+	Retry RetryPolicy
+	// BreakerThreshold is the number of consecutive failures opening the circuit breaker, no breaker if negative
+	BreakerThreshold int
+	// BreakerCooldown is the time the open circuit breaker fails requests before letting a trial request through
+	BreakerCooldown time.Duration
+}
+
+// Pool is a set of connections to aali-llm shared by all requests
+// The connections are dialed on first use and redialed after they are lost.
+// Since the requests share the connections, each message carries the trace context of its request.
+type Pool struct {
+	options Options
+	breaker *breaker
+	conns   []*conn
+	next    atomic.Uint32
+	closed  chan struct{}
+	once    sync.Once
+}
+
+// message is a request as sent to aali-llm
+type message struct {
+	sharedtypes.HandlerRequest
+	// TraceContext holds the trace propagation fields of the request (traceparent, tracestate, baggage)
+	TraceContext map[string]string `json:"traceContext,omitempty"`
+}
+
+// Call is a request sent to aali-llm
+// Its responses are received on Responses, which is closed after the last response.
+// Once the context of the request is done, the call ends with an error response.
+type Call struct {
+	guid      string
+	stream    bool
+	conn      *conn
+	responses chan sharedtypes.HandlerResponse
+	done      chan struct{}
+	once      sync.Once
+	// cancelled is closed once the context of the request is done, so pending responses are dropped
+	cancelled chan struct{}
+	// queued is signalled when responses are added to pending
+	queued chan struct{}
+
+	// mutex guards pending and finished
+	mutex sync.Mutex
+	// pending holds the responses not yet passed to the consumer, so a slow consumer does not hold up the connection
+	pending []sharedtypes.HandlerResponse
+	// finished is set once the last response is pending
+	finished bool
+	// stop stops watching the context of the request
+	stop func() bool
+}
+
+// NewPool creates a pool of connections to aali-llm
+// The connections are opened on first use
+//
+// Parameters:
+//   - options: the options of the pool, defaults are used for zero values
+//
+// Returns:
+//   - *Pool: the pool
+func NewPool(options Options) *Pool {
+	if options.Connections <= 0 {
+		options.Connections = 4
+	}
+	if options.MaxInFlight <= 0 {
+		options.MaxInFlight = 64
+	}
+	if options.ResponseBuffer <= 0 {
+		options.ResponseBuffer = 64
+	}
+	if options.HeartbeatInterval == 0 {
+		options.HeartbeatInterval = 30 * time.Second
+	}
+	if options.HeartbeatTimeout <= 0 {
+		options.HeartbeatTimeout = 10 * time.Second
+	}
+	if options.DialTimeout <= 0 {
+		options.DialTimeout = 10 * time.Second
+	}
+	if options.ReconnectDelay <= 0 {
+		options.ReconnectDelay = time.Second
+	}
+	if options.Retry.MaxAttempts <= 0 {
+		options.Retry.MaxAttempts = 3
+	}
+	if options.Retry.InitialBackoff <= 0 {
+		options.Retry.InitialBackoff = 200 * time.Millisecond
+	}
+	if options.Retry.MaxBackoff <= 0 {
+		options.Retry.MaxBackoff = 5 * time.Second
+	}
+	if options.Retry.RetryableCodes == nil {
+		options.Retry.RetryableCodes = []int{429, 500, 502, 503, 504}
+	}
+	if options.BreakerThreshold == 0 {
+		options.BreakerThreshold = 5
+	}
+	if options.BreakerCooldown <= 0 {
+		options.BreakerCooldown = 30 * time.Second
+	}
+
+	p := &Pool{
+		options: options,
+		breaker: &breaker{endpoint: options.Endpoint, threshold: options.BreakerThreshold, cooldown: options.BreakerCooldown},
+		closed:  make(chan struct{}),
+	}
+	for range options.Connections {
+		p.conns = append(p.conns, &conn{
+			pool:  p,
+			calls: map[string]*Call{},
+			slots: make(chan struct{}, options.MaxInFlight),
+		})
+	}
+	return p
+}
+
+// Send sends a request on the least busy connection
+// The request waits for a free slot if the connection has MaxInFlight unfinished requests.
+// A streamed chat request is finished by the response flagged IsLast, any other request by its first response.
+//
+// Parameters:
+//   - ctx: the context of the request, limiting the wait for a slot, the dial and the write, and ending the call once done
+//   - request: the request, an InstructionGuid is generated if empty
+//
+// Returns:
+//   - *Call: the call receiving the responses
+//   - error: an error if the request cannot be sent, ErrCircuitOpen while aali-llm is considered down
+func (p *Pool) Send(ctx context.Context, request sharedtypes.HandlerRequest) (*Call, error) {
+	err := p.breaker.allow()
+	if err != nil {
+		return nil, err
+	}
+	if request.InstructionGuid == "" {
+		request.InstructionGuid = strings.ReplaceAll(uuid.New().String(), "-", "")
+	}
+	data, err := json.Marshal(message{HandlerRequest: request, TraceContext: tracing.TraceContext(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request to aali-llm: %v", err)
+	}
+
+	c := p.pick()
+	select {
+	case c.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.closed:
+		return nil, ErrClosed
+	}
+
+	call := &Call{
+		guid:      request.InstructionGuid,
+		stream:    request.Adapter == "chat" && request.DataStream,
+		conn:      c,
+		responses: make(chan sharedtypes.HandlerResponse, p.options.ResponseBuffer),
+		done:      make(chan struct{}),
+		cancelled: make(chan struct{}),
+		queued:    make(chan struct{}, 1),
+	}
+	ws, err := c.register(ctx, call)
+	if err != nil {
+		<-c.slots
+		return nil, err
+	}
+
+	err = ws.Write(ctx, websocket.MessageBinary, data)
+	if err != nil {
+		c.remove(call)
+		ws.CloseNow()
+		p.breaker.record(false)
+		return nil, unavailableError{fmt.Errorf("failed to write message to aali-llm: %v", err)}
+	}
+	go call.forward()
+	call.watch(ctx)
+	return call, nil
+}
+
+// SendWithResponseFormat sends a request like Send, asking aali-llm to answer in a response format
+// The response format is passed in the model options of the request.
+//
+// Parameters:
+//   - ctx: the context of the request, limiting the wait for a slot, the dial and the write, and ending the call once done
+//   - request: the request, an InstructionGuid is generated if empty
+//   - responseFormat: the response format, the one of the model options of the request is kept if nil
+//
+// Returns:
+//   - *Call: the call receiving the responses
+//   - error: an error if the request cannot be sent, ErrCircuitOpen while aali-llm is considered down
+func (p *Pool) SendWithResponseFormat(ctx context.Context, request sharedtypes.HandlerRequest, responseFormat map[string]interface{}) (*Call, error) {
+	if responseFormat != nil {
+		request.ModelOptions.ResponseFormat = responseFormat
+	}
+	return p.Send(ctx, request)
+}
+
+// RetryPolicy returns the retry policy of the requests
+//
+// Returns:
+//   - RetryPolicy: the retry policy, with defaults for unset options
+func (p *Pool) RetryPolicy() RetryPolicy {
+	return p.options.Retry
+}
+
+// Close closes all connections
+// The unfinished requests receive an error response.
+func (p *Pool) Close() {
+	p.once.Do(func() {
+		close(p.closed)
+		for _, c := range p.conns {
+			c.mutex.Lock()
+			ws := c.ws
+			c.mutex.Unlock()
+			if ws != nil {
+				ws.Close(websocket.StatusNormalClosure, "client pool closed")
+			}
+		}
+	})
+}
+
+// pick returns the connection with the fewest unfinished requests, starting the search after the last pick
+//
+// Returns:
+//   - *conn: the connection
+func (p *Pool) pick() *conn {
+	start := int(p.next.Add(1))
+	best := p.conns[start%len(p.conns)]
+	for i := 1; i < len(p.conns); i++ {
+		c := p.conns[(start+i)%len(p.conns)]
+		if len(c.slots) < len(best.slots) {
+			best = c
+		}
+	}
+	return best
+}
+
+// Responses returns the channel receiving the responses of the call
+//
+// Returns:
+//   - <-chan sharedtypes.HandlerResponse: the responses, closed after the last one
+func (call *Call) Responses() <-chan sharedtypes.HandlerResponse {
+	return call.responses
+}
+
+// Close abandons the call, its remaining responses are dropped
+// It must be called if the consumer stops reading before the last response.
+func (call *Call) Close() {
+	call.once.Do(func() {
+		close(call.done)
+		call.conn.remove(call)
+	})
+}
+
+// watch ends the call with an error response once the context of its request is done
+//
+// Parameters:
+//   - ctx: the context of the request
+func (call *Call) watch(ctx context.Context) {
+	stop := context.AfterFunc(ctx, func() { call.cancel(ctx.Err()) })
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	if call.finished {
+		stop()
+		return
+	}
+	call.stop = stop
+}
+
+// cancel ends an unfinished call, freeing its slot and replacing its pending responses by an error response
+//
+// Parameters:
+//   - err: the error of the context of the request
+func (call *Call) cancel(err error) {
+	if !call.conn.remove(call) {
+		// the call is finished or its connection was lost, which delivers the last response
+		return
+	}
+
+	call.mutex.Lock()
+	call.pending = []sharedtypes.HandlerResponse{errorResponse(call.guid, CodeCanceled, fmt.Sprintf("request to aali-llm cancelled: %v", err))}
+	call.finished = true
+	close(call.cancelled)
+	call.mutex.Unlock()
+	call.signal()
+}
+
+// push queues a response for the consumer without waiting for it
+// The response is dropped if the call is finished.
+//
+// Parameters:
+//   - response: the response
+//   - last: true if no more responses follow, the responses are closed once it is passed on
+func (call *Call) push(response sharedtypes.HandlerResponse, last bool) {
+	call.mutex.Lock()
+	if call.finished {
+		call.mutex.Unlock()
+		return
+	}
+	call.pending = append(call.pending, response)
+	if last {
+		call.finished = true
+		if call.stop != nil {
+			call.stop()
+		}
+	}
+	call.mutex.Unlock()
+	call.signal()
+}
+
+// signal wakes up forward to pass on the pending responses
+func (call *Call) signal() {
+	select {
+	case call.queued <- struct{}{}:
+	default:
+	}
+}
+
+// forward passes the pending responses to the consumer until the last one or until the call is abandoned
+// Once the call is cancelled, the responses not yet received by the consumer are dropped.
+func (call *Call) forward() {
+	cancelled := call.cancelled
+	for {
+		select {
+		case <-call.queued:
+		case <-call.done:
+			return
+		}
+		call.mutex.Lock()
+		pending, finished := call.pending, call.finished
+		call.pending = nil
+		select {
+		case <-cancelled:
+			// Only the error response is pending after the cancellation
+			cancelled = nil
+			call.drop()
+		default:
+		}
+		call.mutex.Unlock()
+
+	send:
+		for i, response := range pending {
+			select {
+			case call.responses <- response:
+			case <-call.done:
+				return
+			case <-cancelled:
+				// The error response replacing these responses is pending by now
+				cancelled = nil
+				call.drop()
+				break send
+			}
+			if finished && i == len(pending)-1 {
+				close(call.responses)
+				return
+			}
+		}
+	}
+}
+
+// drop drops the responses buffered for the consumer
+func (call *Call) drop() {
+	for {
+		select {
+		case <-call.responses:
+		default:
+			return
+		}
+	}
+}
+
+// Final reports whether a response is the last one of the call
+//
+// Parameters:
+//   - response: the response
+//
+// Returns:
+//   - bool: true if no more responses follow
+func (call *Call) Final(response sharedtypes.HandlerResponse) bool {
+	if response.Type != "chat" || !call.stream {
+		return true
+	}
+	return response.IsLast == nil || *response.IsLast
+}
+
+// errorResponse creates the error response sent to a call in place of the aali-llm responses
+//
+// Parameters:
+//   - guid: the InstructionGuid of the call
+//   - code: the error code
+//   - message: the error message
+//
+// Returns:
+//   - sharedtypes.HandlerResponse: the error response
+func errorResponse(guid string, code int, message string) sharedtypes.HandlerResponse {
+	return sharedtypes.HandlerResponse{
+		InstructionGuid: guid,
+		Type:            "error",
+		Error: &sharedtypes.ErrorResponse{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/llmmock"
+	"github.com/ansys/aali-flowkit/pkg/tracing"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"nhooyr.io/websocket"
+)
+
+// fakeLLM is an aali-llm server answering chat requests on multiplexed connections
+// The data of a request selects the answer: "hold" is never answered, "drop" closes the connection,
+// "freeze" stops reading the connection, so pings are not answered, "slow" is answered after the
+// requests received later, anything else is echoed.
+type fakeLLM struct {
+	connections atomic.Int32
+	mutex       sync.Mutex
+	apiKeys     []string
+	// traceparents holds the traceparent of each connection handshake and request, in order of arrival
+	traceparents []string
+}
+
+// serve starts the fake server and returns its WebSocket URL
+func (f *fakeLLM) serve(t *testing.T) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.CloseNow()
+		f.connections.Add(1)
+
+		ctx := context.Background()
+		_, apiKey, err := c.Read(ctx)
+		if err != nil {
+			return
+		}
+		f.mutex.Lock()
+		f.apiKeys = append(f.apiKeys, string(apiKey))
+		f.traceparents = append(f.traceparents, "dial "+r.Header.Get("traceparent"))
+		f.mutex.Unlock()
+		c.Write(ctx, websocket.MessageText, []byte("authentication successful"))
+
+		for {
+			_, data, err := c.Read(ctx)
+			if err != nil {
+				return
+			}
+			var request message
+			if json.Unmarshal(data, &request) != nil {
+				return
+			}
+			f.mutex.Lock()
+			f.traceparents = append(f.traceparents, request.TraceContext["traceparent"])
+			f.mutex.Unlock()
+			switch request.Data {
+			case "hold":
+			case "drop":
+				return
+			case "freeze":
+				time.Sleep(time.Second)
+				return
+			case "slow":
+				go func() {
+					time.Sleep(100 * time.Millisecond)
+					f.answer(ctx, c, request.HandlerRequest)
+				}()
+			default:
+				f.answer(ctx, c, request.HandlerRequest)
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// answer sends the info message and the chat responses of a request, one per word if streamed
+func (f *fakeLLM) answer(ctx context.Context, c *websocket.Conn, request sharedtypes.HandlerRequest) {
+	info := "working"
+	write(ctx, c, sharedtypes.HandlerResponse{InstructionGuid: request.InstructionGuid, Type: "info", InfoMessage: &info})
+
+	words := []string{request.Data.(string)}
+	if request.DataStream {
+		words = strings.Fields(request.Data.(string))
+	}
+	for i, word := range words {
+		isLast := i == len(words)-1
+		write(ctx, c, sharedtypes.HandlerResponse{InstructionGuid: request.InstructionGuid, Type: "chat", ChatData: &word, IsLast: &isLast})
+	}
+}
+
+// write sends a response to the client
+func write(ctx context.Context, c *websocket.Conn, response sharedtypes.HandlerResponse) {
+	data, _ := json.Marshal(response)
+	c.Write(ctx, websocket.MessageBinary, data)
+}
+
+// collect returns the chat data of all responses of a call
+func collect(t *testing.T, call *Call) []string {
+	var data []string
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case response, ok := <-call.Responses():
+			if !ok {
+				return data
+			}
+			if response.Type == "error" {
+				data = append(data, "error: "+response.Error.Message)
+			} else {
+				data = append(data, *response.ChatData)
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for responses")
+		}
+	}
+}
+
+func TestPoolMultiplexesRequests(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), APIKey: "key", Connections: 2})
+	defer pool.Close()
+	ctx := context.Background()
+
+	// the slow answer does not block the requests sent after it on the same connection
+	slow, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "slow"})
+	require.NoError(t, err)
+	calls := []*Call{}
+	for range 10 {
+		call, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "one two three", DataStream: true})
+		require.NoError(t, err)
+		calls = append(calls, call)
+	}
+	for _, call := range calls {
+		assert.Equal(t, []string{"one", "two", "three"}, collect(t, call))
+	}
+	assert.Equal(t, []string{"slow"}, collect(t, slow))
+
+	// requests without streaming are finished by the first response
+	call, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "one two"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one two"}, collect(t, call))
+
+	// the connections are reused and authenticated once
+	assert.EqualValues(t, 2, llm.connections.Load())
+	assert.Equal(t, []string{"key", "key"}, llm.apiKeys)
+}
+
+func TestPoolSendWithResponseFormat(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	withFormat := true
+	mock := llmmock.NewServer(&llmmock.Fixtures{Fixtures: []llmmock.Fixture{
+		{Match: llmmock.Match{ResponseFormat: &withFormat}, Chat: "formatted"},
+		{Chat: "plain"},
+	}})
+	server := httptest.NewServer(mock)
+	defer server.Close()
+	pool := NewPool(Options{Endpoint: "ws" + strings.TrimPrefix(server.URL, "http"), Connections: 1})
+	defer pool.Close()
+
+	// the response format is sent in the model options of the request, if any
+	call, err := pool.SendWithResponseFormat(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat"}, map[string]interface{}{"type": "json_object"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"formatted"}, collect(t, call))
+	call, err = pool.SendWithResponseFormat(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plain"}, collect(t, call))
+	assert.Equal(t, map[string]interface{}{"type": "json_object"}, mock.Requests()[0].ModelOptions.ResponseFormat)
+}
+
+func TestPoolPropagatesTraceContext(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	_, err := tracing.Setup(context.Background(), tracing.Options{})
+	require.NoError(t, err)
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), Connections: 1})
+	defer pool.Close()
+
+	// every request carries its own trace context, even on the shared connection
+	traceparents := []string{"dial 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+	for i, spanID := range []trace.SpanID{{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}, {0x53, 0x99, 0x5c, 0x3f, 0x42, 0xcd, 0x8a, 0xd8}} {
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithRemoteSpanContext(context.Background(), spanContext)
+		call, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: fmt.Sprintf("request %d", i)})
+		require.NoError(t, err)
+		collect(t, call)
+		traceparents = append(traceparents, fmt.Sprintf("00-4bf92f3577b34da6a3ce929d0e0e4736-%v-01", spanID))
+	}
+
+	// a request without trace context carries none
+	call, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "untraced"})
+	require.NoError(t, err)
+	collect(t, call)
+	llm.mutex.Lock()
+	defer llm.mutex.Unlock()
+	assert.Equal(t, append(traceparents, ""), llm.traceparents)
+}
+
+func TestPoolReconnects(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), Connections: 1})
+	defer pool.Close()
+	ctx := context.Background()
+
+	// the requests of a lost connection fail
+	held, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "hold"})
+	require.NoError(t, err)
+	dropped, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "drop"})
+	require.NoError(t, err)
+	for _, call := range []*Call{held, dropped} {
+		data := collect(t, call)
+		require.Len(t, data, 1)
+		assert.Contains(t, data[0], "error: connection to aali-llm lost")
+	}
+
+	// the next request opens a new connection
+	call, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "again"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"again"}, collect(t, call))
+	assert.EqualValues(t, 2, llm.connections.Load())
+}
+
+func TestPoolHeartbeat(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), Connections: 1, HeartbeatInterval: 20 * time.Millisecond, HeartbeatTimeout: 50 * time.Millisecond})
+	defer pool.Close()
+
+	// an unresponsive connection is dropped before the server gives up on it
+	start := time.Now()
+	call, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "freeze"})
+	require.NoError(t, err)
+	data := collect(t, call)
+	require.Len(t, data, 1)
+	assert.Contains(t, data[0], "error: connection to aali-llm lost")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestPoolSlowConsumer(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), Connections: 1, ResponseBuffer: 1, HeartbeatInterval: 20 * time.Millisecond, HeartbeatTimeout: 50 * time.Millisecond})
+	defer pool.Close()
+	ctx := context.Background()
+
+	// a consumer not reading its responses holds up neither the other requests nor the heartbeat
+	stalled, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "one two three four five", DataStream: true})
+	require.NoError(t, err)
+	for range 5 {
+		call, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "other"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other"}, collect(t, call))
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// the stalled consumer still receives all of its responses
+	assert.Equal(t, []string{"one", "two", "three", "four", "five"}, collect(t, stalled))
+	assert.EqualValues(t, 1, llm.connections.Load())
+}
+
+func TestPoolBackpressure(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), Connections: 1, MaxInFlight: 1})
+	defer pool.Close()
+
+	// a full connection makes the next request wait for a slot
+	held, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "hold"})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "waiting"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// abandoning the call frees its slot
+	held.Close()
+	call, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "free"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"free"}, collect(t, call))
+}
+
+func TestPoolCancel(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), Connections: 1, MaxInFlight: 1})
+	defer pool.Close()
+
+	// a cancelled request ends with an error response without waiting for aali-llm
+	ctx, cancel := context.WithCancel(context.Background())
+	held, err := pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "hold"})
+	require.NoError(t, err)
+	cancel()
+	data := collect(t, held)
+	require.Len(t, data, 1)
+	assert.Equal(t, "error: request to aali-llm cancelled: context canceled", data[0])
+
+	// the slot of the cancelled request is free
+	call, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "free"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"free"}, collect(t, call))
+
+	// a finished request is not affected by the end of its context
+	ctx, cancel = context.WithCancel(context.Background())
+	call, err = pool.Send(ctx, sharedtypes.HandlerRequest{Adapter: "chat", Data: "one two", DataStream: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, collect(t, call))
+	cancel()
+	call, err = pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "again"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"again"}, collect(t, call))
+}
+
+func TestPoolClose(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	llm := &fakeLLM{}
+	pool := NewPool(Options{Endpoint: llm.serve(t), Connections: 1})
+
+	held, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "hold"})
+	require.NoError(t, err)
+	pool.Close()
+
+	// unfinished requests fail and no new request is sent
+	data := collect(t, held)
+	require.Len(t, data, 1)
+	assert.Contains(t, data[0], "error: connection to aali-llm lost")
+	_, err = pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "late"})
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestPoolReconnectDelay(t *testing.T) {
+	logging.InitLogger(&config.Config{LOG_LEVEL: "error"})
+	server := httptest.NewServer(http.NotFoundHandler())
+	endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+	server.Close()
+	pool := NewPool(Options{Endpoint: endpoint, Connections: 1, ReconnectDelay: time.Hour})
+	defer pool.Close()
+
+	// a failed dial is not retried within the reconnect delay
+	_, err := pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "first"})
+	require.Error(t, err)
+	start := time.Now()
+	_, err = pool.Send(context.Background(), sharedtypes.HandlerRequest{Adapter: "chat", Data: "second"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to connect to aali-llm")
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+)
+
+// CodeUnavailable is the error code of the responses sent by the pool when a connection is lost
+const CodeUnavailable = 503
+
+// CodeCanceled is the error code of the responses sent by the pool when the context of a request is done
+const CodeCanceled = 499
+
+// RetryPolicy decides which failed requests to aali-llm are retried and when
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts of a request, including the first one
+	MaxAttempts int
+	// InitialBackoff is the wait before the second attempt, doubled for every further attempt
+	InitialBackoff time.Duration
+	// MaxBackoff limits the wait between two attempts
+	MaxBackoff time.Duration
+	// RetryableCodes are the error codes of aali-llm responses worth retrying; lost connections are always retried
+	RetryableCodes []int
+}
+
+// unavailableError is a failure to reach aali-llm, worth retrying
+type unavailableError struct {
+	err error
+}
+
+// Error returns the message of the wrapped error
+func (e unavailableError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e unavailableError) Unwrap() error {
+	return e.err
+}
+
+// IsUnavailable reports whether an error of Send is a failure to reach aali-llm, worth retrying
+//
+// Parameters:
+//   - err: the error returned by Send
+//
+// Returns:
+//   - bool: true if the connection could not be opened or the request could not be written
+func IsUnavailable(err error) bool {
+	return errors.As(err, &unavailableError{})
+}
+
+// Retryable reports whether an error response is worth retrying
+//
+// Parameters:
+//   - response: the response of aali-llm or of the pool
+//
+// Returns:
+//   - bool: true if the response is an error with a retryable code
+func (r RetryPolicy) Retryable(response sharedtypes.HandlerResponse) bool {
+	if response.Type != "error" || response.Error == nil {
+		return false
+	}
+	return response.Error.Code == CodeUnavailable || slices.Contains(r.RetryableCodes, response.Error.Code)
+}
+
+// Backoff returns the wait before the next attempt, with jitter so the retries of concurrent requests spread out
+//
+// Parameters:
+//   - attempt: the number of the failed attempt, starting at 1
+//
+// Returns:
+//   - time.Duration: a random wait between half and all of the exponential backoff
+func (r RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := r.MaxBackoff
+	if attempt < 32 && r.InitialBackoff<<(attempt-1) < r.MaxBackoff {
+		backoff = r.InitialBackoff << (attempt - 1)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + rand.N(backoff/2+1)
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	// the backoff doubles with every attempt, with jitter of up to half of it
+	for attempt, limit := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 3: 400 * time.Millisecond, 5: time.Second, 100: time.Second} {
+		for range 20 {
+			backoff := policy.Backoff(attempt)
+			assert.GreaterOrEqual(t, backoff, limit/2, attempt)
+			assert.LessOrEqual(t, backoff, limit, attempt)
+		}
+	}
+}
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	policy := RetryPolicy{RetryableCodes: []int{429}}
+	errorWithCode := func(code int) sharedtypes.HandlerResponse {
+		return errorResponse("guid", code, "failed")
+	}
+
+	assert.True(t, policy.Retryable(errorWithCode(429)))
+	assert.True(t, policy.Retryable(errorWithCode(CodeUnavailable)))
+	assert.False(t, policy.Retryable(errorWithCode(400)))
+	assert.False(t, policy.Retryable(sharedtypes.HandlerResponse{Type: "chat"}))
+
+	assert.True(t, IsUnavailable(unavailableError{errors.New("failed to connect")}))
+	assert.False(t, IsUnavailable(context.Canceled))
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmmock
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"gopkg.in/yaml.v2"
+)
+
+// Fixtures scripts the replies of a mock aali-llm server
+type Fixtures struct {
+	// APIKey is the key expected as first message of a connection, any key is accepted if empty
+	APIKey string `yaml:"apiKey"`
+	// Fixtures are matched in order against every request; the first matching fixture that is not used up replies
+	Fixtures []Fixture `yaml:"fixtures"`
+}
+
+// Fixture is the scripted reply to the requests it matches
+type Fixture struct {
+	// Match selects the requests of the fixture, all requests if empty
+	Match Match `yaml:"match"`
+	// Times is the number of requests the fixture replies to before it is used up, unlimited if 0
+	Times int `yaml:"times"`
+	// Delay is the time waited before the first reply
+	Delay time.Duration `yaml:"delay"`
+	// Info are the info messages sent before the reply
+	Info []string `yaml:"info"`
+	// Error replies with an error response instead of data
+	Error *Error `yaml:"error"`
+	// Chat is the answer to chat requests
+	Chat string `yaml:"chat"`
+	// Echo answers chat requests with their data instead of Chat
+	Echo bool `yaml:"echo"`
+	// ChunkSize is the number of characters per streamed chunk, one word per chunk if 0
+	ChunkSize int `yaml:"chunkSize"`
+	// InputTokenCount is reported with the last chunk of the answer
+	InputTokenCount *int `yaml:"inputTokenCount"`
+	// OutputTokenCount is reported with the last chunk of the answer
+	OutputTokenCount *int `yaml:"outputTokenCount"`
+	// Dimensions is the length of the generated embeddings, 8 if 0
+	Dimensions int `yaml:"dimensions"`
+}
+
+// Match selects requests by their fields; empty fields match every request
+type Match struct {
+	// Adapter is the adapter of the request, e.g. "chat" or "embeddings"
+	Adapter string `yaml:"adapter"`
+	// ChatRequestType is the chat request type, e.g. "general", "code", "summary" or "keywords"
+	ChatRequestType string `yaml:"chatRequestType"`
+	// ModelId must be one of the model IDs of the request
+	ModelId string `yaml:"modelId"`
+	// Contains must be part of the data of the request
+	Contains string `yaml:"contains"`
+	// ResponseFormat selects the requests with (true) or without (false) a response format
+	ResponseFormat *bool `yaml:"responseFormat"`
+}
+
+// Error is a scripted error response
+type Error struct {
+	Code    int    `yaml:"code"`
+	Message string `yaml:"message"`
+}
+
+// LoadFixtures reads the fixtures of a YAML file
+//
+// Parameters:
+//   - path: the path of the file
+//
+// Returns:
+//   - *Fixtures: the fixtures
+//   - error: an error if the file cannot be read or a fixture is invalid
+func LoadFixtures(path string) (*Fixtures, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mock LLM fixtures: %v", err)
+	}
+
+	fixtures := &Fixtures{}
+	err = yaml.UnmarshalStrict(content, fixtures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mock LLM fixtures: %v", err)
+	}
+	for i, fixture := range fixtures.Fixtures {
+		if fixture.Times < 0 || fixture.Delay < 0 || fixture.ChunkSize < 0 || fixture.Dimensions < 0 {
+			return nil, fmt.Errorf("fixture %d has a negative times, delay, chunkSize or dimensions", i)
+		}
+		if fixture.Echo && fixture.Chat != "" {
+			return nil, fmt.Errorf("fixture %d sets both chat and echo", i)
+		}
+	}
+	return fixtures, nil
+}
+
+// matches reports whether the fixture replies to a request
+//
+// Parameters:
+//   - request: the request
+//
+// Returns:
+//   - bool: true if all fields of the match agree with the request
+func (m Match) matches(request sharedtypes.HandlerRequest) bool {
+	if m.ResponseFormat != nil && *m.ResponseFormat != (request.ModelOptions.ResponseFormat != nil) {
+		return false
+	}
+	if m.Adapter != "" && m.Adapter != request.Adapter {
+		return false
+	}
+	if m.ChatRequestType != "" && m.ChatRequestType != request.ChatRequestType {
+		return false
+	}
+	if m.ModelId != "" && !contains(request.ModelIds, m.ModelId) {
+		return false
+	}
+	if m.Contains != "" && !strings.Contains(strings.Join(texts(request.Data), "\n"), m.Contains) {
+		return false
+	}
+	return true
+}
+
+// contains reports whether a list holds a value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// texts returns the texts of the data of a request, which is a string or a list of strings
+func texts(data interface{}) []string {
+	switch data := data.(type) {
+	case string:
+		return []string{data}
+	case []interface{}:
+		texts := make([]string, 0, len(data))
+		for _, element := range data {
+			texts = append(texts, fmt.Sprint(element))
+		}
+		return texts
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmmock
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFixtures writes a fixtures file and returns its path
+func writeFixtures(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "fixtures.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadFixtures(t *testing.T) {
+	fixtures, err := LoadFixtures(writeFixtures(t, `
+apiKey: secret
+fixtures:
+  - match:
+      adapter: chat
+      modelId: gpt-4o
+    times: 2
+    delay: 50ms
+    chat: Hello there
+    outputTokenCount: 2
+  - match:
+      adapter: embeddings
+    dimensions: 4
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "secret", fixtures.APIKey)
+	require.Len(t, fixtures.Fixtures, 2)
+	assert.Equal(t, Match{Adapter: "chat", ModelId: "gpt-4o"}, fixtures.Fixtures[0].Match)
+	assert.Equal(t, 50*time.Millisecond, fixtures.Fixtures[0].Delay)
+	assert.Equal(t, 2, *fixtures.Fixtures[0].OutputTokenCount)
+	assert.Equal(t, 4, fixtures.Fixtures[1].Dimensions)
+}
+
+func TestLoadFixturesInvalid(t *testing.T) {
+	files := map[string]string{
+		"unknown field":  "fixtures:\n  - answer: hello\n",
+		"negative times": "fixtures:\n  - times: -1\n",
+		"chat and echo":  "fixtures:\n  - chat: hello\n    echo: true\n",
+	}
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadFixtures(writeFixtures(t, content))
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := LoadFixtures(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestMatch(t *testing.T) {
+	request := sharedtypes.HandlerRequest{Adapter: "chat", ChatRequestType: "general", ModelIds: []string{"gpt-4o"}, Data: "What is a mesh?"}
+
+	assert.True(t, Match{}.matches(request))
+	assert.True(t, Match{Adapter: "chat", ChatRequestType: "general", ModelId: "gpt-4o", Contains: "mesh"}.matches(request))
+	assert.False(t, Match{Adapter: "embeddings"}.matches(request))
+	assert.False(t, Match{ChatRequestType: "code"}.matches(request))
+	assert.False(t, Match{ModelId: "llama"}.matches(request))
+	assert.False(t, Match{Contains: "solver"}.matches(request))
+
+	// the texts of batch requests are searched as well
+	request.Data = []interface{}{"first text", "second text"}
+	assert.True(t, Match{Contains: "second"}.matches(request))
+
+	// requests are told apart by their response format
+	with, without := true, false
+	assert.False(t, Match{ResponseFormat: &with}.matches(request))
+	assert.True(t, Match{ResponseFormat: &without}.matches(request))
+	request.ModelOptions.ResponseFormat = map[string]interface{}{"type": "json_object"}
+	assert.True(t, Match{ResponseFormat: &with}.matches(request))
+}
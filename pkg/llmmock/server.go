@@ -0,0 +1,382 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package llmmock is a fake aali-llm server for offline development and tests.
+// It speaks the WebSocket protocol of aali-llm and replies to requests as scripted by fixtures.
+package llmmock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"nhooyr.io/websocket"
+)
+
+// CodeNoFixture is the error code of the replies to requests no fixture matches
+const CodeNoFixture = 404
+
+// defaultDimensions is the length of the generated embeddings of fixtures without dimensions
+const defaultDimensions = 8
+
+// vocabularySize bounds the token IDs of the generated sparse embeddings
+const vocabularySize = 250002
+
+// Server is a mock aali-llm server; it serves WebSocket connections as http.Handler
+type Server struct {
+	fixtures *Fixtures
+	mutex    sync.Mutex
+	used     []int
+	requests []sharedtypes.HandlerRequest
+	listener net.Listener
+	server   *http.Server
+}
+
+// NewServer creates a mock aali-llm server replying with the given fixtures
+//
+// Parameters:
+//   - fixtures: the fixtures
+//
+// Returns:
+//   - *Server: the server
+func NewServer(fixtures *Fixtures) *Server {
+	return &Server{
+		fixtures: fixtures,
+		used:     make([]int, len(fixtures.Fixtures)),
+	}
+}
+
+// Start listens on an address and serves the connections in the background until Close is called
+//
+// Parameters:
+//   - address: the TCP address, e.g. "localhost:0" for a free port
+//
+// Returns:
+//   - string: the WebSocket URL of the server
+//   - error: an error if the address cannot be listened on
+func (s *Server) Start(address string) (string, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return "", fmt.Errorf("failed to listen on %v: %v", address, err)
+	}
+	s.listener = listener
+	s.server = &http.Server{Handler: s, ReadHeaderTimeout: 10 * time.Second}
+	go s.server.Serve(listener)
+	return "ws://" + listener.Addr().String(), nil
+}
+
+// Close stops a server started by Start
+//
+// Returns:
+//   - error: an error if the listener cannot be closed
+func (s *Server) Close() error {
+	if s.server == nil {
+		return nil
+	}
+	return s.server.Close()
+}
+
+// Requests returns the requests received so far
+//
+// Returns:
+//   - []sharedtypes.HandlerRequest: the requests in the order they were received
+func (s *Server) Requests() []sharedtypes.HandlerRequest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]sharedtypes.HandlerRequest{}, s.requests...)
+}
+
+// ServeHTTP accepts a WebSocket connection, authenticates it and replies to its requests
+// Requests are answered concurrently, so delayed replies do not hold up the other requests of the connection.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer c.CloseNow()
+	c.SetReadLimit(-1)
+
+	// The replies still waiting for their delay are canceled once the connection is lost
+	var replies sync.WaitGroup
+	defer replies.Wait()
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// The first message of a connection is its API key
+	_, apiKey, err := c.Read(ctx)
+	if err != nil {
+		return
+	}
+	if s.fixtures.APIKey != "" && string(apiKey) != s.fixtures.APIKey {
+		c.Close(websocket.StatusPolicyViolation, "authentication failed")
+		return
+	}
+	err = c.Write(ctx, websocket.MessageText, []byte("authentication successful"))
+	if err != nil {
+		return
+	}
+
+	for {
+		_, message, err := c.Read(ctx)
+		if err != nil {
+			return
+		}
+		var request sharedtypes.HandlerRequest
+		err = json.Unmarshal(message, &request)
+		if err != nil {
+			continue
+		}
+
+		replies.Add(1)
+		go func() {
+			defer replies.Done()
+			for _, response := range s.reply(ctx, request) {
+				data, err := json.Marshal(response)
+				if err != nil || c.Write(ctx, websocket.MessageBinary, data) != nil {
+					return
+				}
+			}
+		}()
+	}
+}
+
+// reply records a request and returns its responses, once the delay of its fixture is over
+//
+// Parameters:
+//   - ctx: the context of the connection
+//   - request: the request
+//
+// Returns:
+//   - []sharedtypes.HandlerResponse: the responses
+func (s *Server) reply(ctx context.Context, request sharedtypes.HandlerRequest) []sharedtypes.HandlerResponse {
+	fixture := s.match(request)
+	if fixture == nil {
+		return []sharedtypes.HandlerResponse{errorResponse(request, &Error{Code: CodeNoFixture, Message: fmt.Sprintf("no fixture matches the %v request", request.Adapter)})}
+	}
+
+	if fixture.Delay > 0 {
+		select {
+		case <-time.After(fixture.Delay):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	responses := []sharedtypes.HandlerResponse{}
+	for i := range fixture.Info {
+		responses = append(responses, sharedtypes.HandlerResponse{InstructionGuid: request.InstructionGuid, Type: "info", InfoMessage: &fixture.Info[i]})
+	}
+	switch {
+	case fixture.Error != nil:
+		responses = append(responses, errorResponse(request, fixture.Error))
+	case request.Adapter == "embeddings":
+		responses = append(responses, embeddingsResponse(request, fixture))
+	case request.Adapter == "chat":
+		responses = append(responses, chatResponses(request, fixture)...)
+	default:
+		responses = append(responses, errorResponse(request, &Error{Code: 400, Message: fmt.Sprintf("unknown adapter %q", request.Adapter)}))
+	}
+	return responses
+}
+
+// match records a request and returns the first matching fixture that is not used up
+//
+// Parameters:
+//   - request: the request
+//
+// Returns:
+//   - *Fixture: the fixture, nil if none matches
+func (s *Server) match(request sharedtypes.HandlerRequest) *Fixture {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, request)
+	for i := range s.fixtures.Fixtures {
+		fixture := &s.fixtures.Fixtures[i]
+		if !fixture.Match.matches(request) || (fixture.Times > 0 && s.used[i] >= fixture.Times) {
+			continue
+		}
+		s.used[i]++
+		return fixture
+	}
+	return nil
+}
+
+// errorResponse returns an error response to a request
+func errorResponse(request sharedtypes.HandlerRequest, scripted *Error) sharedtypes.HandlerResponse {
+	return sharedtypes.HandlerResponse{
+		InstructionGuid: request.InstructionGuid,
+		Type:            "error",
+		Error:           &sharedtypes.ErrorResponse{Code: scripted.Code, Message: scripted.Message},
+	}
+}
+
+// chatResponses returns the answer to a chat request, in chunks if the request streams its data
+//
+// Parameters:
+//   - request: the request
+//   - fixture: the fixture replying to the request
+//
+// Returns:
+//   - []sharedtypes.HandlerResponse: the chunks, the last one with IsLast set
+func chatResponses(request sharedtypes.HandlerRequest, fixture *Fixture) []sharedtypes.HandlerResponse {
+	answer := fixture.Chat
+	if fixture.Echo {
+		answer = strings.Join(texts(request.Data), "\n")
+	}
+
+	chunks := []string{answer}
+	if request.DataStream {
+		chunks = split(answer, fixture.ChunkSize)
+	}
+
+	responses := make([]sharedtypes.HandlerResponse, len(chunks))
+	for i := range chunks {
+		position := uint32(i)
+		isLast := i == len(chunks)-1
+		responses[i] = sharedtypes.HandlerResponse{
+			InstructionGuid: request.InstructionGuid,
+			Type:            "chat",
+			ChatData:        &chunks[i],
+			IsLast:          &isLast,
+			Position:        &position,
+		}
+		if isLast {
+			responses[i].InputTokenCount = fixture.InputTokenCount
+			responses[i].OutputTokenCount = fixture.OutputTokenCount
+		}
+	}
+	return responses
+}
+
+// split cuts an answer into chunks which concatenate to the answer
+//
+// Parameters:
+//   - answer: the answer
+//   - size: the number of characters per chunk, one word with its trailing spaces per chunk if 0
+//
+// Returns:
+//   - []string: the chunks, at least one
+func split(answer string, size int) []string {
+	chunks := []string{}
+	for answer != "" {
+		end := 0
+		if size > 0 {
+			for i := 0; i < size && end < len(answer); i++ {
+				_, width := utf8.DecodeRuneInString(answer[end:])
+				end += width
+			}
+		} else {
+			end = len(answer)
+			word := strings.IndexAny(answer, " \n\t")
+			if word >= 0 {
+				rest := strings.TrimLeft(answer[word:], " \n\t")
+				end = len(answer) - len(rest)
+			}
+		}
+		chunks = append(chunks, answer[:end])
+		answer = answer[end:]
+	}
+	if len(chunks) == 0 {
+		chunks = append(chunks, "")
+	}
+	return chunks
+}
+
+// embeddingsResponse returns the generated embeddings of the data of a request
+// The embeddings only depend on the text, so equal texts have equal embeddings.
+// A single text is answered with one vector, a list of texts with a list of vectors.
+//
+// Parameters:
+//   - request: the request
+//   - fixture: the fixture replying to the request
+//
+// Returns:
+//   - sharedtypes.HandlerResponse: the response with the dense and, if requested, the sparse embeddings
+func embeddingsResponse(request sharedtypes.HandlerRequest, fixture *Fixture) sharedtypes.HandlerResponse {
+	dimensions := fixture.Dimensions
+	if dimensions == 0 {
+		dimensions = defaultDimensions
+	}
+	sparse := request.EmbeddingOptions.ReturnSparse != nil && *request.EmbeddingOptions.ReturnSparse
+
+	response := sharedtypes.HandlerResponse{InstructionGuid: request.InstructionGuid, Type: "embeddings"}
+	text, single := request.Data.(string)
+	if single {
+		response.EmbeddedData = denseEmbedding(text, dimensions)
+		if sparse {
+			response.LexicalWeights = sparseEmbedding(text)
+		}
+		return response
+	}
+
+	dense := [][]float32{}
+	lexicalWeights := []map[string]float32{}
+	for _, text := range texts(request.Data) {
+		dense = append(dense, denseEmbedding(text, dimensions))
+		lexicalWeights = append(lexicalWeights, sparseEmbedding(text))
+	}
+	response.EmbeddedData = dense
+	if sparse {
+		response.LexicalWeights = lexicalWeights
+	}
+	return response
+}
+
+// denseEmbedding returns a unit vector derived from the hash of a text
+func denseEmbedding(text string, dimensions int) []float32 {
+	embedding := make([]float32, dimensions)
+	norm := 0.0
+	for i := range embedding {
+		hash := fnv.New32a()
+		fmt.Fprintf(hash, "%d:%s", i, text)
+		value := float64(hash.Sum32())/math.MaxUint32*2 - 1
+		embedding[i] = float32(value)
+		norm += value * value
+	}
+	if norm > 0 {
+		for i := range embedding {
+			embedding[i] /= float32(math.Sqrt(norm))
+		}
+	}
+	return embedding
+}
+
+// sparseEmbedding returns the lexical weights of the words of a text, keyed by the hash of the word
+func sparseEmbedding(text string) map[string]float32 {
+	weights := map[string]float32{}
+	words := strings.Fields(strings.ToLower(text))
+	for _, word := range words {
+		hash := fnv.New32a()
+		hash.Write([]byte(word))
+		weights[fmt.Sprint(hash.Sum32()%vocabularySize)] += 1 / float32(len(words))
+	}
+	return weights
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmmock
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
+)
+
+// dial connects to a mock server and authenticates with an API key
+func dial(t *testing.T, server *Server, apiKey string) *websocket.Conn {
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	c, _, err := websocket.Dial(context.Background(), "ws"+strings.TrimPrefix(httpServer.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.CloseNow() })
+	require.NoError(t, c.Write(context.Background(), websocket.MessageText, []byte(apiKey)))
+	return c
+}
+
+// send writes a request to a connection
+func send(t *testing.T, c *websocket.Conn, request sharedtypes.HandlerRequest) {
+	data, err := json.Marshal(request)
+	require.NoError(t, err)
+	require.NoError(t, c.Write(context.Background(), websocket.MessageBinary, data))
+}
+
+// receive reads the next response of a connection
+func receive(t *testing.T, c *websocket.Conn) sharedtypes.HandlerResponse {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, message, err := c.Read(ctx)
+	require.NoError(t, err)
+	var response sharedtypes.HandlerResponse
+	require.NoError(t, json.Unmarshal(message, &response))
+	return response
+}
+
+// authenticated reads the authentication message of a connection
+func authenticated(t *testing.T, c *websocket.Conn) {
+	_, message, err := c.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "authentication successful", string(message))
+}
+
+func TestServerAuthentication(t *testing.T) {
+	server := NewServer(&Fixtures{APIKey: "secret"})
+	authenticated(t, dial(t, server, "secret"))
+
+	c := dial(t, server, "wrong")
+	_, _, err := c.Read(context.Background())
+	assert.Equal(t, websocket.StatusPolicyViolation, websocket.CloseStatus(err))
+}
+
+func TestServerChat(t *testing.T) {
+	outputTokenCount := 3
+	server := NewServer(&Fixtures{Fixtures: []Fixture{
+		{Match: Match{ModelId: "broken"}, Times: 1, Error: &Error{Code: 503, Message: "overloaded"}},
+		{Match: Match{Adapter: "chat"}, Info: []string{"thinking"}, Chat: "Hello  there, world", OutputTokenCount: &outputTokenCount},
+	}})
+	c := dial(t, server, "")
+	authenticated(t, c)
+
+	// streamed answers are split into words and preceded by the info messages
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "chat", InstructionGuid: "1", ChatRequestType: "general", DataStream: true})
+	info := receive(t, c)
+	assert.Equal(t, "info", info.Type)
+	assert.Equal(t, "thinking", *info.InfoMessage)
+	chunks := []string{}
+	for {
+		response := receive(t, c)
+		require.Equal(t, "chat", response.Type)
+		assert.Equal(t, "1", response.InstructionGuid)
+		assert.EqualValues(t, len(chunks), *response.Position)
+		chunks = append(chunks, *response.ChatData)
+		if *response.IsLast {
+			assert.Equal(t, 3, *response.OutputTokenCount)
+			break
+		}
+		assert.Nil(t, response.OutputTokenCount)
+	}
+	assert.Equal(t, []string{"Hello  ", "there, ", "world"}, chunks)
+
+	// answers which are not streamed come in one piece
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "chat", InstructionGuid: "2", ChatRequestType: "general"})
+	receive(t, c)
+	response := receive(t, c)
+	assert.Equal(t, "Hello  there, world", *response.ChatData)
+	assert.True(t, *response.IsLast)
+
+	// the error fixture is used up after its first request
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "chat", InstructionGuid: "3", ModelIds: []string{"broken"}})
+	response = receive(t, c)
+	assert.Equal(t, "error", response.Type)
+	assert.Equal(t, 503, response.Error.Code)
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "chat", InstructionGuid: "4", ModelIds: []string{"broken"}})
+	assert.Equal(t, "info", receive(t, c).Type)
+
+	assert.Len(t, server.Requests(), 4)
+}
+
+func TestServerNoFixture(t *testing.T) {
+	c := dial(t, NewServer(&Fixtures{}), "")
+	authenticated(t, c)
+
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "chat", InstructionGuid: "1"})
+	response := receive(t, c)
+	assert.Equal(t, "error", response.Type)
+	assert.Equal(t, CodeNoFixture, response.Error.Code)
+}
+
+func TestServerDelay(t *testing.T) {
+	server := NewServer(&Fixtures{Fixtures: []Fixture{
+		{Match: Match{Contains: "slow"}, Delay: time.Hour, Echo: true},
+		{Echo: true},
+	}})
+	c := dial(t, server, "")
+	authenticated(t, c)
+
+	// delayed replies do not hold up the other requests of the connection
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "chat", InstructionGuid: "1", Data: "slow"})
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "chat", InstructionGuid: "2", Data: "fast"})
+	response := receive(t, c)
+	assert.Equal(t, "2", response.InstructionGuid)
+	assert.Equal(t, "fast", *response.ChatData)
+}
+
+func TestServerEmbeddings(t *testing.T) {
+	c := dial(t, NewServer(&Fixtures{Fixtures: []Fixture{{Match: Match{Adapter: "embeddings"}, Dimensions: 4}}}), "")
+	authenticated(t, c)
+
+	// single texts are answered with one vector
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "embeddings", InstructionGuid: "1", Data: "mesh"})
+	response := receive(t, c)
+	assert.Equal(t, "embeddings", response.Type)
+	assert.Len(t, response.EmbeddedData, 4)
+	assert.Nil(t, response.LexicalWeights)
+
+	// lists of texts are answered with lists of vectors and their lexical weights
+	sparse := true
+	send(t, c, sharedtypes.HandlerRequest{Adapter: "embeddings", InstructionGuid: "2", Data: []string{"mesh", "Mesh mesh solver"}, EmbeddingOptions: sharedtypes.EmbeddingOptions{ReturnSparse: &sparse}})
+	response = receive(t, c)
+	vectors := response.EmbeddedData.([]interface{})
+	require.Len(t, vectors, 2)
+	first := vectors[0].([]interface{})
+	assert.Len(t, first, 4)
+	lexicalWeights := response.LexicalWeights.([]interface{})
+	require.Len(t, lexicalWeights, 2)
+	assert.Len(t, lexicalWeights[1], 2)
+}
+
+func TestDenseEmbedding(t *testing.T) {
+	embedding := denseEmbedding("mesh", 16)
+	assert.Equal(t, embedding, denseEmbedding("mesh", 16))
+	assert.NotEqual(t, embedding, denseEmbedding("solver", 16))
+
+	norm := float32(0)
+	for _, value := range embedding {
+		norm += value * value
+	}
+	assert.InDelta(t, 1, norm, 1e-5)
+}
+
+func TestSplit(t *testing.T) {
+	assert.Equal(t, []string{""}, split("", 0))
+	assert.Equal(t, []string{"one ", "two\n", "three"}, split("one two\nthree", 0))
+	assert.Equal(t, []string{"äbc", "de"}, split("äbcde", 3))
+}
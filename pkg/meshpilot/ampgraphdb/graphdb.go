@@ -23,10 +23,14 @@
 package ampgraphdb
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/ansys/aali-flowkit/pkg/metrics"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/aali_graphdb"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 )
@@ -51,7 +55,7 @@ func EstablishConnection(uri string, db_name string) (funcError error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic Initialize: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic Initialize: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -75,7 +79,7 @@ func EstablishConnection(uri string, db_name string) (funcError error) {
 	// create client
 	client, err := aali_graphdb.DefaultClient(addr)
 	if err != nil {
-		logging.Log.Errorf(logCtx, "Error creating graphdb client: %v", err)
+		redact.Log.Errorf(logCtx, "Error creating graphdb client: %v", err)
 		return err
 	}
 
@@ -87,19 +91,19 @@ func EstablishConnection(uri string, db_name string) (funcError error) {
 	// Check if DB connection is successfull
 	_, err = client.GetHealth()
 	if err != nil {
-		logging.Log.Errorf(logCtx, "Error during healthcheck: %v", err)
+		redact.Log.Errorf(logCtx, "Error during healthcheck: %v", err)
 		return err
 	}
 
 	// if the database does not exist, create it
 	dbs, err := client.GetDatabases()
 	if err != nil {
-		logging.Log.Errorf(logCtx, "unable to get existing databases")
+		redact.Log.Errorf(logCtx, "unable to get existing databases")
 		return err
 	}
 
 	if slices.Contains(dbs, GraphDbDriver.dbname) {
-		logging.Log.Debugf(logCtx, "database %q exists in the graphdb", GraphDbDriver.dbname)
+		redact.Log.Debugf(logCtx, "database %q exists in the graphdb", GraphDbDriver.dbname)
 	} else {
 		return fmt.Errorf("database %q does not exists in the graphdb", GraphDbDriver.dbname)
 	}
@@ -108,11 +112,12 @@ func EstablishConnection(uri string, db_name string) (funcError error) {
 }
 
 func (graphdb_context *graphDbContext) GetProperties(description string, query string) (properties []string, funcError error) {
+	defer observeQuery("GetProperties", time.Now(), &funcError)
 
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -124,12 +129,12 @@ func (graphdb_context *graphDbContext) GetProperties(description string, query s
 
 	result, err := aali_graphdb.CypherQueryReadGeneric[map[string][]string](graphdb_context.client, graphdb_context.dbname, query, params)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return nil, err
 	}
 
 	if len(result) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "No properties found for description: %s", description)
+		redact.Log.Debugf(&logging.ContextMap{}, "No properties found for description: %s", description)
 		return []string{}, nil
 	}
 
@@ -137,7 +142,7 @@ func (graphdb_context *graphDbContext) GetProperties(description string, query s
 	if propertiesList, ok := result[0]["properties"]; ok {
 		properties = propertiesList
 	} else {
-		logging.Log.Debugf(&logging.ContextMap{}, "No properties found in the result for description: %s", description)
+		redact.Log.Debugf(&logging.ContextMap{}, "No properties found in the result for description: %s", description)
 		return []string{}, nil
 	}
 
@@ -145,10 +150,11 @@ func (graphdb_context *graphDbContext) GetProperties(description string, query s
 }
 
 func (graphdb_context *graphDbContext) GetSummaries(description string, query string) (summaries string, funcError error) {
+	defer observeQuery("GetSummaries", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -160,12 +166,12 @@ func (graphdb_context *graphDbContext) GetSummaries(description string, query st
 
 	result, err := aali_graphdb.CypherQueryReadGeneric[map[string]interface{}](GraphDbDriver.client, GraphDbDriver.dbname, query, params)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return "", err
 	}
 
 	if len(result) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "No summaries found for description: %s", description)
+		redact.Log.Debugf(&logging.ContextMap{}, "No summaries found for description: %s", description)
 		return "", nil
 	}
 
@@ -173,7 +179,7 @@ func (graphdb_context *graphDbContext) GetSummaries(description string, query st
 	if stateNode, ok := result[0]["stateNode"]; ok {
 		summaries = stateNode.(map[string]interface{})["Summary"].(string)
 	} else {
-		logging.Log.Debugf(&logging.ContextMap{}, "No summaries found in the result for description: %s", description)
+		redact.Log.Debugf(&logging.ContextMap{}, "No summaries found in the result for description: %s", description)
 		return "", nil
 	}
 
@@ -181,10 +187,11 @@ func (graphdb_context *graphDbContext) GetSummaries(description string, query st
 }
 
 func (graphdb_context *graphDbContext) GetActions(description string, query string) (actions []map[string]string, funcError error) {
+	defer observeQuery("GetActions", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -197,12 +204,12 @@ func (graphdb_context *graphDbContext) GetActions(description string, query stri
 	// Execute the query and retrieve the result
 	result, err := aali_graphdb.CypherQueryReadGeneric[map[string]interface{}](graphdb_context.client, graphdb_context.dbname, query, params)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return nil, err
 	}
 
 	if len(result) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "No actions found for description: %s", description)
+		redact.Log.Debugf(&logging.ContextMap{}, "No actions found for description: %s", description)
 		return []map[string]string{}, nil
 	}
 
@@ -210,19 +217,19 @@ func (graphdb_context *graphDbContext) GetActions(description string, query stri
 	for _, item := range result {
 		actionNodeRaw, ok := item["actionNode"]
 		if !ok {
-			logging.Log.Debugf(&logging.ContextMap{}, "No actionNode found in the result for description: %s", description)
+			redact.Log.Debugf(&logging.ContextMap{}, "No actionNode found in the result for description: %s", description)
 			continue
 		}
 
 		// Ensure actionNode is a map
 		actionNode, ok := actionNodeRaw.(map[string]interface{})
 		if !ok {
-			logging.Log.Errorf(&logging.ContextMap{}, "Unexpected type for actionNode: %T", actionNodeRaw)
+			redact.Log.Errorf(&logging.ContextMap{}, "Unexpected type for actionNode: %T", actionNodeRaw)
 			continue
 		}
 
 		if "ACTION" != actionNode["node_type"] {
-			logging.Log.Debugf(&logging.ContextMap{}, "Node label ACTION does not match action node type %s for description: %s", actionNode["node_type"], description)
+			redact.Log.Debugf(&logging.ContextMap{}, "Node label ACTION does not match action node type %s for description: %s", actionNode["node_type"], description)
 			continue
 		}
 
@@ -232,7 +239,7 @@ func (graphdb_context *graphDbContext) GetActions(description string, query stri
 				continue
 			}
 			if key == "ActApi" && value == "" {
-				logging.Log.Infof(&logging.ContextMap{}, "ActApi is None or empty for action node: %v", actionNode)
+				redact.Log.Infof(&logging.ContextMap{}, "ActApi is None or empty for action node: %v", actionNode)
 				continue
 			}
 			if strValue, ok := value.(string); ok && strValue != "" {
@@ -246,42 +253,43 @@ func (graphdb_context *graphDbContext) GetActions(description string, query stri
 	}
 
 	if len(actions) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "No actions found in the result for description: %s", description)
+		redact.Log.Debugf(&logging.ContextMap{}, "No actions found in the result for description: %s", description)
 		return []map[string]string{}, nil
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Actions found for description: %s, actions: %v", description, actions)
+	redact.Log.Debugf(&logging.ContextMap{}, "Actions found for description: %s, actions: %v", description, actions)
 	return actions, nil
 }
 
 func (graphdb_context *graphDbContext) GetSolutions(fmFailureCode, primeMeshFailureCode, query string) (solutions []string, funcError error) {
+	defer observeQuery("GetSolutions", time.Now(), &funcError)
 
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic get properties from graphdb: %v", r)
 			funcError = r.(error)
 			return
 		}
 	}()
 
-	logging.Log.Debugf(&logging.ContextMap{}, "failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
+	redact.Log.Debugf(&logging.ContextMap{}, "failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
 
 	params := aali_graphdb.ParameterMap{
 		"fm_failure_code":    aali_graphdb.StringValue(fmFailureCode),
 		"prime_failure_code": aali_graphdb.StringValue(primeMeshFailureCode),
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Executing query with parameters: %v", params)
+	redact.Log.Debugf(&logging.ContextMap{}, "Executing query with parameters: %v", params)
 
 	result, err := aali_graphdb.CypherQueryReadGeneric[map[string]interface{}](graphdb_context.client, graphdb_context.dbname, query, params)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return []string{}, err
 	}
 
 	if len(result) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "No solutions found for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
+		redact.Log.Debugf(&logging.ContextMap{}, "No solutions found for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
 		return []string{}, nil
 	}
 
@@ -292,19 +300,29 @@ func (graphdb_context *graphDbContext) GetSolutions(fmFailureCode, primeMeshFail
 			if solution != "" {
 				solutions = append(solutions, solution)
 			} else {
-				logging.Log.Debugf(&logging.ContextMap{}, "One of the solution description found in the result is empty for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
+				redact.Log.Debugf(&logging.ContextMap{}, "One of the solution description found in the result is empty for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
 			}
 		} else {
-			logging.Log.Debugf(&logging.ContextMap{}, "No stateNode found in the result for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
+			redact.Log.Debugf(&logging.ContextMap{}, "No stateNode found in the result for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
 		}
 	}
 
 	if len(solutions) == 0 {
-		logging.Log.Debugf(&logging.ContextMap{}, "No solutions found in the result for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
+		redact.Log.Debugf(&logging.ContextMap{}, "No solutions found in the result for failure codes: %s, %s", fmFailureCode, primeMeshFailureCode)
 		return []string{}, nil
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Solutions found for failure codes: %s, %s, solutions: %v", fmFailureCode, primeMeshFailureCode, solutions)
+	redact.Log.Debugf(&logging.ContextMap{}, "Solutions found for failure codes: %s, %s, solutions: %v", fmFailureCode, primeMeshFailureCode, solutions)
 
 	return solutions, nil
 }
+
+// observeQuery records the metrics of a graph database operation.
+//
+// Parameters:
+//   - operation: the name of the operation.
+//   - start: the time the operation started.
+//   - funcError: the error returned by the operation.
+func observeQuery(operation string, start time.Time, funcError *error) {
+	metrics.ObserveClientRequest(context.Background(), "graphdb", operation, start, *funcError)
+}
@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 )
@@ -35,7 +36,7 @@ func mustCfg(ctx *logging.ContextMap, key string) string {
 
 	if !ok {
 		err := fmt.Sprintf("%s not found in configuration", key)
-		logging.Log.Error(ctx, err)
+		redact.Log.Error(ctx, err)
 		panic(err)
 	}
 
@@ -56,7 +57,7 @@ func GetSubworkflows() []struct {
 	toolAmount := mustCfg(ctx, "APP_TOOL_TOTAL_AMOUNT")
 	toolAmountInt, err := strconv.Atoi(toolAmount)
 	if err != nil {
-		logging.Log.Error(ctx, fmt.Sprintf("Invalid tool amount: %s", toolAmount))
+		redact.Log.Error(ctx, fmt.Sprintf("Invalid tool amount: %s", toolAmount))
 		panic(err)
 	}
 
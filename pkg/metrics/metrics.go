@@ -0,0 +1,278 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package metrics exposes Prometheus metrics for the external function calls
+// and for the requests sent to the downstream services (aali-llm, Qdrant, graphdb).
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/redact"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Results of a function call or a client request
+const (
+	ResultOk    = "ok"
+	ResultError = "error"
+	ResultPanic = "panic"
+)
+
+var (
+	functionCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_function_calls_total",
+		Help: "Number of external function calls by function, category, gRPC method and result (ok, error, panic).",
+	}, []string{"function", "category", "method", "result"})
+
+	functionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "flowkit_function_duration_seconds",
+		Help:    "Duration of external function calls, including streaming, by function, category and gRPC method.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"function", "category", "method"})
+
+	streamMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_stream_messages_total",
+		Help: "Number of messages sent by StreamFunction by function and category.",
+	}, []string{"function", "category"})
+
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_cache_lookups_total",
+		Help: "Number of result cache lookups of cacheable functions by function, category and result (hit, miss).",
+	}, []string{"function", "category", "result"})
+
+	queueWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "flowkit_function_queue_wait_seconds",
+		Help:    "Time calls of concurrency limited functions waited for a free slot, by function and category.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"function", "category"})
+
+	queueRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_function_queue_rejections_total",
+		Help: "Number of calls of concurrency limited functions rejected because the queue was full, by function and category.",
+	}, []string{"function", "category"})
+
+	rateLimitRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_rate_limit_rejections_total",
+		Help: "Number of requests rejected because an API key exceeded its rate limit or daily quota, by reason.",
+	}, []string{"reason"})
+
+	clientRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_client_requests_total",
+		Help: "Number of requests to downstream services by client, operation, calling function and result (ok, error).",
+	}, []string{"client", "operation", "function", "result"})
+
+	clientDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "flowkit_client_request_duration_seconds",
+		Help:    "Duration of requests to downstream services by client, operation and calling function.",
+		Buckets: []float64{0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"client", "operation", "function"})
+
+	clientRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_client_retries_total",
+		Help: "Number of retried requests to downstream services by client and operation.",
+	}, []string{"client", "operation"})
+
+	modelFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flowkit_llm_model_fallbacks_total",
+		Help: "Number of LLM requests passed on to the next model of their fallback chain, by failed and next model.",
+	}, []string{"model", "next_model"})
+
+	circuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "flowkit_client_circuit_open",
+		Help: "Whether the circuit breaker of a downstream service is open (1) and fails requests fast, by client.",
+	}, []string{"client"})
+)
+
+// functionContextKey is the context key under which the name of the called external function is stored
+type functionContextKey struct{}
+
+// WithFunction stores the name of the called external function in the context,
+// so that the client metrics can be attributed to it.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - function: the name of the external function
+//
+// Returns:
+//   - context.Context: the context with the function name
+func WithFunction(ctx context.Context, function string) context.Context {
+	return context.WithValue(ctx, functionContextKey{}, function)
+}
+
+// FunctionFromContext returns the name of the external function stored in the context.
+//
+// Parameters:
+//   - ctx: the context of the request
+//
+// Returns:
+//   - string: the function name, or an empty string if none is stored
+func FunctionFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	function, _ := ctx.Value(functionContextKey{}).(string)
+	return function
+}
+
+// ObserveFunctionCall records a finished external function call.
+//
+// Parameters:
+//   - function: the name of the function
+//   - category: the category of the function
+//   - method: the gRPC method, e.g. "RunFunction"
+//   - start: the time the call started
+//   - result: the result of the call (ResultOk, ResultError, ResultPanic)
+func ObserveFunctionCall(function string, category string, method string, start time.Time, result string) {
+	functionCalls.WithLabelValues(function, category, method, result).Inc()
+	functionDuration.WithLabelValues(function, category, method).Observe(time.Since(start).Seconds())
+}
+
+// ObserveStreamMessage records a message sent by StreamFunction.
+//
+// Parameters:
+//   - function: the name of the function
+//   - category: the category of the function
+func ObserveStreamMessage(function string, category string) {
+	streamMessages.WithLabelValues(function, category).Inc()
+}
+
+// ObserveCacheLookup records a result cache lookup of a cacheable function.
+//
+// Parameters:
+//   - function: the name of the function
+//   - category: the category of the function
+//   - hit: true if the outputs were found in the cache
+func ObserveCacheLookup(function string, category string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheLookups.WithLabelValues(function, category, result).Inc()
+}
+
+// ObserveQueueWait records the time a call of a concurrency limited function waited for a free slot.
+//
+// Parameters:
+//   - function: the name of the function
+//   - category: the category of the function
+//   - wait: the time the call waited
+func ObserveQueueWait(function string, category string, wait time.Duration) {
+	queueWait.WithLabelValues(function, category).Observe(wait.Seconds())
+}
+
+// ObserveQueueRejection records a call of a concurrency limited function rejected because the queue was full.
+//
+// Parameters:
+//   - function: the name of the function
+//   - category: the category of the function
+func ObserveQueueRejection(function string, category string) {
+	queueRejections.WithLabelValues(function, category).Inc()
+}
+
+// ObserveRateLimitRejection records a request rejected by the rate limiter.
+//
+// Parameters:
+//   - reason: "rate" if the rate limit was exceeded, "quota" if the daily quota was exceeded
+func ObserveRateLimitRejection(reason string) {
+	rateLimitRejections.WithLabelValues(reason).Inc()
+}
+
+// ObserveClientRequest records a finished request to a downstream service.
+//
+// Parameters:
+//   - ctx: the context of the request, used to find the calling function
+//   - client: the downstream service, e.g. "aali-llm", "qdrant", "graphdb"
+//   - operation: the operation of the request, e.g. the gRPC method or query type
+//   - start: the time the request started
+//   - err: the error of the request, nil if it succeeded
+func ObserveClientRequest(ctx context.Context, client string, operation string, start time.Time, err error) {
+	function := FunctionFromContext(ctx)
+	result := ResultOk
+	if err != nil {
+		result = ResultError
+	}
+	clientRequests.WithLabelValues(client, operation, function, result).Inc()
+	clientDuration.WithLabelValues(client, operation, function).Observe(time.Since(start).Seconds())
+}
+
+// ObserveClientRetry records a retried request to a downstream service.
+//
+// Parameters:
+//   - client: the downstream service, e.g. "aali-llm"
+//   - operation: the operation of the request
+func ObserveClientRetry(client string, operation string) {
+	clientRetries.WithLabelValues(client, operation).Inc()
+}
+
+// ObserveModelFallback records an LLM request passed on to the next model of its fallback chain.
+//
+// Parameters:
+//   - model: the model that failed
+//   - nextModel: the model the request is sent to next
+func ObserveModelFallback(model string, nextModel string) {
+	modelFallbacks.WithLabelValues(model, nextModel).Inc()
+}
+
+// SetCircuitOpen records the state of the circuit breaker of a downstream service.
+//
+// Parameters:
+//   - client: the downstream service, e.g. "aali-llm"
+//   - open: true if the circuit breaker fails the requests fast
+func SetCircuitOpen(client string, open bool) {
+	value := 0.0
+	if open {
+		value = 1
+	}
+	circuitOpen.WithLabelValues(client).Set(value)
+}
+
+// StartServer starts the HTTP server exposing the /metrics endpoint.
+//
+// Parameters:
+//   - address: the address the server listens on, e.g. "0.0.0.0:9090"
+//
+// Returns:
+//   - *http.Server: the started server, to be shut down by the caller
+func StartServer(address string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		redact.Log.Infof(&logging.ContextMap{}, "metrics server listening on address '%s'...", address)
+		err := server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			redact.Log.Errorf(&logging.ContextMap{}, "metrics server failed: %v", err)
+		}
+	}()
+	return server
+}
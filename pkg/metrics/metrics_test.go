@@ -0,0 +1,54 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveClientRequest(t *testing.T) {
+	ctx := WithFunction(context.Background(), "PerformVectorEmbeddingRequest")
+	assert.Equal(t, "PerformVectorEmbeddingRequest", FunctionFromContext(ctx))
+	assert.Equal(t, "", FunctionFromContext(context.Background()))
+
+	ObserveClientRequest(ctx, "aali-llm", "embeddings", time.Now(), nil)
+	ObserveClientRequest(ctx, "aali-llm", "embeddings", time.Now(), errors.New("connection refused"))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(clientRequests.WithLabelValues("aali-llm", "embeddings", "PerformVectorEmbeddingRequest", ResultOk)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(clientRequests.WithLabelValues("aali-llm", "embeddings", "PerformVectorEmbeddingRequest", ResultError)))
+}
+
+func TestObserveFunctionCall(t *testing.T) {
+	ObserveFunctionCall("QdrantCreateCollection", "qdrant", "RunFunction", time.Now(), ResultPanic)
+	ObserveStreamMessage("PerformGeneralRequest", "llm_handler")
+	ObserveStreamMessage("PerformGeneralRequest", "llm_handler")
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(functionCalls.WithLabelValues("QdrantCreateCollection", "qdrant", "RunFunction", ResultPanic)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(streamMessages.WithLabelValues("PerformGeneralRequest", "llm_handler")))
+}
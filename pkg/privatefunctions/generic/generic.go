@@ -30,6 +30,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/ansys/aali-flowkit/pkg/redact"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -51,7 +52,7 @@ func CreatePayloadAndSendHttpRequest(url string, requestType string, requestObje
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic in CreatePayloadAndSendHttpRequest: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic in CreatePayloadAndSendHttpRequest: %v", r)
 			funcError = r.(error)
 			return
 		}
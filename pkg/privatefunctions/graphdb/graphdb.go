@@ -24,18 +24,22 @@ package graphdb
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"slices"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/ansys/aali-sharedtypes/pkg/aali_graphdb"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
 
+	"github.com/ansys/aali-flowkit/pkg/metrics"
 	"github.com/ansys/aali-flowkit/pkg/privatefunctions/codegeneration"
+	"github.com/ansys/aali-flowkit/pkg/redact"
 )
 
 type graphDbContext struct {
@@ -46,6 +50,47 @@ type graphDbContext struct {
 // Initialize DB login object
 var GraphDbDriver graphDbContext
 
+// CheckHealth checks that the graph database is reachable, without initializing the connection.
+//
+// Parameters:
+//   - uri: URI of the graph database.
+//
+// Returns:
+//   - error: Error object if the graph database is not healthy.
+func CheckHealth(uri string) error {
+	addr, err := graphDbAddress(uri)
+	if err != nil {
+		return err
+	}
+
+	client, err := aali_graphdb.DefaultClient(addr)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.GetHealth()
+	return err
+}
+
+// graphDbAddress makes sure the graph database address is absolute (for now, assume everything is http).
+//
+// Parameters:
+//   - uri: URI of the graph database.
+//
+// Returns:
+//   - string: the absolute address.
+//   - error: Error object if the scheme is not supported.
+func graphDbAddress(uri string) (string, error) {
+	scheme, _, found := strings.Cut(uri, "://")
+	if !found {
+		return fmt.Sprintf("http://%v", uri), nil
+	}
+	if strings.ToLower(scheme) != "http" {
+		return "", fmt.Errorf("expected http address but got scheme %q", scheme)
+	}
+	return uri, nil
+}
+
 // Initialize graph database connection.
 //
 // Parameters:
@@ -57,7 +102,7 @@ func Initialize(uri string) (funcError error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic Initialize: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic Initialize: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -66,22 +111,15 @@ func Initialize(uri string) (funcError error) {
 	logCtx := &logging.ContextMap{}
 
 	// make sure address is absolute (for now, assume everything is http)
-	scheme, _, found := strings.Cut(uri, "://")
-	var addr string
-	if found {
-		if strings.ToLower(scheme) == "http" {
-			addr = uri
-		} else {
-			return fmt.Errorf("expected http address but got scheme %q", scheme)
-		}
-	} else {
-		addr = fmt.Sprintf("http://%v", uri)
+	addr, err := graphDbAddress(uri)
+	if err != nil {
+		return err
 	}
 
 	// create client
 	client, err := aali_graphdb.DefaultClient(addr)
 	if err != nil {
-		logging.Log.Errorf(logCtx, "Error creating graphdb client: %v", err)
+		redact.Log.Errorf(logCtx, "Error creating graphdb client: %v", err)
 		return err
 	}
 
@@ -93,29 +131,29 @@ func Initialize(uri string) (funcError error) {
 	// Check if DB connection is successfull
 	_, err = client.GetHealth()
 	if err != nil {
-		logging.Log.Errorf(logCtx, "Error during healthcheck: %v", err)
+		redact.Log.Errorf(logCtx, "Error during healthcheck: %v", err)
 		return err
 	}
 
 	// if the database does not exist, create it
 	dbs, err := client.GetDatabases()
 	if err != nil {
-		logging.Log.Errorf(logCtx, "unable to get existing databases")
+		redact.Log.Errorf(logCtx, "unable to get existing databases")
 		return err
 	}
 	if slices.Contains(dbs, GraphDbDriver.dbname) {
-		logging.Log.Debugf(logCtx, "database %q already exists in the graphdb", GraphDbDriver.dbname)
+		redact.Log.Debugf(logCtx, "database %q already exists in the graphdb", GraphDbDriver.dbname)
 	} else {
-		logging.Log.Debugf(logCtx, "database %q does not exist, creating", GraphDbDriver.dbname)
+		redact.Log.Debugf(logCtx, "database %q does not exist, creating", GraphDbDriver.dbname)
 		err := client.CreateDatabase(GraphDbDriver.dbname)
 		if err != nil {
-			logging.Log.Errorf(logCtx, "unable to create database %q", GraphDbDriver.dbname)
+			redact.Log.Errorf(logCtx, "unable to create database %q", GraphDbDriver.dbname)
 			return err
 		}
 	}
 
 	// Log successfull connection
-	logging.Log.Debugf(logCtx, "Initialized graphdb database connection to %v", addr)
+	redact.Log.Debugf(logCtx, "Initialized graphdb database connection to %v", addr)
 
 	return nil
 }
@@ -201,10 +239,11 @@ func graphdbStringList(l []string) aali_graphdb.ListValue {
 // Returns:
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) AddCodeGenerationElementNodes(nodes []sharedtypes.CodeGenerationElement) (funcError error) {
+	defer observeQuery("AddCodeGenerationElementNodes", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic AddCodeGenerationElementNodes: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic AddCodeGenerationElementNodes: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -270,7 +309,7 @@ func (graphdb_context *graphDbContext) AddCodeGenerationElementNodes(nodes []sha
 			queryParams,
 		)
 		if err != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+			redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 			return err
 		}
 	}
@@ -287,10 +326,11 @@ func (graphdb_context *graphDbContext) AddCodeGenerationElementNodes(nodes []sha
 // Returns:
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) AddCodeGenerationExampleNodes(nodes []sharedtypes.CodeGenerationExample) (funcError error) {
+	defer observeQuery("AddCodeGenerationExampleNodes", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic AddCodeGenerationExampleNodes: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic AddCodeGenerationExampleNodes: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -321,7 +361,7 @@ func (graphdb_context *graphDbContext) AddCodeGenerationExampleNodes(nodes []sha
 			parameters,
 		)
 		if err != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+			redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 			return err
 		}
 
@@ -336,7 +376,7 @@ func (graphdb_context *graphDbContext) AddCodeGenerationExampleNodes(nodes []sha
 			}
 			_, err := graphdb_context.client.CypherQueryWrite(graphdb_context.dbname, query, params)
 			if err != nil {
-				logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+				redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 				return err
 			}
 		}
@@ -355,10 +395,11 @@ func (graphdb_context *graphDbContext) AddCodeGenerationExampleNodes(nodes []sha
 // Returns:
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) AddUserGuideSectionNodes(nodes []sharedtypes.CodeGenerationUserGuideSection) (funcError error) {
+	defer observeQuery("AddUserGuideSectionNodes", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic AddUserGuideSectionNodes: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic AddUserGuideSectionNodes: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -393,7 +434,7 @@ func (graphdb_context *graphDbContext) AddUserGuideSectionNodes(nodes []sharedty
 			parameters,
 		)
 		if err != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+			redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 			return err
 		}
 
@@ -411,10 +452,11 @@ func (graphdb_context *graphDbContext) AddUserGuideSectionNodes(nodes []sharedty
 // Returns:
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) CreateCodeGenerationExampleRelationships(nodes []sharedtypes.CodeGenerationExample) (funcError error) {
+	defer observeQuery("CreateCodeGenerationExampleRelationships", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic CreateCodeGenerationExampleRelationships: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic CreateCodeGenerationExampleRelationships: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -435,14 +477,14 @@ func (graphdb_context *graphDbContext) CreateCodeGenerationExampleRelationships(
 			}
 			_, err := graphdb_context.client.CypherQueryWrite(graphdb_context.dbname, query, parameters)
 			if err != nil {
-				logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+				redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 				return err
 			}
 
 		}
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "Created relationships for %v nodes", len(nodes))
+	redact.Log.Debugf(&logging.ContextMap{}, "Created relationships for %v nodes", len(nodes))
 	return nil
 }
 
@@ -454,10 +496,11 @@ func (graphdb_context *graphDbContext) CreateCodeGenerationExampleRelationships(
 // Returns:
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) CreateCodeGenerationRelationships(nodes []sharedtypes.CodeGenerationElement) (funcError error) {
+	defer observeQuery("CreateCodeGenerationRelationships", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic CreateCodeGenerationRelationships: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic CreateCodeGenerationRelationships: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -503,7 +546,7 @@ func (graphdb_context *graphDbContext) CreateCodeGenerationRelationships(nodes [
 			)
 			if err != nil {
 				errMsg := fmt.Sprintf("Error during cypher query adding BelongsTo relationships: %v", err)
-				logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+				redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 				return errors.New(errMsg)
 			}
 		}
@@ -520,7 +563,7 @@ func (graphdb_context *graphDbContext) CreateCodeGenerationRelationships(nodes [
 			)
 			if err != nil {
 				errMsg := fmt.Sprintf("Error during cypher query adding Returns relationships: %v", err)
-				logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+				redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 				return errors.New(errMsg)
 			}
 		}
@@ -530,7 +573,7 @@ func (graphdb_context *graphDbContext) CreateCodeGenerationRelationships(nodes [
 		for _, parameter := range node.Parameters {
 			patameterTypes, err := codegeneration.CreateReturnList(parameter.Type)
 			if err != nil {
-				logging.Log.Errorf(&logging.ContextMap{}, "Error during codegeneration.CreateReturnList: %v", err)
+				redact.Log.Errorf(&logging.ContextMap{}, "Error during codegeneration.CreateReturnList: %v", err)
 				return err
 			}
 			parameterList = append(parameterList, patameterTypes...)
@@ -547,7 +590,7 @@ func (graphdb_context *graphDbContext) CreateCodeGenerationRelationships(nodes [
 			)
 			if err != nil {
 				errMsg := fmt.Sprintf("Error during cypher query adding UsesParameter relationships: %v", err)
-				logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+				redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 				return errors.New(errMsg)
 			}
 		}
@@ -566,10 +609,11 @@ func (graphdb_context *graphDbContext) CreateCodeGenerationRelationships(nodes [
 // Returns:
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) CreateUserGuideSectionRelationships(nodes []sharedtypes.CodeGenerationUserGuideSection) (funcError error) {
+	defer observeQuery("CreateUserGuideSectionRelationships", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic CreateUserGuideSectionRelationships: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic CreateUserGuideSectionRelationships: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -595,7 +639,7 @@ func (graphdb_context *graphDbContext) CreateUserGuideSectionRelationships(nodes
 			)
 			if err != nil {
 				errMsg := fmt.Sprintf("Error during cypher query adding References relationships: %v", err)
-				logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+				redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 				return errors.New(errMsg)
 			}
 		}
@@ -613,7 +657,7 @@ func (graphdb_context *graphDbContext) CreateUserGuideSectionRelationships(nodes
 			)
 			if err != nil {
 				errMsg := fmt.Sprintf("Error during cypher query adding NextSibling relationships: %v", err)
-				logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+				redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 				return errors.New(errMsg)
 			}
 		}
@@ -632,7 +676,7 @@ func (graphdb_context *graphDbContext) CreateUserGuideSectionRelationships(nodes
 			)
 			if err != nil {
 				errMsg := fmt.Sprintf("Error during cypher query adding NextParent relationships: %v", err)
-				logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+				redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 				return errors.New(errMsg)
 			}
 		}
@@ -652,7 +696,7 @@ func (graphdb_context *graphDbContext) CreateUserGuideSectionRelationships(nodes
 			)
 			if err != nil {
 				errMsg := fmt.Sprintf("Error during cypher query adding HasFirstChild relationships: %v", err)
-				logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+				redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 				return errors.New(errMsg)
 			}
 		}
@@ -670,7 +714,7 @@ func (graphdb_context *graphDbContext) CreateUserGuideSectionRelationships(nodes
 		)
 		if err != nil {
 			errMsg := fmt.Sprintf("Error during cypher query adding HasChild relationships: %v", err)
-			logging.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
+			redact.Log.Errorf(&logging.ContextMap{}, "%v", errMsg)
 			return errors.New(errMsg)
 		}
 	}
@@ -687,7 +731,8 @@ func (graphdb_context *graphDbContext) CreateUserGuideSectionRelationships(nodes
 // Returns:
 //   - results: array of map[string]any, keys are determined by the specific cypher query that was passed in
 //   - err: error, if any
-func (graphdb_context *graphDbContext) WriteCypherQuery(query string, parameters aali_graphdb.Parameters) ([]map[string]any, error) {
+func (graphdb_context *graphDbContext) WriteCypherQuery(query string, parameters aali_graphdb.Parameters) (result []map[string]any, funcError error) {
+	defer observeQuery("WriteCypherQuery", time.Now(), &funcError)
 	return graphdb_context.client.CypherQueryWrite(graphdb_context.dbname, query, parameters)
 }
 
@@ -703,10 +748,11 @@ func (graphdb_context *graphDbContext) WriteCypherQuery(query string, parameters
 //   - exampleNames: List of example names.
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) GetExamplesFromCodeGenerationElement(elementType string, elementName string) (exampleNames []string, funcError error) {
+	defer observeQuery("GetExamplesFromCodeGenerationElement", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic GetExamplesFromCodeGenerationElement: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic GetExamplesFromCodeGenerationElement: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -727,7 +773,7 @@ func (graphdb_context *graphDbContext) GetExamplesFromCodeGenerationElement(elem
 		},
 	)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return nil, err
 	}
 
@@ -749,10 +795,11 @@ func (graphdb_context *graphDbContext) GetExamplesFromCodeGenerationElement(elem
 //   - elements: List of code generation elements.
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) GetCodeGenerationElementAndDependencies(elementName string, maxHops int) (elements []sharedtypes.CodeGenerationElement, funcError error) {
+	defer observeQuery("GetCodeGenerationElementAndDependencies", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic GetCodeGenerationElementAndDependencies: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic GetCodeGenerationElementAndDependencies: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -778,7 +825,7 @@ func (graphdb_context *graphDbContext) GetCodeGenerationElementAndDependencies(e
 	}
 	elements, err := aali_graphdb.CypherQueryReadGeneric[sharedtypes.CodeGenerationElement](graphdb_context.client, graphdb_context.dbname, query, params)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return nil, err
 	}
 
@@ -791,10 +838,11 @@ func (graphdb_context *graphDbContext) GetCodeGenerationElementAndDependencies(e
 //   - sections: List of user guide sections.
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) GetUserGuideMainChapters() (sections []sharedtypes.CodeGenerationUserGuideSection, funcError error) {
+	defer observeQuery("GetUserGuideMainChapters", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic GetUserGuideMainChapters: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic GetUserGuideMainChapters: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -812,23 +860,23 @@ func (graphdb_context *graphDbContext) GetUserGuideMainChapters() (sections []sh
 	result, err := aali_graphdb.CypherQueryReadGeneric[[]sharedtypes.CodeGenerationUserGuideSection](graphdb_context.client, graphdb_context.dbname, query, nil)
 
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return nil, err
 	}
 
 	switch len(result) {
 	case 0:
-		logging.Log.Warnf(&logging.ContextMap{}, "did not find any first child")
+		redact.Log.Warnf(&logging.ContextMap{}, "did not find any first child")
 		return []sharedtypes.CodeGenerationUserGuideSection{}, nil
 	case 1:
 		sections = result[0]
 	default:
 		errMsg := fmt.Sprintf("got more than 1 first child: %d", len(result))
-		logging.Log.Error(&logging.ContextMap{}, errMsg)
+		redact.Log.Error(&logging.ContextMap{}, errMsg)
 		return nil, errors.New(errMsg)
 	}
 
-	logging.Log.Debugf(&logging.ContextMap{}, "found %d chapters", len(sections))
+	redact.Log.Debugf(&logging.ContextMap{}, "found %d chapters", len(sections))
 	return sections, nil
 }
 
@@ -841,10 +889,11 @@ func (graphdb_context *graphDbContext) GetUserGuideMainChapters() (sections []sh
 //   - sectionChildren: List of user guide sections.
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) GetUserGuideSectionChildren(sectionName string) (sectionChildren []sharedtypes.CodeGenerationUserGuideSection, funcError error) {
+	defer observeQuery("GetUserGuideSectionChildren", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic in GetUserGuideSectionChildren: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic in GetUserGuideSectionChildren: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -865,7 +914,7 @@ func (graphdb_context *graphDbContext) GetUserGuideSectionChildren(sectionName s
 	}
 	sectionChildren, err := aali_graphdb.CypherQueryReadGeneric[sharedtypes.CodeGenerationUserGuideSection](graphdb_context.client, graphdb_context.dbname, query, params)
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return nil, err
 	}
 
@@ -881,10 +930,11 @@ func (graphdb_context *graphDbContext) GetUserGuideSectionChildren(sectionName s
 //   - tableOfContents: List of user guide sections.
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) GetUserGuideTableOfContents(maxLevel int) (tableOfContents []map[string]interface{}, funcError error) {
+	defer observeQuery("GetUserGuideTableOfContents", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic in GetUserGuideTableOfContents: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic in GetUserGuideTableOfContents: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -893,7 +943,7 @@ func (graphdb_context *graphDbContext) GetUserGuideTableOfContents(maxLevel int)
 	// Fetch table of contents
 	mainChapters, err := GraphDbDriver.GetUserGuideMainChapters()
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error fetching main chapters: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error fetching main chapters: %v", err)
 		return nil, err
 	}
 
@@ -903,7 +953,7 @@ func (graphdb_context *graphDbContext) GetUserGuideTableOfContents(maxLevel int)
 	for _, section := range mainChapters {
 		sectionNode, err := graphdb_context.getUserGuideNodeRecursive(section.Name, maxLevel)
 		if err != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error fetching section node %v: %v", section.Name, err)
+			redact.Log.Errorf(&logging.ContextMap{}, "Error fetching section node %v: %v", section.Name, err)
 			return nil, err
 		}
 		if sectionNode != nil {
@@ -999,10 +1049,11 @@ func (graphdb_context *graphDbContext) getUserGuideNodeRecursive(nodeName string
 //   - referencedSections: List of referenced sections.
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) GetUserGuideSectionReferences(sectionName string) (referencedSections string, funcError error) {
+	defer observeQuery("GetUserGuideSectionReferences", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic in GetUserGuideSectionReferences: %v", r)
+			redact.Log.Errorf(&logging.ContextMap{}, "Panic in GetUserGuideSectionReferences: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -1022,7 +1073,7 @@ func (graphdb_context *graphDbContext) GetUserGuideSectionReferences(sectionName
 	result, err := aali_graphdb.CypherQueryReadGeneric[reference](graphdb_context.client, graphdb_context.dbname, query, params)
 
 	if err != nil {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
+		redact.Log.Errorf(&logging.ContextMap{}, "Error during cypher query: %v", err)
 		return "", err
 	}
 
@@ -1048,10 +1099,11 @@ func (graphdb_context *graphDbContext) GetUserGuideSectionReferences(sectionName
 //   - dependenciesIds: List of dependencies ids.
 //   - funcError: Error object.
 func (graphdb_context *graphDbContext) RetrieveDependencies(ctx *logging.ContextMap, relationshipName string, relationshipDirection string, sourceDocumentName string, nodeTypesFilter sharedtypes.DbArrayFilter, tagsFilter []string, maxHops int) (dependencyNames []string, funcError error) {
+	defer observeQuery("RetrieveDependencies", time.Now(), &funcError)
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(ctx, "Panic retrieveDependencies: %v", r)
+			redact.Log.Errorf(ctx, "Panic retrieveDependencies: %v", r)
 			funcError = r.(error)
 			return
 		}
@@ -1103,7 +1155,7 @@ func (graphdb_context *graphDbContext) RetrieveDependencies(ctx *logging.Context
 		`,
 	)
 	if err != nil {
-		logging.Log.Errorf(ctx, "error parsing query template: %v", err)
+		redact.Log.Errorf(ctx, "error parsing query template: %v", err)
 		return nil, err
 	}
 	var queryBuf bytes.Buffer
@@ -1117,7 +1169,7 @@ func (graphdb_context *graphDbContext) RetrieveDependencies(ctx *logging.Context
 	}
 	err = queryTemplate.Execute(&queryBuf, queryVars)
 	if err != nil {
-		logging.Log.Errorf(ctx, "error executing query template: %v", err)
+		redact.Log.Errorf(ctx, "error executing query template: %v", err)
 		return nil, err
 	}
 	query := queryBuf.String()
@@ -1128,7 +1180,7 @@ func (graphdb_context *graphDbContext) RetrieveDependencies(ctx *logging.Context
 		Name string `json:"name"`
 	}](graphdb_context.client, graphdb_context.dbname, query, params)
 	if err != nil {
-		logging.Log.Errorf(ctx, "error during cypher query: %v", err)
+		redact.Log.Errorf(ctx, "error during cypher query: %v", err)
 		return nil, err
 	}
 
@@ -1139,3 +1191,13 @@ func (graphdb_context *graphDbContext) RetrieveDependencies(ctx *logging.Context
 
 	return dependencyNames, nil
 }
+
+// observeQuery records the metrics of a graph database operation.
+//
+// Parameters:
+//   - operation: the name of the operation.
+//   - start: the time the operation started.
+//   - funcError: the error returned by the operation.
+func observeQuery(operation string, start time.Time, funcError *error) {
+	metrics.ObserveClientRequest(context.Background(), "graphdb", operation, start, *funcError)
+}
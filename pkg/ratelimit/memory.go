@@ -0,0 +1,125 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps the buckets and call counts in memory, so the limits apply to each aali-flowkit instance
+type MemoryStore struct {
+	mutex   sync.Mutex
+	buckets map[string]*memoryBucket
+	calls   map[string]*memoryCount
+	now     func() time.Time
+}
+
+// memoryBucket is the token bucket of a key
+type memoryBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// memoryCount is the call count of a key in a day
+type memoryCount struct {
+	day   string
+	count int
+}
+
+// NewMemoryStore creates an in-memory store
+//
+// Returns:
+//   - *MemoryStore: the store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: map[string]*memoryBucket{},
+		calls:   map[string]*memoryCount{},
+		now:     time.Now,
+	}
+}
+
+// TakeToken takes a token from the bucket of a key
+// New buckets start full
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//   - rate: the tokens added per second
+//   - burst: the maximum number of tokens in the bucket
+//
+// Returns:
+//   - bool: true if a token was taken
+//   - time.Duration: the time until the next token if the bucket is empty
+//   - error: always nil
+func (s *MemoryStore) TakeToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &memoryBucket{tokens: float64(burst), updated: now}
+		s.buckets[key] = bucket
+	}
+	bucket.tokens = math.Min(float64(burst), bucket.tokens+now.Sub(bucket.updated).Seconds()*rate)
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		return false, tokenWait(bucket.tokens, rate), nil
+	}
+	bucket.tokens--
+	return true, 0, nil
+}
+
+// CountCall counts a call of a key in the current UTC day
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//   - quota: the number of calls allowed per day
+//
+// Returns:
+//   - bool: true if the call is within the quota
+//   - time.Duration: the time until the next day if the quota is exceeded
+//   - error: always nil
+func (s *MemoryStore) CountCall(ctx context.Context, key string, quota int) (bool, time.Duration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	day, nextDay := quotaDay(now)
+	count, ok := s.calls[key]
+	if !ok || count.day != day {
+		count = &memoryCount{day: day}
+		s.calls[key] = count
+	}
+
+	count.count++
+	if count.count > quota {
+		return false, nextDay.Sub(now), nil
+	}
+	return true, 0, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStoreTakeToken(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	// new buckets start full
+	for i := 0; i < 2; i++ {
+		allowed, _, err := store.TakeToken(ctx, "a", 4, 2)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, wait, err := store.TakeToken(ctx, "a", 4, 2)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 250*time.Millisecond, wait)
+
+	// the buckets of other keys are independent
+	allowed, _, _ = store.TakeToken(ctx, "b", 4, 2)
+	assert.True(t, allowed)
+
+	// the bucket is refilled with the rate
+	now = now.Add(100 * time.Millisecond)
+	allowed, wait, _ = store.TakeToken(ctx, "a", 4, 2)
+	assert.False(t, allowed)
+	assert.Equal(t, 150*time.Millisecond, wait)
+	now = now.Add(150 * time.Millisecond)
+	allowed, _, _ = store.TakeToken(ctx, "a", 4, 2)
+	assert.True(t, allowed)
+
+	// the bucket holds at most burst tokens
+	now = now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		allowed, _, _ = store.TakeToken(ctx, "a", 4, 2)
+		assert.True(t, allowed)
+	}
+	allowed, _, _ = store.TakeToken(ctx, "a", 4, 2)
+	assert.False(t, allowed)
+}
+
+func TestMemoryStoreCountCall(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 14, 18, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		allowed, _, err := store.CountCall(ctx, "a", 2)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, wait, err := store.CountCall(ctx, "a", 2)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 6*time.Hour, wait)
+
+	// the count starts again the next UTC day
+	now = now.Add(6 * time.Hour)
+	allowed, _, _ = store.CountCall(ctx, "a", 2)
+	assert.True(t, allowed)
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package ratelimit limits the calls of API keys with token buckets and daily quotas,
+// kept in memory or in Redis.
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"time"
+)
+
+// Store keeps the token buckets and the daily call counts of the keys
+type Store interface {
+	// TakeToken takes a token from the bucket of a key, refilled with rate tokens per second up to burst tokens
+	// It returns false and the time until the next token if the bucket is empty
+	TakeToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error)
+	// CountCall counts a call of a key in the current UTC day
+	// It returns false and the time until the next day if the quota is exceeded
+	CountCall(ctx context.Context, key string, quota int) (bool, time.Duration, error)
+}
+
+// Limit is the rate limit and quota of a key
+type Limit struct {
+	// Rate is the number of calls per second, the calls are not rate limited if zero
+	Rate float64
+	// Burst is the number of calls allowed at once
+	Burst int
+	// DailyQuota is the number of calls per UTC day, the calls are not counted if zero
+	DailyQuota int
+}
+
+// KeyID identifies an API key in the store without storing the key itself
+//
+// Parameters:
+//   - apiKey: the API key
+//
+// Returns:
+//   - string: the hex encoded SHA-256 hash of the key
+func KeyID(apiKey string) string {
+	hash := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(hash[:])
+}
+
+// tokenWait returns the time until the bucket holds a full token again
+//
+// Parameters:
+//   - tokens: the tokens in the bucket, less than one
+//   - rate: the tokens added per second
+//
+// Returns:
+//   - time.Duration: the time until the next token, rounded up to milliseconds
+func tokenWait(tokens float64, rate float64) time.Duration {
+	milliseconds := math.Ceil((1 - tokens) / rate * 1000)
+	return time.Duration(milliseconds) * time.Millisecond
+}
+
+// quotaDay returns the UTC day of a time and the start of the next day
+//
+// Parameters:
+//   - now: the time
+//
+// Returns:
+//   - string: the day, e.g. "2025-03-14"
+//   - time.Time: the start of the next day
+func quotaDay(now time.Time) (string, time.Time) {
+	now = now.UTC()
+	year, month, day := now.Date()
+	return now.Format(time.DateOnly), time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/cache"
+)
+
+// tokenBucketScript takes a token from a bucket stored as hash of its tokens and update time in milliseconds
+// It returns whether a token was taken and the milliseconds until the next token
+const tokenBucketScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local tokens = tonumber(redis.call('HGET', KEYS[1], 'tokens'))
+local updated = tonumber(redis.call('HGET', KEYS[1], 'updated'))
+if tokens == nil or updated == nil then
+	tokens = burst
+	updated = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - updated) / 1000 * rate)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) / rate * 1000)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, wait}
+`
+
+// dailyCountScript increments the call count of a day, which expires at the given time in milliseconds
+const dailyCountScript = `
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIREAT', KEYS[1], ARGV[1])
+end
+return count
+`
+
+// scriptRunner runs Lua scripts on a Redis server
+type scriptRunner interface {
+	Eval(ctx context.Context, script string, keys []string, args ...string) (interface{}, error)
+}
+
+// RedisStore keeps the buckets and call counts in Redis, so the limits are shared by several aali-flowkit instances
+// The buckets and counts are updated by Lua scripts, so concurrent calls do not overwrite each other
+type RedisStore struct {
+	redis scriptRunner
+	now   func() time.Time
+}
+
+// NewRedisStore creates a store on a Redis server
+//
+// Parameters:
+//   - redis: the connection to the Redis server
+//
+// Returns:
+//   - *RedisStore: the store
+func NewRedisStore(redis *cache.RedisStore) *RedisStore {
+	return &RedisStore{redis: redis, now: time.Now}
+}
+
+// TakeToken takes a token from the bucket of a key
+// New buckets start full and expire once they would be full again
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//   - rate: the tokens added per second
+//   - burst: the maximum number of tokens in the bucket
+//
+// Returns:
+//   - bool: true if a token was taken
+//   - time.Duration: the time until the next token if the bucket is empty
+//   - error: an error if the script fails
+func (s *RedisStore) TakeToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error) {
+	reply, err := s.redis.Eval(ctx, tokenBucketScript, []string{"bucket:" + key},
+		strconv.FormatFloat(rate, 'f', -1, 64), strconv.Itoa(burst), strconv.FormatInt(s.now().UnixMilli(), 10))
+	if err != nil {
+		return false, 0, err
+	}
+	values, ok := reply.([]interface{})
+	if !ok || len(values) != 2 {
+		return false, 0, fmt.Errorf("redis: unexpected token bucket reply %v", reply)
+	}
+	allowed, _ := values[0].(int64)
+	wait, _ := values[1].(int64)
+	return allowed == 1, time.Duration(wait) * time.Millisecond, nil
+}
+
+// CountCall counts a call of a key in the current UTC day
+// The counts expire at the end of their day
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - key: the key
+//   - quota: the number of calls allowed per day
+//
+// Returns:
+//   - bool: true if the call is within the quota
+//   - time.Duration: the time until the next day if the quota is exceeded
+//   - error: an error if the script fails
+func (s *RedisStore) CountCall(ctx context.Context, key string, quota int) (bool, time.Duration, error) {
+	now := s.now()
+	day, nextDay := quotaDay(now)
+	reply, err := s.redis.Eval(ctx, dailyCountScript, []string{"quota:" + key + ":" + day}, strconv.FormatInt(nextDay.UnixMilli(), 10))
+	if err != nil {
+		return false, 0, err
+	}
+	count, ok := reply.(int64)
+	if !ok {
+		return false, 0, fmt.Errorf("redis: unexpected call count reply %v", reply)
+	}
+	if count > int64(quota) {
+		return false, nextDay.Sub(now), nil
+	}
+	return true, 0, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/ansys/aali-flowkit/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScriptRunner records the script calls and returns a fixed reply
+type fakeScriptRunner struct {
+	script string
+	keys   []string
+	args   []string
+	reply  interface{}
+	err    error
+}
+
+func (r *fakeScriptRunner) Eval(ctx context.Context, script string, keys []string, args ...string) (interface{}, error) {
+	r.script, r.keys, r.args = script, keys, args
+	return r.reply, r.err
+}
+
+func TestRedisStoreTakeToken(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	runner := &fakeScriptRunner{reply: []interface{}{int64(0), int64(1500)}}
+	store := &RedisStore{redis: runner, now: func() time.Time { return now }}
+
+	allowed, wait, err := store.TakeToken(ctx, "a", 0.5, 3)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 1500*time.Millisecond, wait)
+	assert.Equal(t, tokenBucketScript, runner.script)
+	assert.Equal(t, []string{"bucket:a"}, runner.keys)
+	assert.Equal(t, []string{"0.5", "3", "1741953600000"}, runner.args)
+
+	runner.reply = []interface{}{int64(1), int64(0)}
+	allowed, _, err = store.TakeToken(ctx, "a", 0.5, 3)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	runner.reply = []byte("OK")
+	_, _, err = store.TakeToken(ctx, "a", 0.5, 3)
+	assert.Error(t, err)
+}
+
+func TestRedisStoreCountCall(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 14, 18, 0, 0, 0, time.UTC)
+	runner := &fakeScriptRunner{reply: int64(2)}
+	store := &RedisStore{redis: runner, now: func() time.Time { return now }}
+
+	// the count of the day expires at the start of the next day
+	allowed, _, err := store.CountCall(ctx, "a", 2)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, dailyCountScript, runner.script)
+	assert.Equal(t, []string{"quota:a:2025-03-14"}, runner.keys)
+	assert.Equal(t, []string{"1741996800000"}, runner.args)
+
+	runner.reply = int64(3)
+	allowed, wait, err := store.CountCall(ctx, "a", 2)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 6*time.Hour, wait)
+
+	runner.err = errors.New("redis: connection refused")
+	_, _, err = store.CountCall(ctx, "a", 2)
+	assert.Error(t, err)
+}
+
+func TestRedisStoreScripts(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	redis := cache.NewRedisStore(cache.RedisOptions{Address: server.Addr(), Namespace: "ratelimit:"})
+	defer redis.Close()
+	now := time.Now()
+	store := &RedisStore{redis: redis, now: func() time.Time { return now }}
+
+	// the bucket starts full and is refilled at the rate
+	for i := 0; i < 2; i++ {
+		allowed, _, err := store.TakeToken(ctx, "a", 0.5, 2)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, wait, err := store.TakeToken(ctx, "a", 0.5, 2)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 2*time.Second, wait)
+	now = now.Add(2 * time.Second)
+	allowed, _, err = store.TakeToken(ctx, "a", 0.5, 2)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// the calls beyond the quota are refused until the next day
+	for i := 0; i < 2; i++ {
+		allowed, _, err = store.CountCall(ctx, "a", 2)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, wait, err = store.CountCall(ctx, "a", 2)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Positive(t, wait)
+	day, _ := quotaDay(now)
+	count, err := server.Get("ratelimit:quota:a:" + day)
+	require.NoError(t, err)
+	assert.Equal(t, "3", count)
+}
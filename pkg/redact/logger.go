@@ -0,0 +1,113 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package redact
+
+import (
+	"fmt"
+
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+)
+
+// Logger writes to the aali-flowkit log with the secret values masked
+// The messages are passed through unchanged while no value is tracked
+type Logger struct{}
+
+// Log is the masking logger, used in place of logging.Log
+var Log = &Logger{}
+
+// Debug logs a debug message
+func (l *Logger) Debug(ctx *logging.ContextMap, args ...interface{}) {
+	if !active() {
+		logging.Log.Debug(ctx, args...)
+		return
+	}
+	logging.Log.Debug(ctx, String(fmt.Sprint(args...)))
+}
+
+// Debugf logs a formatted debug message
+func (l *Logger) Debugf(ctx *logging.ContextMap, format string, args ...interface{}) {
+	if !active() {
+		logging.Log.Debugf(ctx, format, args...)
+		return
+	}
+	logging.Log.Debugf(ctx, "%s", String(fmt.Sprintf(format, args...)))
+}
+
+// Info logs an info message
+func (l *Logger) Info(ctx *logging.ContextMap, args ...interface{}) {
+	if !active() {
+		logging.Log.Info(ctx, args...)
+		return
+	}
+	logging.Log.Info(ctx, String(fmt.Sprint(args...)))
+}
+
+// Infof logs a formatted info message
+func (l *Logger) Infof(ctx *logging.ContextMap, format string, args ...interface{}) {
+	if !active() {
+		logging.Log.Infof(ctx, format, args...)
+		return
+	}
+	logging.Log.Infof(ctx, "%s", String(fmt.Sprintf(format, args...)))
+}
+
+// Warn logs a warning
+func (l *Logger) Warn(ctx *logging.ContextMap, args ...interface{}) {
+	if !active() {
+		logging.Log.Warn(ctx, args...)
+		return
+	}
+	logging.Log.Warn(ctx, String(fmt.Sprint(args...)))
+}
+
+// Warnf logs a formatted warning
+func (l *Logger) Warnf(ctx *logging.ContextMap, format string, args ...interface{}) {
+	if !active() {
+		logging.Log.Warnf(ctx, format, args...)
+		return
+	}
+	logging.Log.Warnf(ctx, "%s", String(fmt.Sprintf(format, args...)))
+}
+
+// Error logs an error
+func (l *Logger) Error(ctx *logging.ContextMap, args ...interface{}) {
+	if !active() {
+		logging.Log.Error(ctx, args...)
+		return
+	}
+	logging.Log.Error(ctx, String(fmt.Sprint(args...)))
+}
+
+// Errorf logs a formatted error
+func (l *Logger) Errorf(ctx *logging.ContextMap, format string, args ...interface{}) {
+	if !active() {
+		logging.Log.Errorf(ctx, format, args...)
+		return
+	}
+	logging.Log.Errorf(ctx, "%s", String(fmt.Sprintf(format, args...)))
+}
+
+// Fatalf logs a formatted error and exits
+func (l *Logger) Fatalf(ctx *logging.ContextMap, format string, args ...interface{}) {
+	logging.Log.Fatalf(ctx, "%s", String(fmt.Sprintf(format, args...)))
+}
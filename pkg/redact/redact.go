@@ -0,0 +1,281 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package redact masks secret values, e.g. tokens and API keys passed as function inputs,
+// in the log lines and error messages of aali-flowkit.
+package redact
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mask replaces the secret values
+const Mask = "[REDACTED]"
+
+// minSecretLength is the length below which values are not masked, masking them would garble unrelated text
+const minSecretLength = 8
+
+// secretNameSuffixes are the lower case name endings of secret inputs and variables
+var secretNameSuffixes = []string{"token", "apikey", "api_key", "password", "passwd", "secret"}
+
+// secrets holds the values masked by String
+var secrets = newRegistry()
+
+// registry holds the values kept until the process ends and the scopes of the running requests
+type registry struct {
+	mutex    sync.RWMutex
+	kept     map[string]bool
+	replacer *strings.Replacer
+	// scopes holds the scopes of the running requests that track values
+	scopes map[*scope]bool
+}
+
+// scope holds the values tracked by a request and the replacer masking them
+type scope struct {
+	mutex    sync.RWMutex
+	values   map[string]bool
+	replacer *strings.Replacer
+	ended    bool
+}
+
+// scopeContextKey is the context key of the scope of a request
+type scopeContextKey struct{}
+
+// newRegistry creates an empty registry
+//
+// Returns:
+//   - *registry: the registry
+func newRegistry() *registry {
+	return &registry{kept: map[string]bool{}, scopes: map[*scope]bool{}}
+}
+
+// IsSecretName checks if an input or variable name looks like it holds a secret,
+// i.e. ends with Token, ApiKey, Password or Secret regardless of case
+//
+// Parameters:
+//   - name: the name
+//
+// Returns:
+//   - bool: true if the name looks secret
+func IsSecretName(name string) bool {
+	name = strings.ToLower(name)
+	for _, suffix := range secretNameSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Begin starts the scope of a request, the values tracked with the returned context are masked until end is called
+//
+// Parameters:
+//   - ctx: the context of the request
+//
+// Returns:
+//   - context.Context: the context holding the scope
+//   - func(): the function ending the scope once the request is done
+func Begin(ctx context.Context) (context.Context, func()) {
+	s := &scope{values: map[string]bool{}}
+	return context.WithValue(ctx, scopeContextKey{}, s), func() { secrets.end(s) }
+}
+
+// Track masks values until the scope of the request ends, e.g. the secret inputs of a function call
+// Values shorter than eight bytes and values tracked outside a scope are ignored
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - values: the secret values
+func Track(ctx context.Context, values ...string) {
+	s, ok := ctx.Value(scopeContextKey{}).(*scope)
+	if !ok {
+		return
+	}
+	if s.add(values) {
+		secrets.register(s)
+	}
+}
+
+// Keep masks values until the process ends, e.g. secrets of the configuration
+// Values shorter than eight bytes are ignored
+//
+// Parameters:
+//   - values: the secret values
+func Keep(values ...string) {
+	secrets.keep(values)
+}
+
+// String masks the secret values in a string
+// The values of all running requests are masked, as log lines do not tell which request they belong to
+//
+// Parameters:
+//   - s: the string
+//
+// Returns:
+//   - string: the string with each secret value replaced by Mask
+func String(s string) string {
+	return secrets.mask(s)
+}
+
+// active checks if any value is masked
+//
+// Returns:
+//   - bool: true if String may change its input
+func active() bool {
+	secrets.mutex.RLock()
+	defer secrets.mutex.RUnlock()
+	return secrets.replacer != nil || len(secrets.scopes) > 0
+}
+
+// newReplacer creates the replacer of values, longer values first so they win over values they contain
+//
+// Parameters:
+//   - values: the values
+//
+// Returns:
+//   - *strings.Replacer: the replacer, nil if there are no values
+func newReplacer(values map[string]bool) *strings.Replacer {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := make([]string, 0, len(values))
+	for value := range values {
+		sorted = append(sorted, value)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+
+	pairs := make([]string, 0, 2*len(sorted))
+	for _, value := range sorted {
+		pairs = append(pairs, value, Mask)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// keep adds values kept until the process ends and rebuilds their replacer if they changed
+//
+// Parameters:
+//   - values: the values
+func (r *registry) keep(values []string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	changed := false
+	for _, value := range values {
+		if len(value) >= minSecretLength && !r.kept[value] {
+			r.kept[value] = true
+			changed = true
+		}
+	}
+	if changed {
+		r.replacer = newReplacer(r.kept)
+	}
+}
+
+// register adds the scope of a request, unless it already ended
+//
+// Parameters:
+//   - s: the scope
+func (r *registry) register(s *scope) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if !s.ended {
+		r.scopes[s] = true
+	}
+}
+
+// end removes the scope of a request, its values are no longer masked
+//
+// Parameters:
+//   - s: the scope
+func (r *registry) end(s *scope) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ended = true
+	delete(r.scopes, s)
+}
+
+// mask replaces the values of the running requests and the kept values in a string
+//
+// Parameters:
+//   - s: the string
+//
+// Returns:
+//   - string: the masked string
+func (r *registry) mask(s string) string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for scope := range r.scopes {
+		s = scope.mask(s)
+	}
+	if r.replacer != nil {
+		s = r.replacer.Replace(s)
+	}
+	return s
+}
+
+// add adds values to the scope and rebuilds its replacer if the values changed
+//
+// Parameters:
+//   - values: the values
+//
+// Returns:
+//   - bool: true if the scope holds any value
+func (s *scope) add(values []string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	changed := false
+	for _, value := range values {
+		if len(value) >= minSecretLength && !s.values[value] {
+			s.values[value] = true
+			changed = true
+		}
+	}
+	if changed {
+		s.replacer = newReplacer(s.values)
+	}
+	return s.replacer != nil
+}
+
+// mask replaces the values of the scope in a string
+//
+// Parameters:
+//   - str: the string
+//
+// Returns:
+//   - string: the masked string
+func (s *scope) mask(str string) string {
+	s.mutex.RLock()
+	replacer := s.replacer
+	s.mutex.RUnlock()
+	if replacer == nil {
+		return str
+	}
+	return replacer.Replace(str)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package redact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSecretName(t *testing.T) {
+	for _, name := range []string{"githubAccessToken", "acsApiKey", "jwtToken", "dbPassword", "client_secret", "API_KEY", "token"} {
+		assert.True(t, IsSecretName(name), name)
+	}
+	for _, name := range []string{"maxTokens", "tokenCountEndpoint", "query", "apiVersion", "findKey"} {
+		assert.False(t, IsSecretName(name), name)
+	}
+}
+
+func TestScope(t *testing.T) {
+	r := newRegistry()
+	assert.Equal(t, "token ghp_123456", r.mask("token ghp_123456"))
+
+	// longer values are masked first and short values are ignored
+	first := &scope{values: map[string]bool{}}
+	first.add([]string{"ghp_123456", "ghp_1234", "true", "2024"})
+	r.register(first)
+	r.keep([]string{"sk-config-key"})
+	assert.Equal(t, "token [REDACTED], key [REDACTED], true in 2024 [REDACTED]", r.mask("token ghp_123456, key sk-config-key, true in 2024 ghp_1234"))
+
+	// the values of concurrent requests are masked until their request ends, kept values stay
+	second := &scope{values: map[string]bool{}}
+	second.add([]string{"second-secret"})
+	r.register(second)
+	assert.Equal(t, "[REDACTED] [REDACTED]", r.mask("ghp_123456 second-secret"))
+	r.end(first)
+	assert.Equal(t, "ghp_123456 [REDACTED] [REDACTED]", r.mask("ghp_123456 second-secret sk-config-key"))
+
+	// an ended scope is not registered again
+	first.add([]string{"late-secret"})
+	r.register(first)
+	r.end(second)
+	assert.Equal(t, "late-secret second-secret", r.mask("late-secret second-secret"))
+	assert.Empty(t, r.scopes)
+}
+
+func TestString(t *testing.T) {
+	ctx, end := Begin(context.Background())
+	Track(ctx, "tracked-secret-value")
+	Keep("kept-secret-value")
+	assert.True(t, active())
+	assert.Equal(t, "calling with [REDACTED] and [REDACTED]", String("calling with tracked-secret-value and kept-secret-value"))
+
+	// the values are masked until the request ends, values tracked outside a request are ignored
+	end()
+	Track(context.Background(), "untracked-secret-value")
+	assert.Equal(t, "calling with tracked-secret-value and [REDACTED], untracked-secret-value", String("calling with tracked-secret-value and kept-secret-value, untracked-secret-value"))
+}
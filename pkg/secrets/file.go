@@ -0,0 +1,189 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize is the size of the AES-256 key of encrypted secret files
+const KeySize = 32
+
+// EncryptedFileProvider looks up secrets in a local file holding a JSON object of names and values,
+// encrypted with AES-256-GCM and base64 encoded
+// The file is decrypted once when the provider is created
+type EncryptedFileProvider struct {
+	values map[string]string
+}
+
+// NewEncryptedFileProvider decrypts a secret file
+//
+// Parameters:
+//   - path: the path of the encrypted file
+//   - key: the AES-256 key
+//
+// Returns:
+//   - *EncryptedFileProvider: the provider
+//   - error: an error if the file cannot be read or decrypted
+func NewEncryptedFileProvider(path string, key []byte) (*EncryptedFileProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret file: %v", err)
+	}
+	values, err := Decrypt(content, key)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedFileProvider{values: values}, nil
+}
+
+// Lookup returns the value of a secret of the file
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - name: the name of the secret
+//
+// Returns:
+//   - string: the value of the secret
+//   - bool: true if the file holds the secret
+//   - error: always nil
+func (p *EncryptedFileProvider) Lookup(ctx context.Context, name string) (string, bool, error) {
+	value, ok := p.values[name]
+	return value, ok, nil
+}
+
+// Encrypt encrypts secrets for an EncryptedFileProvider
+//
+// Parameters:
+//   - values: the values by secret name
+//   - key: the AES-256 key
+//
+// Returns:
+//   - []byte: the content of the encrypted file
+//   - error: an error if the key is invalid
+func Encrypt(values map[string]string, key []byte) ([]byte, error) {
+	for name := range values {
+		if !nameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid secret name %q", name)
+		}
+	}
+	plaintext, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := gcm.Seal(nonce, nonce, plaintext, nil)
+	return []byte(base64.StdEncoding.EncodeToString(sealed) + "\n"), nil
+}
+
+// Decrypt decrypts the content of an encrypted secret file
+//
+// Parameters:
+//   - content: the content of the file
+//   - key: the AES-256 key
+//
+// Returns:
+//   - map[string]string: the values by secret name
+//   - error: an error if the key is wrong or the content is damaged
+func Decrypt(content []byte, key []byte) (map[string]string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret file encoding: %v", err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("secret file is too short")
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret file, wrong key or damaged file")
+	}
+	values := map[string]string{}
+	if err := json.Unmarshal(plaintext, &values); err != nil {
+		return nil, fmt.Errorf("invalid secret file content: %v", err)
+	}
+	return values, nil
+}
+
+// LoadKey reads an AES-256 key from a file holding it hex or base64 encoded
+//
+// Parameters:
+//   - path: the path of the key file
+//
+// Returns:
+//   - []byte: the key
+//   - error: an error if the file cannot be read or holds no 32 byte key
+func LoadKey(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret key file: %v", err)
+	}
+	encoded := strings.TrimSpace(string(content))
+	if key, err := hex.DecodeString(encoded); err == nil && len(key) == KeySize {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(encoded); err == nil && len(key) == KeySize {
+		return key, nil
+	}
+	return nil, fmt.Errorf("secret key file must hold a %d byte key, hex or base64 encoded", KeySize)
+}
+
+// newGCM creates the AES-GCM cipher of a key
+//
+// Parameters:
+//   - key: the AES-256 key
+//
+// Returns:
+//   - cipher.AEAD: the cipher
+//   - error: an error if the key has the wrong size
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("secret key must have %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// EnvProvider looks up secrets in environment variables
+// The variable of a secret is the prefix followed by the upper case name,
+// with dots and dashes replaced by underscores, e.g. FLOWKIT_SECRET_ACS_API_KEY for acs-api-key
+type EnvProvider struct {
+	prefix string
+}
+
+// NewEnvProvider creates a provider reading environment variables
+//
+// Parameters:
+//   - prefix: the prefix of the variables
+//
+// Returns:
+//   - *EnvProvider: the provider
+func NewEnvProvider(prefix string) *EnvProvider {
+	return &EnvProvider{prefix: prefix}
+}
+
+// Lookup returns the value of the variable of a secret
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - name: the name of the secret
+//
+// Returns:
+//   - string: the value of the secret
+//   - bool: true if the variable is set
+//   - error: always nil
+func (p *EnvProvider) Lookup(ctx context.Context, name string) (string, bool, error) {
+	variable := p.prefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(name))
+	value, ok := os.LookupEnv(variable)
+	return value, ok, nil
+}
+
+// DirectoryProvider looks up secrets in a directory holding a file per secret, e.g. a mounted Kubernetes secret
+// The files are read on each lookup, so rotated secrets are picked up
+type DirectoryProvider struct {
+	directory string
+}
+
+// NewDirectoryProvider creates a provider reading the files of a directory
+//
+// Parameters:
+//   - directory: the directory
+//
+// Returns:
+//   - *DirectoryProvider: the provider
+func NewDirectoryProvider(directory string) *DirectoryProvider {
+	return &DirectoryProvider{directory: directory}
+}
+
+// Lookup returns the content of the file of a secret without trailing line break
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - name: the name of the secret, the file name
+//
+// Returns:
+//   - string: the value of the secret
+//   - bool: true if the file exists
+//   - error: an error if the file cannot be read
+func (p *DirectoryProvider) Lookup(ctx context.Context, name string) (string, bool, error) {
+	content, err := os.ReadFile(filepath.Join(p.directory, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return strings.TrimRight(string(content), "\r\n"), true, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package secrets resolves secret references like secret://acs-api-key,
+// so flows can pass secrets to external functions without holding their values.
+// The values are looked up in environment variables, a directory of files or an encrypted file.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ReferencePrefix starts the input values referencing a secret
+const ReferencePrefix = "secret://"
+
+// ErrNotFound is returned if no provider holds the referenced secret
+var ErrNotFound = errors.New("secret not found")
+
+// ErrInvalidInput is returned if a secret reference is malformed
+var ErrInvalidInput = errors.New("invalid secret reference")
+
+// nameRegex matches the valid secret names, they cannot leave the directory of the directory provider
+var nameRegex = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*$`)
+
+// Provider looks up the values of secrets
+type Provider interface {
+	// Lookup returns the value of a secret and false if the provider does not hold it
+	Lookup(ctx context.Context, name string) (string, bool, error)
+}
+
+// Resolver resolves secret references with a chain of providers
+type Resolver struct {
+	providers []Provider
+}
+
+// NewResolver creates a resolver asking the providers in the given order
+//
+// Parameters:
+//   - providers: the providers
+//
+// Returns:
+//   - *Resolver: the resolver
+func NewResolver(providers ...Provider) *Resolver {
+	return &Resolver{providers: providers}
+}
+
+// ParseReference extracts the secret name of a reference
+//
+// Parameters:
+//   - value: the input value
+//
+// Returns:
+//   - string: the name of the referenced secret
+//   - bool: true if the value is a secret reference
+func ParseReference(value string) (string, bool) {
+	if !strings.HasPrefix(value, ReferencePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, ReferencePrefix), true
+}
+
+// Resolve returns the value of a secret from the first provider holding it
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - name: the name of the secret
+//
+// Returns:
+//   - string: the value of the secret
+//   - error: ErrInvalidInput if the name is malformed, ErrNotFound if no provider holds the secret, or the error of a provider
+func (r *Resolver) Resolve(ctx context.Context, name string) (string, error) {
+	if !nameRegex.MatchString(name) {
+		return "", fmt.Errorf("%w: invalid secret name %q", ErrInvalidInput, name)
+	}
+	for _, provider := range r.providers {
+		value, ok, err := provider.Lookup(ctx, name)
+		if err != nil {
+			return "", fmt.Errorf("failed to look up secret %q: %v", name, err)
+		}
+		if ok {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrNotFound, name)
+}
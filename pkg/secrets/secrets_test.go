@@ -0,0 +1,82 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingProvider fails every lookup
+type failingProvider struct{}
+
+func (failingProvider) Lookup(ctx context.Context, name string) (string, bool, error) {
+	return "", false, errors.New("vault sealed")
+}
+
+func TestParseReference(t *testing.T) {
+	name, ok := ParseReference("secret://acs-api-key")
+	assert.True(t, ok)
+	assert.Equal(t, "acs-api-key", name)
+
+	_, ok = ParseReference("https://example.com")
+	assert.False(t, ok)
+}
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+	directory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "github-token"), []byte("ghp_from_file\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "acs-api-key"), []byte("from-file"), 0o600))
+	t.Setenv("TEST_SECRET_ACS_API_KEY", "from-env")
+
+	resolver := NewResolver(NewEnvProvider("TEST_SECRET_"), NewDirectoryProvider(directory))
+
+	// the first provider holding the secret wins
+	value, err := resolver.Resolve(ctx, "acs-api-key")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", value)
+
+	// the trailing line break of files is removed
+	value, err = resolver.Resolve(ctx, "github-token")
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_from_file", value)
+
+	_, err = resolver.Resolve(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// names cannot leave the directory
+	_, err = resolver.Resolve(ctx, "../github-token")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.ErrorContains(t, err, "invalid secret name")
+
+	// provider errors are returned
+	_, err = NewResolver(failingProvider{}).Resolve(ctx, "acs-api-key")
+	assert.ErrorContains(t, err, "vault sealed")
+}
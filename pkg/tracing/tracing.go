@@ -0,0 +1,308 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package tracing sets up OpenTelemetry tracing for the external function calls
+// and propagates the trace context to the downstream services (aali-llm, Qdrant, MongoDB, MCP, HTTP APIs).
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
+)
+
+// tracerName is the name of the tracer creating the flowkit spans
+const tracerName = "github.com/ansys/aali-flowkit"
+
+// Exporters supported by Setup
+const (
+	ExporterNone = ""
+	ExporterOTLP = "otlp"
+	ExporterFile = "file"
+)
+
+// Options configures the tracing of aali-flowkit.
+type Options struct {
+	// ServiceName is reported as service.name of the traces
+	ServiceName string
+	// Exporter is the trace exporter: ExporterNone, ExporterOTLP or ExporterFile
+	Exporter string
+	// OTLPEndpoint is the host:port of the OTLP gRPC collector; the OTEL_EXPORTER_OTLP_* environment variables are used if empty
+	OTLPEndpoint string
+	// OTLPInsecure disables TLS for the connection to the OTLP collector
+	OTLPInsecure bool
+	// File is the path of the file the spans are written to by the file exporter
+	File string
+	// SampleRatio is the ratio of new traces that are sampled; traces started by the caller follow the caller's decision
+	SampleRatio float64
+}
+
+// Setup installs the W3C trace context propagator and, if an exporter is configured,
+// a tracer provider exporting the spans.
+//
+// Parameters:
+//   - ctx: the context used to create the exporter
+//   - opts: the tracing options
+//
+// Returns:
+//   - func(context.Context) error: flushes the pending spans and shuts down the exporter
+//   - error: an error if the exporter could not be created
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var file *os.File
+	switch opts.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		clientOpts := []otlptracegrpc.Option{}
+		if opts.OTLPEndpoint != "" {
+			clientOpts = append(clientOpts, otlptracegrpc.WithEndpoint(opts.OTLPEndpoint))
+		}
+		if opts.OTLPInsecure {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+		otlpExporter, err := otlptracegrpc.New(ctx, clientOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %v", err)
+		}
+		exporter = otlpExporter
+	case ExporterFile:
+		if opts.File == "" {
+			return nil, fmt.Errorf("file exporter requires a file path")
+		}
+		var err error
+		file, err = os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %v", err)
+		}
+		fileExporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to create file exporter: %v", err)
+		}
+		exporter = fileExporter
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter %q", opts.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", opts.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	shutdown := func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			file.Close()
+		}
+		return err
+	}
+	return shutdown, nil
+}
+
+// ParseSampleRatio parses the sample ratio of new traces.
+//
+// Parameters:
+//   - value: the ratio between 0 and 1; 1 if empty
+//
+// Returns:
+//   - float64: the sample ratio
+//   - error: an error if the value is not a valid ratio
+func ParseSampleRatio(value string) (float64, error) {
+	if value == "" {
+		return 1, nil
+	}
+	ratio, err := strconv.ParseFloat(value, 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return 0, fmt.Errorf("invalid sample ratio %q, expected a number between 0 and 1", value)
+	}
+	return ratio, nil
+}
+
+// Start starts a span as child of the span in the context.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - name: the name of the span
+//   - attrs: the attributes of the span
+//
+// Returns:
+//   - context.Context: the context containing the new span
+//   - trace.Span: the new span, to be ended with End
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// SetFunction adds the called external function to the span in the context.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - function: the name of the function
+//   - category: the category of the function
+func SetFunction(ctx context.Context, function string, category string) {
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("flowkit.function", function),
+		attribute.String("flowkit.category", category),
+	)
+}
+
+// End records the error of the span, if any, and ends it.
+//
+// Parameters:
+//   - span: the span to end
+//   - err: the error of the traced operation, nil if it succeeded
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// HTTPHeader returns the headers propagating the trace context of the given context,
+// e.g. for the upgrade request of a WebSocket connection.
+//
+// Parameters:
+//   - ctx: the context of the request
+//
+// Returns:
+//   - http.Header: the propagation headers (traceparent, tracestate, baggage)
+func HTTPHeader(ctx context.Context) http.Header {
+	header := http.Header{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+	return header
+}
+
+// TraceContext returns the fields propagating the trace context of the given context,
+// e.g. for a message sent on a connection shared by several requests.
+//
+// Parameters:
+//   - ctx: the context of the request
+//
+// Returns:
+//   - map[string]string: the propagation fields (traceparent, tracestate, baggage), nil if there is no trace context
+func TraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// NewTransport wraps an HTTP transport to trace the requests and propagate the trace context.
+//
+// Parameters:
+//   - base: the wrapped transport, http.DefaultTransport if nil
+//
+// Returns:
+//   - http.RoundTripper: the traced transport
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
+
+// ServerHandler returns the gRPC stats handler starting a span for every incoming call
+// from the trace context in the request metadata.
+//
+// Returns:
+//   - stats.Handler: the gRPC server stats handler
+func ServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler()
+}
+
+// ClientHandler returns the gRPC stats handler tracing the outgoing calls of a gRPC client.
+//
+// Returns:
+//   - stats.Handler: the gRPC client stats handler
+func ClientHandler() stats.Handler {
+	return otelgrpc.NewClientHandler()
+}
+
+// mongoMonitor traces the commands of a MongoDB client
+type mongoMonitor struct {
+	// spans holds the running span of every command by request ID
+	spans sync.Map
+}
+
+// MongoMonitor returns the MongoDB command monitor tracing the commands of a MongoDB client.
+//
+// Returns:
+//   - *event.CommandMonitor: the command monitor
+func MongoMonitor() *event.CommandMonitor {
+	monitor := &mongoMonitor{}
+	return &event.CommandMonitor{
+		Started:   monitor.started,
+		Succeeded: monitor.succeeded,
+		Failed:    monitor.failed,
+	}
+}
+
+// started starts the span of a MongoDB command
+func (m *mongoMonitor) started(ctx context.Context, evt *event.CommandStartedEvent) {
+	_, span := Start(ctx, "mongodb."+evt.CommandName,
+		attribute.String("db.system", "mongodb"),
+		attribute.String("db.name", evt.DatabaseName),
+		attribute.String("db.operation", evt.CommandName),
+	)
+	m.spans.Store(evt.RequestID, span)
+}
+
+// succeeded ends the span of a successful MongoDB command
+func (m *mongoMonitor) succeeded(ctx context.Context, evt *event.CommandSucceededEvent) {
+	m.end(evt.RequestID, nil)
+}
+
+// failed ends the span of a failed MongoDB command
+func (m *mongoMonitor) failed(ctx context.Context, evt *event.CommandFailedEvent) {
+	m.end(evt.RequestID, errors.New(evt.Failure))
+}
+
+// end ends the span of the MongoDB command with the given request ID
+func (m *mongoMonitor) end(requestID int64, err error) {
+	span, ok := m.spans.LoadAndDelete(requestID)
+	if !ok {
+		return
+	}
+	End(span.(trace.Span), err)
+}
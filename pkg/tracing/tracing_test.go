@@ -0,0 +1,95 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSetupFileExporter(t *testing.T) {
+	require := require.New(t)
+	file := filepath.Join(t.TempDir(), "traces.json")
+
+	shutdown, err := Setup(context.Background(), Options{ServiceName: "aali-flowkit", Exporter: ExporterFile, File: file, SampleRatio: 1})
+	require.NoError(err)
+
+	ctx, parent := Start(context.Background(), "RunFunction")
+	_, child := Start(ctx, "aali-llm.chat")
+	assert.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+	End(child, errors.New("connection refused"))
+	End(parent, nil)
+	require.NoError(shutdown(context.Background()))
+
+	content, err := os.ReadFile(file)
+	require.NoError(err)
+	assert.Contains(t, string(content), `"Name":"aali-llm.chat"`)
+	assert.Contains(t, string(content), "connection refused")
+}
+
+func TestHTTPHeader(t *testing.T) {
+	_, err := Setup(context.Background(), Options{})
+	require.NoError(t, err)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), spanContext)
+
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", HTTPHeader(ctx).Get("traceparent"))
+	assert.Empty(t, HTTPHeader(context.Background()).Get("traceparent"))
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", TraceContext(ctx)["traceparent"])
+	assert.Nil(t, TraceContext(context.Background()))
+}
+
+func TestSetupInvalidOptions(t *testing.T) {
+	_, err := Setup(context.Background(), Options{Exporter: "jaeger"})
+	assert.Error(t, err)
+
+	_, err = Setup(context.Background(), Options{Exporter: ExporterFile})
+	assert.Error(t, err)
+}
+
+func TestParseSampleRatio(t *testing.T) {
+	ratio, err := ParseSampleRatio("")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, ratio)
+
+	ratio, err = ParseSampleRatio("0.25")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.25, ratio)
+
+	_, err = ParseSampleRatio("2")
+	assert.Error(t, err)
+	_, err = ParseSampleRatio("all")
+	assert.Error(t, err)
+}